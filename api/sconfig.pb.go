@@ -83,11 +83,15 @@ type SinfoResp struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CurIndex        uint64 `protobuf:"varint,1,opt,name=cur_index,json=curIndex,proto3" json:"cur_index,omitempty"`
-	MaxIndex        uint64 `protobuf:"varint,2,opt,name=max_index,json=maxIndex,proto3" json:"max_index,omitempty"`
-	OpNum           uint64 `protobuf:"varint,3,opt,name=op_num,json=opNum,proto3" json:"op_num,omitempty"` //0 means config not exist
-	CurAppConfig    string `protobuf:"bytes,4,opt,name=cur_app_config,json=curAppConfig,proto3" json:"cur_app_config,omitempty"`
-	CurSourceConfig string `protobuf:"bytes,5,opt,name=cur_source_config,json=curSourceConfig,proto3" json:"cur_source_config,omitempty"`
+	CurIndex        uint64   `protobuf:"varint,1,opt,name=cur_index,json=curIndex,proto3" json:"cur_index,omitempty"`
+	MaxIndex        uint64   `protobuf:"varint,2,opt,name=max_index,json=maxIndex,proto3" json:"max_index,omitempty"`
+	OpNum           uint64   `protobuf:"varint,3,opt,name=op_num,json=opNum,proto3" json:"op_num,omitempty"` //0 means config not exist
+	CurAppConfig    string   `protobuf:"bytes,4,opt,name=cur_app_config,json=curAppConfig,proto3" json:"cur_app_config,omitempty"`
+	CurSourceConfig string   `protobuf:"bytes,5,opt,name=cur_source_config,json=curSourceConfig,proto3" json:"cur_source_config,omitempty"`
+	CanaryIndex     uint64   `protobuf:"varint,6,opt,name=canary_index,json=canaryIndex,proto3" json:"canary_index,omitempty"` //0 means no canary
+	CanaryPercent   uint32   `protobuf:"varint,7,opt,name=canary_percent,json=canaryPercent,proto3" json:"canary_percent,omitempty"`
+	CanaryHostnames []string `protobuf:"bytes,8,rep,name=canary_hostnames,json=canaryHostnames,proto3" json:"canary_hostnames,omitempty"`
+	CanaryLabels    []string `protobuf:"bytes,9,rep,name=canary_labels,json=canaryLabels,proto3" json:"canary_labels,omitempty"`
 }
 
 func (x *SinfoResp) Reset() {
@@ -157,15 +161,47 @@ func (x *SinfoResp) GetCurSourceConfig() string {
 	return ""
 }
 
+func (x *SinfoResp) GetCanaryIndex() uint64 {
+	if x != nil {
+		return x.CanaryIndex
+	}
+	return 0
+}
+
+func (x *SinfoResp) GetCanaryPercent() uint32 {
+	if x != nil {
+		return x.CanaryPercent
+	}
+	return 0
+}
+
+func (x *SinfoResp) GetCanaryHostnames() []string {
+	if x != nil {
+		return x.CanaryHostnames
+	}
+	return nil
+}
+
+func (x *SinfoResp) GetCanaryLabels() []string {
+	if x != nil {
+		return x.CanaryLabels
+	}
+	return nil
+}
+
 type SsetReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Groupname    string `protobuf:"bytes,1,opt,name=groupname,proto3" json:"groupname,omitempty"`
-	Appname      string `protobuf:"bytes,2,opt,name=appname,proto3" json:"appname,omitempty"`
-	AppConfig    string `protobuf:"bytes,3,opt,name=app_config,json=appConfig,proto3" json:"app_config,omitempty"`
-	SourceConfig string `protobuf:"bytes,4,opt,name=source_config,json=sourceConfig,proto3" json:"source_config,omitempty"`
+	Groupname     string        `protobuf:"bytes,1,opt,name=groupname,proto3" json:"groupname,omitempty"`
+	Appname       string        `protobuf:"bytes,2,opt,name=appname,proto3" json:"appname,omitempty"`
+	AppConfig     string        `protobuf:"bytes,3,opt,name=app_config,json=appConfig,proto3" json:"app_config,omitempty"`
+	SourceConfig  string        `protobuf:"bytes,4,opt,name=source_config,json=sourceConfig,proto3" json:"source_config,omitempty"`
+	Comment       string        `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
+	ExpectedOpNum uint64        `protobuf:"varint,6,opt,name=expected_op_num,json=expectedOpNum,proto3" json:"expected_op_num,omitempty"` //if not 0,the set will be rejected when it's different from the current op_num
+	Draft         bool          `protobuf:"varint,7,opt,name=draft,proto3" json:"draft,omitempty"`                                        //true means only save a new version,it will not be current until spublish
+	Guard         *PublishGuard `protobuf:"bytes,8,opt,name=guard,proto3" json:"guard,omitempty"`                                         //optional,ignored when draft is true
 }
 
 func (x *SsetReq) Reset() {
@@ -228,10 +264,42 @@ func (x *SsetReq) GetSourceConfig() string {
 	return ""
 }
 
+func (x *SsetReq) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *SsetReq) GetExpectedOpNum() uint64 {
+	if x != nil {
+		return x.ExpectedOpNum
+	}
+	return 0
+}
+
+func (x *SsetReq) GetDraft() bool {
+	if x != nil {
+		return x.Draft
+	}
+	return false
+}
+
+func (x *SsetReq) GetGuard() *PublishGuard {
+	if x != nil {
+		return x.Guard
+	}
+	return nil
+}
+
 type SsetResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	CurIndex uint64 `protobuf:"varint,1,opt,name=cur_index,json=curIndex,proto3" json:"cur_index,omitempty"`
+	MaxIndex uint64 `protobuf:"varint,2,opt,name=max_index,json=maxIndex,proto3" json:"max_index,omitempty"`
+	OpNum    uint64 `protobuf:"varint,3,opt,name=op_num,json=opNum,proto3" json:"op_num,omitempty"`
 }
 
 func (x *SsetResp) Reset() {
@@ -266,14 +334,36 @@ func (*SsetResp) Descriptor() ([]byte, []int) {
 	return file_api_sconfig_proto_rawDescGZIP(), []int{3}
 }
 
+func (x *SsetResp) GetCurIndex() uint64 {
+	if x != nil {
+		return x.CurIndex
+	}
+	return 0
+}
+
+func (x *SsetResp) GetMaxIndex() uint64 {
+	if x != nil {
+		return x.MaxIndex
+	}
+	return 0
+}
+
+func (x *SsetResp) GetOpNum() uint64 {
+	if x != nil {
+		return x.OpNum
+	}
+	return 0
+}
+
 type SrollbackReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Groupname string `protobuf:"bytes,1,opt,name=groupname,proto3" json:"groupname,omitempty"`
-	Appname   string `protobuf:"bytes,2,opt,name=appname,proto3" json:"appname,omitempty"`
-	Index     uint64 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	Groupname     string `protobuf:"bytes,1,opt,name=groupname,proto3" json:"groupname,omitempty"`
+	Appname       string `protobuf:"bytes,2,opt,name=appname,proto3" json:"appname,omitempty"`
+	Index         uint64 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	ExpectedOpNum uint64 `protobuf:"varint,4,opt,name=expected_op_num,json=expectedOpNum,proto3" json:"expected_op_num,omitempty"` //if not 0,the rollback will be rejected when it's different from the current op_num
 }
 
 func (x *SrollbackReq) Reset() {
@@ -329,10 +419,21 @@ func (x *SrollbackReq) GetIndex() uint64 {
 	return 0
 }
 
+func (x *SrollbackReq) GetExpectedOpNum() uint64 {
+	if x != nil {
+		return x.ExpectedOpNum
+	}
+	return 0
+}
+
 type SrollbackResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	CurIndex uint64 `protobuf:"varint,1,opt,name=cur_index,json=curIndex,proto3" json:"cur_index,omitempty"`
+	MaxIndex uint64 `protobuf:"varint,2,opt,name=max_index,json=maxIndex,proto3" json:"max_index,omitempty"`
+	OpNum    uint64 `protobuf:"varint,3,opt,name=op_num,json=opNum,proto3" json:"op_num,omitempty"`
 }
 
 func (x *SrollbackResp) Reset() {
@@ -367,6 +468,27 @@ func (*SrollbackResp) Descriptor() ([]byte, []int) {
 	return file_api_sconfig_proto_rawDescGZIP(), []int{5}
 }
 
+func (x *SrollbackResp) GetCurIndex() uint64 {
+	if x != nil {
+		return x.CurIndex
+	}
+	return 0
+}
+
+func (x *SrollbackResp) GetMaxIndex() uint64 {
+	if x != nil {
+		return x.MaxIndex
+	}
+	return 0
+}
+
+func (x *SrollbackResp) GetOpNum() uint64 {
+	if x != nil {
+		return x.OpNum
+	}
+	return 0
+}
+
 type SgetReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -438,6 +560,11 @@ type SgetResp struct {
 	Index        uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
 	AppConfig    string `protobuf:"bytes,2,opt,name=app_config,json=appConfig,proto3" json:"app_config,omitempty"`
 	SourceConfig string `protobuf:"bytes,3,opt,name=source_config,json=sourceConfig,proto3" json:"source_config,omitempty"`
+	Ctime        uint64 `protobuf:"varint,4,opt,name=ctime,proto3" json:"ctime,omitempty"` //unix timestamp,second
+	Author       string `protobuf:"bytes,5,opt,name=author,proto3" json:"author,omitempty"`
+	Comment      string `protobuf:"bytes,6,opt,name=comment,proto3" json:"comment,omitempty"`
+	Draft        bool   `protobuf:"varint,7,opt,name=draft,proto3" json:"draft,omitempty"` //true means this version has never been current
+	Tag          string `protobuf:"bytes,8,opt,name=tag,proto3" json:"tag,omitempty"`      //empty means no tag
 }
 
 func (x *SgetResp) Reset() {
@@ -493,6 +620,41 @@ func (x *SgetResp) GetSourceConfig() string {
 	return ""
 }
 
+func (x *SgetResp) GetCtime() uint64 {
+	if x != nil {
+		return x.Ctime
+	}
+	return 0
+}
+
+func (x *SgetResp) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *SgetResp) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *SgetResp) GetDraft() bool {
+	if x != nil {
+		return x.Draft
+	}
+	return false
+}
+
+func (x *SgetResp) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
 type SgroupsReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -672,14 +834,21 @@ func (x *SappsResp) GetApps() []string {
 	return nil
 }
 
-type SwatchaddrReq struct {
+type SwatchReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Groupname string   `protobuf:"bytes,1,opt,name=groupname,proto3" json:"groupname,omitempty"`
+	Appname   string   `protobuf:"bytes,2,opt,name=appname,proto3" json:"appname,omitempty"`
+	OpNum     uint64   `protobuf:"varint,3,opt,name=op_num,json=opNum,proto3" json:"op_num,omitempty"`               //the op_num the caller already has
+	Instance  string   `protobuf:"bytes,4,opt,name=instance,proto3" json:"instance,omitempty"`                       //the caller's hostname or instance id,used by canary
+	Labels    []string `protobuf:"bytes,5,rep,name=labels,proto3" json:"labels,omitempty"`                           //the caller's labels,used by canary
+	BaseOpNum uint64   `protobuf:"varint,6,opt,name=base_op_num,json=baseOpNum,proto3" json:"base_op_num,omitempty"` //the base_op_num the caller already has
 }
 
-func (x *SwatchaddrReq) Reset() {
-	*x = SwatchaddrReq{}
+func (x *SwatchReq) Reset() {
+	*x = SwatchReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_sconfig_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -687,13 +856,13 @@ func (x *SwatchaddrReq) Reset() {
 	}
 }
 
-func (x *SwatchaddrReq) String() string {
+func (x *SwatchReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SwatchaddrReq) ProtoMessage() {}
+func (*SwatchReq) ProtoMessage() {}
 
-func (x *SwatchaddrReq) ProtoReflect() protoreflect.Message {
+func (x *SwatchReq) ProtoReflect() protoreflect.Message {
 	mi := &file_api_sconfig_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -705,24 +874,68 @@ func (x *SwatchaddrReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SwatchaddrReq.ProtoReflect.Descriptor instead.
-func (*SwatchaddrReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use SwatchReq.ProtoReflect.Descriptor instead.
+func (*SwatchReq) Descriptor() ([]byte, []int) {
 	return file_api_sconfig_proto_rawDescGZIP(), []int{12}
 }
 
-type SwatchaddrResp struct {
+func (x *SwatchReq) GetGroupname() string {
+	if x != nil {
+		return x.Groupname
+	}
+	return ""
+}
+
+func (x *SwatchReq) GetAppname() string {
+	if x != nil {
+		return x.Appname
+	}
+	return ""
+}
+
+func (x *SwatchReq) GetOpNum() uint64 {
+	if x != nil {
+		return x.OpNum
+	}
+	return 0
+}
+
+func (x *SwatchReq) GetInstance() string {
+	if x != nil {
+		return x.Instance
+	}
+	return ""
+}
+
+func (x *SwatchReq) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *SwatchReq) GetBaseOpNum() uint64 {
+	if x != nil {
+		return x.BaseOpNum
+	}
+	return 0
+}
+
+type SwatchResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Username       string   `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Passwd         string   `protobuf:"bytes,2,opt,name=passwd,proto3" json:"passwd,omitempty"`
-	Addrs          []string `protobuf:"bytes,3,rep,name=addrs,proto3" json:"addrs,omitempty"`
-	ReplicaSetName string   `protobuf:"bytes,4,opt,name=replica_set_name,json=replicaSetName,proto3" json:"replica_set_name,omitempty"`
+	OpNum        uint64 `protobuf:"varint,1,opt,name=op_num,json=opNum,proto3" json:"op_num,omitempty"` //0 means config not exist
+	CurIndex     uint64 `protobuf:"varint,2,opt,name=cur_index,json=curIndex,proto3" json:"cur_index,omitempty"`
+	AppConfig    string `protobuf:"bytes,3,opt,name=app_config,json=appConfig,proto3" json:"app_config,omitempty"`          //merged with the group's base config
+	SourceConfig string `protobuf:"bytes,4,opt,name=source_config,json=sourceConfig,proto3" json:"source_config,omitempty"` //merged with the group's base config
+	Canary       bool   `protobuf:"varint,5,opt,name=canary,proto3" json:"canary,omitempty"`                                //true means the cur_index is the canary version for this caller
+	BaseOpNum    uint64 `protobuf:"varint,6,opt,name=base_op_num,json=baseOpNum,proto3" json:"base_op_num,omitempty"`       //the group's base config's op_num,0 means base config not exist
 }
 
-func (x *SwatchaddrResp) Reset() {
-	*x = SwatchaddrResp{}
+func (x *SwatchResp) Reset() {
+	*x = SwatchResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_sconfig_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -730,13 +943,13 @@ func (x *SwatchaddrResp) Reset() {
 	}
 }
 
-func (x *SwatchaddrResp) String() string {
+func (x *SwatchResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SwatchaddrResp) ProtoMessage() {}
+func (*SwatchResp) ProtoMessage() {}
 
-func (x *SwatchaddrResp) ProtoReflect() protoreflect.Message {
+func (x *SwatchResp) ProtoReflect() protoreflect.Message {
 	mi := &file_api_sconfig_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -748,216 +961,6786 @@ func (x *SwatchaddrResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SwatchaddrResp.ProtoReflect.Descriptor instead.
-func (*SwatchaddrResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use SwatchResp.ProtoReflect.Descriptor instead.
+func (*SwatchResp) Descriptor() ([]byte, []int) {
 	return file_api_sconfig_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *SwatchaddrResp) GetUsername() string {
+func (x *SwatchResp) GetOpNum() uint64 {
+	if x != nil {
+		return x.OpNum
+	}
+	return 0
+}
+
+func (x *SwatchResp) GetCurIndex() uint64 {
+	if x != nil {
+		return x.CurIndex
+	}
+	return 0
+}
+
+func (x *SwatchResp) GetAppConfig() string {
 	if x != nil {
-		return x.Username
+		return x.AppConfig
 	}
 	return ""
 }
 
-func (x *SwatchaddrResp) GetPasswd() string {
+func (x *SwatchResp) GetSourceConfig() string {
 	if x != nil {
-		return x.Passwd
+		return x.SourceConfig
 	}
 	return ""
 }
 
-func (x *SwatchaddrResp) GetAddrs() []string {
+func (x *SwatchResp) GetCanary() bool {
 	if x != nil {
-		return x.Addrs
+		return x.Canary
 	}
-	return nil
+	return false
 }
 
-func (x *SwatchaddrResp) GetReplicaSetName() string {
+func (x *SwatchResp) GetBaseOpNum() uint64 {
 	if x != nil {
-		return x.ReplicaSetName
+		return x.BaseOpNum
 	}
-	return ""
+	return 0
 }
 
-var File_api_sconfig_proto protoreflect.FileDescriptor
+type ShistoryReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_api_sconfig_proto_rawDesc = []byte{
-	0x0a, 0x11, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x12, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x0f, 0x70, 0x62, 0x65,
-	0x78, 0x2f, 0x70, 0x62, 0x65, 0x78, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x4f, 0x0a, 0x09,
-	0x73, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x72, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x09, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xf0, 0x90,
-	0x4e, 0x00, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a,
-	0x07, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04,
-	0xf0, 0x90, 0x4e, 0x00, 0x52, 0x07, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xaf, 0x01,
-	0x0a, 0x0a, 0x73, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x12, 0x1b, 0x0a, 0x09,
-	0x63, 0x75, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x08, 0x63, 0x75, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78,
-	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x61,
-	0x78, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x15, 0x0a, 0x06, 0x6f, 0x70, 0x5f, 0x6e, 0x75, 0x6d,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6f, 0x70, 0x4e, 0x75, 0x6d, 0x12, 0x24, 0x0a,
-	0x0e, 0x63, 0x75, 0x72, 0x5f, 0x61, 0x70, 0x70, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x41, 0x70, 0x70, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x12, 0x2a, 0x0a, 0x11, 0x63, 0x75, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
-	0x63, 0x75, 0x72, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22,
-	0x92, 0x01, 0x0a, 0x08, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x09,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x04, 0xf0, 0x90, 0x4e, 0x00, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x1e, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x04, 0xf0, 0x90, 0x4e, 0x00, 0x52, 0x07, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x70, 0x70, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x70, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
-	0x23, 0x0a, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x22, 0x0b, 0x0a, 0x09, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x73,
-	0x70, 0x22, 0x6f, 0x0a, 0x0d, 0x73, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x72,
-	0x65, 0x71, 0x12, 0x22, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xf0, 0x90, 0x4e, 0x00, 0x52, 0x09, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xf0, 0x90, 0x4e, 0x00, 0x52, 0x07, 0x61,
-	0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x04, 0xa0, 0x91, 0x4e, 0x00, 0x52, 0x05, 0x69, 0x6e, 0x64,
-	0x65, 0x78, 0x22, 0x10, 0x0a, 0x0e, 0x73, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f,
-	0x72, 0x65, 0x73, 0x70, 0x22, 0x6a, 0x0a, 0x08, 0x73, 0x67, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x71,
-	0x12, 0x22, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x04, 0xf0, 0x90, 0x4e, 0x00, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xf0, 0x90, 0x4e, 0x00, 0x52, 0x07, 0x61, 0x70, 0x70,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x04, 0x42, 0x04, 0xa0, 0x91, 0x4e, 0x00, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
-	0x22, 0x65, 0x0a, 0x09, 0x73, 0x67, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a,
-	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x70, 0x70, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x70, 0x70, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x0d, 0x0a, 0x0b, 0x73, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x73, 0x5f, 0x72, 0x65, 0x71, 0x22, 0x26, 0x0a, 0x0c, 0x73, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x73, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x2f,
-	0x0a, 0x09, 0x73, 0x61, 0x70, 0x70, 0x73, 0x5f, 0x72, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x09, 0x67,
-	0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04,
-	0xf0, 0x90, 0x4e, 0x00, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x22,
-	0x20, 0x0a, 0x0a, 0x73, 0x61, 0x70, 0x70, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x12, 0x12, 0x0a,
-	0x04, 0x61, 0x70, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x70, 0x70,
-	0x73, 0x22, 0x10, 0x0a, 0x0e, 0x73, 0x77, 0x61, 0x74, 0x63, 0x68, 0x61, 0x64, 0x64, 0x72, 0x5f,
-	0x72, 0x65, 0x71, 0x22, 0x85, 0x01, 0x0a, 0x0f, 0x73, 0x77, 0x61, 0x74, 0x63, 0x68, 0x61, 0x64,
-	0x64, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x73, 0x73, 0x77, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x73, 0x73, 0x77, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61,
-	0x64, 0x64, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x61, 0x64, 0x64, 0x72,
-	0x73, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x5f, 0x73, 0x65, 0x74,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x53, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x32, 0xf4, 0x03, 0x0a, 0x07,
-	0x73, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x40, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x66, 0x6f,
-	0x12, 0x11, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x69, 0x6e, 0x66, 0x6f, 0x5f,
-	0x72, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x69, 0x6e,
-	0x66, 0x6f, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x10, 0x8a, 0x9f, 0x49, 0x03, 0x67, 0x65, 0x74,
-	0x92, 0x9f, 0x49, 0x05, 0x32, 0x35, 0x30, 0x6d, 0x73, 0x12, 0x3e, 0x0a, 0x04, 0x73, 0x73, 0x65,
-	0x74, 0x12, 0x10, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x73, 0x65, 0x74, 0x5f,
-	0x72, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x73, 0x65,
-	0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x11, 0x8a, 0x9f, 0x49, 0x04, 0x70, 0x6f, 0x73, 0x74,
-	0x92, 0x9f, 0x49, 0x05, 0x32, 0x35, 0x30, 0x6d, 0x73, 0x12, 0x4d, 0x0a, 0x09, 0x73, 0x72, 0x6f,
-	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x15, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
-	0x73, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x72, 0x65, 0x71, 0x1a, 0x16, 0x2e,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
-	0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x11, 0x8a, 0x9f, 0x49, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x92,
-	0x9f, 0x49, 0x05, 0x32, 0x35, 0x30, 0x6d, 0x73, 0x12, 0x3d, 0x0a, 0x04, 0x73, 0x67, 0x65, 0x74,
-	0x12, 0x10, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x67, 0x65, 0x74, 0x5f, 0x72,
-	0x65, 0x71, 0x1a, 0x11, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x67, 0x65, 0x74,
-	0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x10, 0x8a, 0x9f, 0x49, 0x03, 0x67, 0x65, 0x74, 0x92, 0x9f,
-	0x49, 0x05, 0x32, 0x35, 0x30, 0x6d, 0x73, 0x12, 0x46, 0x0a, 0x07, 0x73, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x73, 0x12, 0x13, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x73, 0x5f, 0x72, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x2e, 0x73, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x10, 0x8a,
-	0x9f, 0x49, 0x03, 0x67, 0x65, 0x74, 0x92, 0x9f, 0x49, 0x05, 0x32, 0x35, 0x30, 0x6d, 0x73, 0x12,
-	0x40, 0x0a, 0x05, 0x73, 0x61, 0x70, 0x70, 0x73, 0x12, 0x11, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x2e, 0x73, 0x61, 0x70, 0x70, 0x73, 0x5f, 0x72, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x61, 0x70, 0x70, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22,
-	0x10, 0x8a, 0x9f, 0x49, 0x03, 0x67, 0x65, 0x74, 0x92, 0x9f, 0x49, 0x05, 0x32, 0x35, 0x30, 0x6d,
-	0x73, 0x12, 0x4f, 0x0a, 0x0a, 0x73, 0x77, 0x61, 0x74, 0x63, 0x68, 0x61, 0x64, 0x64, 0x72, 0x12,
-	0x16, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x77, 0x61, 0x74, 0x63, 0x68, 0x61,
-	0x64, 0x64, 0x72, 0x5f, 0x72, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x2e, 0x73, 0x77, 0x61, 0x74, 0x63, 0x68, 0x61, 0x64, 0x64, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x70,
-	0x22, 0x10, 0x8a, 0x9f, 0x49, 0x03, 0x67, 0x65, 0x74, 0x92, 0x9f, 0x49, 0x05, 0x32, 0x35, 0x30,
-	0x6d, 0x73, 0x42, 0x10, 0x5a, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x61, 0x70, 0x69,
-	0x3b, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	Groupname string `protobuf:"bytes,1,opt,name=groupname,proto3" json:"groupname,omitempty"`
+	Appname   string `protobuf:"bytes,2,opt,name=appname,proto3" json:"appname,omitempty"`
+	Page      uint32 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"` //start from 1,0 will be treated as 1
+	Size      uint32 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"` //0 will be treated as 20,max 100
 }
 
-var (
-	file_api_sconfig_proto_rawDescOnce sync.Once
-	file_api_sconfig_proto_rawDescData = file_api_sconfig_proto_rawDesc
-)
+func (x *ShistoryReq) Reset() {
+	*x = ShistoryReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_api_sconfig_proto_rawDescGZIP() []byte {
-	file_api_sconfig_proto_rawDescOnce.Do(func() {
-		file_api_sconfig_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_sconfig_proto_rawDescData)
-	})
-	return file_api_sconfig_proto_rawDescData
+func (x *ShistoryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_api_sconfig_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
-var file_api_sconfig_proto_goTypes = []interface{}{
-	(*SinfoReq)(nil),       // 0: config.sinfo_req
-	(*SinfoResp)(nil),      // 1: config.sinfo_resp
-	(*SsetReq)(nil),        // 2: config.sset_req
-	(*SsetResp)(nil),       // 3: config.sset_resp
-	(*SrollbackReq)(nil),   // 4: config.srollback_req
-	(*SrollbackResp)(nil),  // 5: config.srollback_resp
-	(*SgetReq)(nil),        // 6: config.sget_req
-	(*SgetResp)(nil),       // 7: config.sget_resp
-	(*SgroupsReq)(nil),     // 8: config.sgroups_req
-	(*SgroupsResp)(nil),    // 9: config.sgroups_resp
-	(*SappsReq)(nil),       // 10: config.sapps_req
-	(*SappsResp)(nil),      // 11: config.sapps_resp
-	(*SwatchaddrReq)(nil),  // 12: config.swatchaddr_req
-	(*SwatchaddrResp)(nil), // 13: config.swatchaddr_resp
+func (*ShistoryReq) ProtoMessage() {}
+
+func (x *ShistoryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_api_sconfig_proto_depIdxs = []int32{
-	0,  // 0: config.sconfig.sinfo:input_type -> config.sinfo_req
-	2,  // 1: config.sconfig.sset:input_type -> config.sset_req
-	4,  // 2: config.sconfig.srollback:input_type -> config.srollback_req
-	6,  // 3: config.sconfig.sget:input_type -> config.sget_req
-	8,  // 4: config.sconfig.sgroups:input_type -> config.sgroups_req
-	10, // 5: config.sconfig.sapps:input_type -> config.sapps_req
-	12, // 6: config.sconfig.swatchaddr:input_type -> config.swatchaddr_req
-	1,  // 7: config.sconfig.sinfo:output_type -> config.sinfo_resp
-	3,  // 8: config.sconfig.sset:output_type -> config.sset_resp
-	5,  // 9: config.sconfig.srollback:output_type -> config.srollback_resp
-	7,  // 10: config.sconfig.sget:output_type -> config.sget_resp
-	9,  // 11: config.sconfig.sgroups:output_type -> config.sgroups_resp
-	11, // 12: config.sconfig.sapps:output_type -> config.sapps_resp
-	13, // 13: config.sconfig.swatchaddr:output_type -> config.swatchaddr_resp
-	7,  // [7:14] is the sub-list for method output_type
-	0,  // [0:7] is the sub-list for method input_type
-	0,  // [0:0] is the sub-list for extension type_name
-	0,  // [0:0] is the sub-list for extension extendee
-	0,  // [0:0] is the sub-list for field type_name
+
+// Deprecated: Use ShistoryReq.ProtoReflect.Descriptor instead.
+func (*ShistoryReq) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{14}
 }
 
-func init() { file_api_sconfig_proto_init() }
-func file_api_sconfig_proto_init() {
-	if File_api_sconfig_proto != nil {
-		return
+func (x *ShistoryReq) GetGroupname() string {
+	if x != nil {
+		return x.Groupname
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_api_sconfig_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SinfoReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_api_sconfig_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+	return ""
+}
+
+func (x *ShistoryReq) GetAppname() string {
+	if x != nil {
+		return x.Appname
+	}
+	return ""
+}
+
+func (x *ShistoryReq) GetPage() uint32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ShistoryReq) GetSize() uint32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type ShistoryResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total    uint64         `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"` //total versions
+	Versions []*HistoryInfo `protobuf:"bytes,2,rep,name=versions,proto3" json:"versions,omitempty"`
+}
+
+func (x *ShistoryResp) Reset() {
+	*x = ShistoryResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShistoryResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShistoryResp) ProtoMessage() {}
+
+func (x *ShistoryResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShistoryResp.ProtoReflect.Descriptor instead.
+func (*ShistoryResp) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ShistoryResp) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ShistoryResp) GetVersions() []*HistoryInfo {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+type HistoryInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index            uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Ctime            uint64 `protobuf:"varint,2,opt,name=ctime,proto3" json:"ctime,omitempty"` //unix timestamp,second
+	Author           string `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	Comment          string `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	AppConfigSize    uint64 `protobuf:"varint,5,opt,name=app_config_size,json=appConfigSize,proto3" json:"app_config_size,omitempty"`
+	SourceConfigSize uint64 `protobuf:"varint,6,opt,name=source_config_size,json=sourceConfigSize,proto3" json:"source_config_size,omitempty"`
+	Draft            bool   `protobuf:"varint,7,opt,name=draft,proto3" json:"draft,omitempty"` //true means this version has never been current
+	Tag              string `protobuf:"bytes,8,opt,name=tag,proto3" json:"tag,omitempty"`      //empty means no tag
+}
+
+func (x *HistoryInfo) Reset() {
+	*x = HistoryInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HistoryInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoryInfo) ProtoMessage() {}
+
+func (x *HistoryInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoryInfo.ProtoReflect.Descriptor instead.
+func (*HistoryInfo) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *HistoryInfo) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *HistoryInfo) GetCtime() uint64 {
+	if x != nil {
+		return x.Ctime
+	}
+	return 0
+}
+
+func (x *HistoryInfo) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *HistoryInfo) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *HistoryInfo) GetAppConfigSize() uint64 {
+	if x != nil {
+		return x.AppConfigSize
+	}
+	return 0
+}
+
+func (x *HistoryInfo) GetSourceConfigSize() uint64 {
+	if x != nil {
+		return x.SourceConfigSize
+	}
+	return 0
+}
+
+func (x *HistoryInfo) GetDraft() bool {
+	if x != nil {
+		return x.Draft
+	}
+	return false
+}
+
+func (x *HistoryInfo) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+type SauditReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groupname string `protobuf:"bytes,1,opt,name=groupname,proto3" json:"groupname,omitempty"`
+	Appname   string `protobuf:"bytes,2,opt,name=appname,proto3" json:"appname,omitempty"`                       //empty means all apps in this group
+	BeginTime uint64 `protobuf:"varint,3,opt,name=begin_time,json=beginTime,proto3" json:"begin_time,omitempty"` //unix timestamp,second,0 means no limit
+	EndTime   uint64 `protobuf:"varint,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`       //unix timestamp,second,0 means no limit
+	Page      uint32 `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`                            //start from 1,0 will be treated as 1
+	Size      uint32 `protobuf:"varint,6,opt,name=size,proto3" json:"size,omitempty"`                            //0 will be treated as 20,max 100
+}
+
+func (x *SauditReq) Reset() {
+	*x = SauditReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SauditReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SauditReq) ProtoMessage() {}
+
+func (x *SauditReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SauditReq.ProtoReflect.Descriptor instead.
+func (*SauditReq) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SauditReq) GetGroupname() string {
+	if x != nil {
+		return x.Groupname
+	}
+	return ""
+}
+
+func (x *SauditReq) GetAppname() string {
+	if x != nil {
+		return x.Appname
+	}
+	return ""
+}
+
+func (x *SauditReq) GetBeginTime() uint64 {
+	if x != nil {
+		return x.BeginTime
+	}
+	return 0
+}
+
+func (x *SauditReq) GetEndTime() uint64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *SauditReq) GetPage() uint32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SauditReq) GetSize() uint32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type SauditResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total  uint64       `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Audits []*AuditInfo `protobuf:"bytes,2,rep,name=audits,proto3" json:"audits,omitempty"`
+}
+
+func (x *SauditResp) Reset() {
+	*x = SauditResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SauditResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SauditResp) ProtoMessage() {}
+
+func (x *SauditResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SauditResp.ProtoReflect.Descriptor instead.
+func (*SauditResp) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SauditResp) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SauditResp) GetAudits() []*AuditInfo {
+	if x != nil {
+		return x.Audits
+	}
+	return nil
+}
+
+type AuditInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groupname string `protobuf:"bytes,1,opt,name=groupname,proto3" json:"groupname,omitempty"`
+	Appname   string `protobuf:"bytes,2,opt,name=appname,proto3" json:"appname,omitempty"`
+	Op        string `protobuf:"bytes,3,opt,name=op,proto3" json:"op,omitempty"` //set,patch,rollback,set_draft,patch_draft,publish,approve,reject,policy(appname is empty),schedule,schedule_cancel,schedule_fire,schedule_fail,canary,canary_promote,canary_abort,auto_rollback,delete,restore,purge,tag,retention(appname is empty),prune,grant,token_issue,token_rotate,token_revoke,base(appname is empty)
+	Caller    string `protobuf:"bytes,4,opt,name=caller,proto3" json:"caller,omitempty"`
+	Ip        string `protobuf:"bytes,5,opt,name=ip,proto3" json:"ip,omitempty"`
+	FromIndex uint64 `protobuf:"varint,6,opt,name=from_index,json=fromIndex,proto3" json:"from_index,omitempty"`
+	ToIndex   uint64 `protobuf:"varint,7,opt,name=to_index,json=toIndex,proto3" json:"to_index,omitempty"`
+	OpNum     uint64 `protobuf:"varint,8,opt,name=op_num,json=opNum,proto3" json:"op_num,omitempty"` //the op_num after this op
+	Ctime     uint64 `protobuf:"varint,9,opt,name=ctime,proto3" json:"ctime,omitempty"`              //unix timestamp,second
+	Detail    string `protobuf:"bytes,10,opt,name=detail,proto3" json:"detail,omitempty"`            //why this op happened,e.g. the auto_rollback's reason
+}
+
+func (x *AuditInfo) Reset() {
+	*x = AuditInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditInfo) ProtoMessage() {}
+
+func (x *AuditInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditInfo.ProtoReflect.Descriptor instead.
+func (*AuditInfo) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *AuditInfo) GetGroupname() string {
+	if x != nil {
+		return x.Groupname
+	}
+	return ""
+}
+
+func (x *AuditInfo) GetAppname() string {
+	if x != nil {
+		return x.Appname
+	}
+	return ""
+}
+
+func (x *AuditInfo) GetOp() string {
+	if x != nil {
+		return x.Op
+	}
+	return ""
+}
+
+func (x *AuditInfo) GetCaller() string {
+	if x != nil {
+		return x.Caller
+	}
+	return ""
+}
+
+func (x *AuditInfo) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *AuditInfo) GetFromIndex() uint64 {
+	if x != nil {
+		return x.FromIndex
+	}
+	return 0
+}
+
+func (x *AuditInfo) GetToIndex() uint64 {
+	if x != nil {
+		return x.ToIndex
+	}
+	return 0
+}
+
+func (x *AuditInfo) GetOpNum() uint64 {
+	if x != nil {
+		return x.OpNum
+	}
+	return 0
+}
+
+func (x *AuditInfo) GetCtime() uint64 {
+	if x != nil {
+		return x.Ctime
+	}
+	return 0
+}
+
+func (x *AuditInfo) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+type SdiffReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groupname string `protobuf:"bytes,1,opt,name=groupname,proto3" json:"groupname,omitempty"`
+	Appname   string `protobuf:"bytes,2,opt,name=appname,proto3" json:"appname,omitempty"`
+	FromIndex uint64 `protobuf:"varint,3,opt,name=from_index,json=fromIndex,proto3" json:"from_index,omitempty"`
+	ToIndex   uint64 `protobuf:"varint,4,opt,name=to_index,json=toIndex,proto3" json:"to_index,omitempty"` //0 means the current index
+}
+
+func (x *SdiffReq) Reset() {
+	*x = SdiffReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SdiffReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SdiffReq) ProtoMessage() {}
+
+func (x *SdiffReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SdiffReq.ProtoReflect.Descriptor instead.
+func (*SdiffReq) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SdiffReq) GetGroupname() string {
+	if x != nil {
+		return x.Groupname
+	}
+	return ""
+}
+
+func (x *SdiffReq) GetAppname() string {
+	if x != nil {
+		return x.Appname
+	}
+	return ""
+}
+
+func (x *SdiffReq) GetFromIndex() uint64 {
+	if x != nil {
+		return x.FromIndex
+	}
+	return 0
+}
+
+func (x *SdiffReq) GetToIndex() uint64 {
+	if x != nil {
+		return x.ToIndex
+	}
+	return 0
+}
+
+type SdiffResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromIndex    uint64      `protobuf:"varint,1,opt,name=from_index,json=fromIndex,proto3" json:"from_index,omitempty"`
+	ToIndex      uint64      `protobuf:"varint,2,opt,name=to_index,json=toIndex,proto3" json:"to_index,omitempty"`
+	AppConfig    []*DiffItem `protobuf:"bytes,3,rep,name=app_config,json=appConfig,proto3" json:"app_config,omitempty"`
+	SourceConfig []*DiffItem `protobuf:"bytes,4,rep,name=source_config,json=sourceConfig,proto3" json:"source_config,omitempty"`
+}
+
+func (x *SdiffResp) Reset() {
+	*x = SdiffResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SdiffResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SdiffResp) ProtoMessage() {}
+
+func (x *SdiffResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SdiffResp.ProtoReflect.Descriptor instead.
+func (*SdiffResp) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SdiffResp) GetFromIndex() uint64 {
+	if x != nil {
+		return x.FromIndex
+	}
+	return 0
+}
+
+func (x *SdiffResp) GetToIndex() uint64 {
+	if x != nil {
+		return x.ToIndex
+	}
+	return 0
+}
+
+func (x *SdiffResp) GetAppConfig() []*DiffItem {
+	if x != nil {
+		return x.AppConfig
+	}
+	return nil
+}
+
+func (x *SdiffResp) GetSourceConfig() []*DiffItem {
+	if x != nil {
+		return x.SourceConfig
+	}
+	return nil
+}
+
+type DiffItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Op       string `protobuf:"bytes,1,opt,name=op,proto3" json:"op,omitempty"`                             //add,remove,change
+	Path     string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`                         //json pointer(rfc 6901),e.g. /mongo/addrs/0
+	OldValue string `protobuf:"bytes,3,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"` //json,empty when op is add
+	NewValue string `protobuf:"bytes,4,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"` //json,empty when op is remove
+}
+
+func (x *DiffItem) Reset() {
+	*x = DiffItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffItem) ProtoMessage() {}
+
+func (x *DiffItem) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffItem.ProtoReflect.Descriptor instead.
+func (*DiffItem) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DiffItem) GetOp() string {
+	if x != nil {
+		return x.Op
+	}
+	return ""
+}
+
+func (x *DiffItem) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *DiffItem) GetOldValue() string {
+	if x != nil {
+		return x.OldValue
+	}
+	return ""
+}
+
+func (x *DiffItem) GetNewValue() string {
+	if x != nil {
+		return x.NewValue
+	}
+	return ""
+}
+
+type SpatchReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groupname         string        `protobuf:"bytes,1,opt,name=groupname,proto3" json:"groupname,omitempty"`
+	Appname           string        `protobuf:"bytes,2,opt,name=appname,proto3" json:"appname,omitempty"`
+	PatchType         string        `protobuf:"bytes,3,opt,name=patch_type,json=patchType,proto3" json:"patch_type,omitempty"`                           //merge(rfc 7386) or json(rfc 6902)
+	AppConfigPatch    string        `protobuf:"bytes,4,opt,name=app_config_patch,json=appConfigPatch,proto3" json:"app_config_patch,omitempty"`          //empty means don't change
+	SourceConfigPatch string        `protobuf:"bytes,5,opt,name=source_config_patch,json=sourceConfigPatch,proto3" json:"source_config_patch,omitempty"` //empty means don't change
+	Comment           string        `protobuf:"bytes,6,opt,name=comment,proto3" json:"comment,omitempty"`
+	ExpectedOpNum     uint64        `protobuf:"varint,7,opt,name=expected_op_num,json=expectedOpNum,proto3" json:"expected_op_num,omitempty"` //if not 0,the patch will be rejected when it's different from the current op_num
+	Draft             bool          `protobuf:"varint,8,opt,name=draft,proto3" json:"draft,omitempty"`                                        //true means only save a new version,it will not be current until spublish
+	Guard             *PublishGuard `protobuf:"bytes,9,opt,name=guard,proto3" json:"guard,omitempty"`                                         //optional,ignored when draft is true
+}
+
+func (x *SpatchReq) Reset() {
+	*x = SpatchReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpatchReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpatchReq) ProtoMessage() {}
+
+func (x *SpatchReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpatchReq.ProtoReflect.Descriptor instead.
+func (*SpatchReq) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SpatchReq) GetGroupname() string {
+	if x != nil {
+		return x.Groupname
+	}
+	return ""
+}
+
+func (x *SpatchReq) GetAppname() string {
+	if x != nil {
+		return x.Appname
+	}
+	return ""
+}
+
+func (x *SpatchReq) GetPatchType() string {
+	if x != nil {
+		return x.PatchType
+	}
+	return ""
+}
+
+func (x *SpatchReq) GetAppConfigPatch() string {
+	if x != nil {
+		return x.AppConfigPatch
+	}
+	return ""
+}
+
+func (x *SpatchReq) GetSourceConfigPatch() string {
+	if x != nil {
+		return x.SourceConfigPatch
+	}
+	return ""
+}
+
+func (x *SpatchReq) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *SpatchReq) GetExpectedOpNum() uint64 {
+	if x != nil {
+		return x.ExpectedOpNum
+	}
+	return 0
+}
+
+func (x *SpatchReq) GetDraft() bool {
+	if x != nil {
+		return x.Draft
+	}
+	return false
+}
+
+func (x *SpatchReq) GetGuard() *PublishGuard {
+	if x != nil {
+		return x.Guard
+	}
+	return nil
+}
+
+type SpatchResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CurIndex uint64 `protobuf:"varint,1,opt,name=cur_index,json=curIndex,proto3" json:"cur_index,omitempty"`
+	MaxIndex uint64 `protobuf:"varint,2,opt,name=max_index,json=maxIndex,proto3" json:"max_index,omitempty"`
+	OpNum    uint64 `protobuf:"varint,3,opt,name=op_num,json=opNum,proto3" json:"op_num,omitempty"`
+}
+
+func (x *SpatchResp) Reset() {
+	*x = SpatchResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpatchResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpatchResp) ProtoMessage() {}
+
+func (x *SpatchResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpatchResp.ProtoReflect.Descriptor instead.
+func (*SpatchResp) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SpatchResp) GetCurIndex() uint64 {
+	if x != nil {
+		return x.CurIndex
+	}
+	return 0
+}
+
+func (x *SpatchResp) GetMaxIndex() uint64 {
+	if x != nil {
+		return x.MaxIndex
+	}
+	return 0
+}
+
+func (x *SpatchResp) GetOpNum() uint64 {
+	if x != nil {
+		return x.OpNum
+	}
+	return 0
+}
+
+type SschemaSetReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groupname    string `protobuf:"bytes,1,opt,name=groupname,proto3" json:"groupname,omitempty"`
+	Appname      string `protobuf:"bytes,2,opt,name=appname,proto3" json:"appname,omitempty"`
+	AppSchema    string `protobuf:"bytes,3,opt,name=app_schema,json=appSchema,proto3" json:"app_schema,omitempty"`          //empty means don't check app_config
+	SourceSchema string `protobuf:"bytes,4,opt,name=source_schema,json=sourceSchema,proto3" json:"source_schema,omitempty"` //empty means don't check source_config
+}
+
+func (x *SschemaSetReq) Reset() {
+	*x = SschemaSetReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SschemaSetReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SschemaSetReq) ProtoMessage() {}
+
+func (x *SschemaSetReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SschemaSetReq.ProtoReflect.Descriptor instead.
+func (*SschemaSetReq) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SschemaSetReq) GetGroupname() string {
+	if x != nil {
+		return x.Groupname
+	}
+	return ""
+}
+
+func (x *SschemaSetReq) GetAppname() string {
+	if x != nil {
+		return x.Appname
+	}
+	return ""
+}
+
+func (x *SschemaSetReq) GetAppSchema() string {
+	if x != nil {
+		return x.AppSchema
+	}
+	return ""
+}
+
+func (x *SschemaSetReq) GetSourceSchema() string {
+	if x != nil {
+		return x.SourceSchema
+	}
+	return ""
+}
+
+type SschemaSetResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (x *SschemaSetResp) Reset() {
+	*x = SschemaSetResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SschemaSetResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SschemaSetResp) ProtoMessage() {}
+
+func (x *SschemaSetResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SschemaSetResp.ProtoReflect.Descriptor instead.
+func (*SschemaSetResp) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SschemaSetResp) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+type SschemaGetReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groupname string `protobuf:"bytes,1,opt,name=groupname,proto3" json:"groupname,omitempty"`
+	Appname   string `protobuf:"bytes,2,opt,name=appname,proto3" json:"appname,omitempty"`
+	Index     uint64 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"` //0 means the newest
+}
+
+func (x *SschemaGetReq) Reset() {
+	*x = SschemaGetReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SschemaGetReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SschemaGetReq) ProtoMessage() {}
+
+func (x *SschemaGetReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SschemaGetReq.ProtoReflect.Descriptor instead.
+func (*SschemaGetReq) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SschemaGetReq) GetGroupname() string {
+	if x != nil {
+		return x.Groupname
+	}
+	return ""
+}
+
+func (x *SschemaGetReq) GetAppname() string {
+	if x != nil {
+		return x.Appname
+	}
+	return ""
+}
+
+func (x *SschemaGetReq) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+type SschemaGetResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index        uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	AppSchema    string `protobuf:"bytes,2,opt,name=app_schema,json=appSchema,proto3" json:"app_schema,omitempty"`
+	SourceSchema string `protobuf:"bytes,3,opt,name=source_schema,json=sourceSchema,proto3" json:"source_schema,omitempty"`
+	Ctime        uint64 `protobuf:"varint,4,opt,name=ctime,proto3" json:"ctime,omitempty"` //unix timestamp,second
+	Author       string `protobuf:"bytes,5,opt,name=author,proto3" json:"author,omitempty"`
+}
+
+func (x *SschemaGetResp) Reset() {
+	*x = SschemaGetResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SschemaGetResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SschemaGetResp) ProtoMessage() {}
+
+func (x *SschemaGetResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SschemaGetResp.ProtoReflect.Descriptor instead.
+func (*SschemaGetResp) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SschemaGetResp) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *SschemaGetResp) GetAppSchema() string {
+	if x != nil {
+		return x.AppSchema
+	}
+	return ""
+}
+
+func (x *SschemaGetResp) GetSourceSchema() string {
+	if x != nil {
+		return x.SourceSchema
+	}
+	return ""
+}
+
+func (x *SschemaGetResp) GetCtime() uint64 {
+	if x != nil {
+		return x.Ctime
+	}
+	return 0
+}
+
+func (x *SschemaGetResp) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+type SpublishReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groupname     string        `protobuf:"bytes,1,opt,name=groupname,proto3" json:"groupname,omitempty"`
+	Appname       string        `protobuf:"bytes,2,opt,name=appname,proto3" json:"appname,omitempty"`
+	Index         uint64        `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	ExpectedOpNum uint64        `protobuf:"varint,4,opt,name=expected_op_num,json=expectedOpNum,proto3" json:"expected_op_num,omitempty"` //if not 0,the publish will be rejected when it's different from the current op_num
+	Guard         *PublishGuard `protobuf:"bytes,5,opt,name=guard,proto3" json:"guard,omitempty"`                                         //optional
+}
+
+func (x *SpublishReq) Reset() {
+	*x = SpublishReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpublishReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpublishReq) ProtoMessage() {}
+
+func (x *SpublishReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpublishReq.ProtoReflect.Descriptor instead.
+func (*SpublishReq) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SpublishReq) GetGroupname() string {
+	if x != nil {
+		return x.Groupname
+	}
+	return ""
+}
+
+func (x *SpublishReq) GetAppname() string {
+	if x != nil {
+		return x.Appname
+	}
+	return ""
+}
+
+func (x *SpublishReq) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *SpublishReq) GetExpectedOpNum() uint64 {
+	if x != nil {
+		return x.ExpectedOpNum
+	}
+	return 0
+}
+
+func (x *SpublishReq) GetGuard() *PublishGuard {
+	if x != nil {
+		return x.Guard
+	}
+	return nil
+}
+
+type SpublishResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CurIndex uint64 `protobuf:"varint,1,opt,name=cur_index,json=curIndex,proto3" json:"cur_index,omitempty"`
+	MaxIndex uint64 `protobuf:"varint,2,opt,name=max_index,json=maxIndex,proto3" json:"max_index,omitempty"`
+	OpNum    uint64 `protobuf:"varint,3,opt,name=op_num,json=opNum,proto3" json:"op_num,omitempty"`
+}
+
+func (x *SpublishResp) Reset() {
+	*x = SpublishResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpublishResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpublishResp) ProtoMessage() {}
+
+func (x *SpublishResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpublishResp.ProtoReflect.Descriptor instead.
+func (*SpublishResp) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SpublishResp) GetCurIndex() uint64 {
+	if x != nil {
+		return x.CurIndex
+	}
+	return 0
+}
+
+func (x *SpublishResp) GetMaxIndex() uint64 {
+	if x != nil {
+		return x.MaxIndex
+	}
+	return 0
+}
+
+func (x *SpublishResp) GetOpNum() uint64 {
+	if x != nil {
+		return x.OpNum
+	}
+	return 0
+}
+
+type SpolicySetReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groupname string   `protobuf:"bytes,1,opt,name=groupname,proto3" json:"groupname,omitempty"`
+	Reviewers []string `protobuf:"bytes,2,rep,name=reviewers,proto3" json:"reviewers,omitempty"`
+	Approvals uint32   `protobuf:"varint,3,opt,name=approvals,proto3" json:"approvals,omitempty"` //0 means disable the approval,can't be larger than the reviewers' count
+}
+
+func (x *SpolicySetReq) Reset() {
+	*x = SpolicySetReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpolicySetReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpolicySetReq) ProtoMessage() {}
+
+func (x *SpolicySetReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpolicySetReq.ProtoReflect.Descriptor instead.
+func (*SpolicySetReq) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SpolicySetReq) GetGroupname() string {
+	if x != nil {
+		return x.Groupname
+	}
+	return ""
+}
+
+func (x *SpolicySetReq) GetReviewers() []string {
+	if x != nil {
+		return x.Reviewers
+	}
+	return nil
+}
+
+func (x *SpolicySetReq) GetApprovals() uint32 {
+	if x != nil {
+		return x.Approvals
+	}
+	return 0
+}
+
+type SpolicySetResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SpolicySetResp) Reset() {
+	*x = SpolicySetResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpolicySetResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpolicySetResp) ProtoMessage() {}
+
+func (x *SpolicySetResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpolicySetResp.ProtoReflect.Descriptor instead.
+func (*SpolicySetResp) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{32}
+}
+
+type SpolicyGetReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groupname string `protobuf:"bytes,1,opt,name=groupname,proto3" json:"groupname,omitempty"`
+}
+
+func (x *SpolicyGetReq) Reset() {
+	*x = SpolicyGetReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpolicyGetReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpolicyGetReq) ProtoMessage() {}
+
+func (x *SpolicyGetReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpolicyGetReq.ProtoReflect.Descriptor instead.
+func (*SpolicyGetReq) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SpolicyGetReq) GetGroupname() string {
+	if x != nil {
+		return x.Groupname
+	}
+	return ""
+}
+
+type SpolicyGetResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reviewers []string `protobuf:"bytes,1,rep,name=reviewers,proto3" json:"reviewers,omitempty"`
+	Approvals uint32   `protobuf:"varint,2,opt,name=approvals,proto3" json:"approvals,omitempty"` //0 means the approval is disabled
+	Ctime     uint64   `protobuf:"varint,3,opt,name=ctime,proto3" json:"ctime,omitempty"`         //unix timestamp,second
+	Author    string   `protobuf:"bytes,4,opt,name=author,proto3" json:"author,omitempty"`
+}
+
+func (x *SpolicyGetResp) Reset() {
+	*x = SpolicyGetResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpolicyGetResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpolicyGetResp) ProtoMessage() {}
+
+func (x *SpolicyGetResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpolicyGetResp.ProtoReflect.Descriptor instead.
+func (*SpolicyGetResp) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SpolicyGetResp) GetReviewers() []string {
+	if x != nil {
+		return x.Reviewers
+	}
+	return nil
+}
+
+func (x *SpolicyGetResp) GetApprovals() uint32 {
+	if x != nil {
+		return x.Approvals
+	}
+	return 0
+}
+
+func (x *SpolicyGetResp) GetCtime() uint64 {
+	if x != nil {
+		return x.Ctime
+	}
+	return 0
+}
+
+func (x *SpolicyGetResp) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+type SapproveReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groupname string `protobuf:"bytes,1,opt,name=groupname,proto3" json:"groupname,omitempty"`
+	Appname   string `protobuf:"bytes,2,opt,name=appname,proto3" json:"appname,omitempty"`
+	Index     uint64 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	Comment   string `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+}
+
+func (x *SapproveReq) Reset() {
+	*x = SapproveReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SapproveReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SapproveReq) ProtoMessage() {}
+
+func (x *SapproveReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SapproveReq.ProtoReflect.Descriptor instead.
+func (*SapproveReq) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SapproveReq) GetGroupname() string {
+	if x != nil {
+		return x.Groupname
+	}
+	return ""
+}
+
+func (x *SapproveReq) GetAppname() string {
+	if x != nil {
+		return x.Appname
+	}
+	return ""
+}
+
+func (x *SapproveReq) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *SapproveReq) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type SapproveResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Approvals uint32 `protobuf:"varint,1,opt,name=approvals,proto3" json:"approvals,omitempty"` //the valid approvals after this op
+	Required  uint32 `protobuf:"varint,2,opt,name=required,proto3" json:"required,omitempty"`
+}
+
+func (x *SapproveResp) Reset() {
+	*x = SapproveResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SapproveResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SapproveResp) ProtoMessage() {}
+
+func (x *SapproveResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SapproveResp.ProtoReflect.Descriptor instead.
+func (*SapproveResp) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SapproveResp) GetApprovals() uint32 {
+	if x != nil {
+		return x.Approvals
+	}
+	return 0
+}
+
+func (x *SapproveResp) GetRequired() uint32 {
+	if x != nil {
+		return x.Required
+	}
+	return 0
+}
+
+type SrejectReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groupname string `protobuf:"bytes,1,opt,name=groupname,proto3" json:"groupname,omitempty"`
+	Appname   string `protobuf:"bytes,2,opt,name=appname,proto3" json:"appname,omitempty"`
+	Index     uint64 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	Comment   string `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+}
+
+func (x *SrejectReq) Reset() {
+	*x = SrejectReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SrejectReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SrejectReq) ProtoMessage() {}
+
+func (x *SrejectReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SrejectReq.ProtoReflect.Descriptor instead.
+func (*SrejectReq) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SrejectReq) GetGroupname() string {
+	if x != nil {
+		return x.Groupname
+	}
+	return ""
+}
+
+func (x *SrejectReq) GetAppname() string {
+	if x != nil {
+		return x.Appname
+	}
+	return ""
+}
+
+func (x *SrejectReq) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *SrejectReq) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type SrejectResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SrejectResp) Reset() {
+	*x = SrejectResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SrejectResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SrejectResp) ProtoMessage() {}
+
+func (x *SrejectResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SrejectResp.ProtoReflect.Descriptor instead.
+func (*SrejectResp) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{38}
+}
+
+type SpendingReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groupname string `protobuf:"bytes,1,opt,name=groupname,proto3" json:"groupname,omitempty"`
+	Appname   string `protobuf:"bytes,2,opt,name=appname,proto3" json:"appname,omitempty"` //empty means all apps in this group
+}
+
+func (x *SpendingReq) Reset() {
+	*x = SpendingReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpendingReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpendingReq) ProtoMessage() {}
+
+func (x *SpendingReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpendingReq.ProtoReflect.Descriptor instead.
+func (*SpendingReq) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SpendingReq) GetGroupname() string {
+	if x != nil {
+		return x.Groupname
+	}
+	return ""
+}
+
+func (x *SpendingReq) GetAppname() string {
+	if x != nil {
+		return x.Appname
+	}
+	return ""
+}
+
+type SpendingResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Changes []*PendingInfo `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (x *SpendingResp) Reset() {
+	*x = SpendingResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpendingResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpendingResp) ProtoMessage() {}
+
+func (x *SpendingResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpendingResp.ProtoReflect.Descriptor instead.
+func (*SpendingResp) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SpendingResp) GetChanges() []*PendingInfo {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type PendingInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Appname   string   `protobuf:"bytes,1,opt,name=appname,proto3" json:"appname,omitempty"`
+	Index     uint64   `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Ctime     uint64   `protobuf:"varint,3,opt,name=ctime,proto3" json:"ctime,omitempty"` //unix timestamp,second
+	Author    string   `protobuf:"bytes,4,opt,name=author,proto3" json:"author,omitempty"`
+	Comment   string   `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
+	Approvers []string `protobuf:"bytes,6,rep,name=approvers,proto3" json:"approvers,omitempty"` //the reviewers who approved this draft
+	Required  uint32   `protobuf:"varint,7,opt,name=required,proto3" json:"required,omitempty"`
+}
+
+func (x *PendingInfo) Reset() {
+	*x = PendingInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PendingInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingInfo) ProtoMessage() {}
+
+func (x *PendingInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PendingInfo.ProtoReflect.Descriptor instead.
+func (*PendingInfo) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *PendingInfo) GetAppname() string {
+	if x != nil {
+		return x.Appname
+	}
+	return ""
+}
+
+func (x *PendingInfo) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *PendingInfo) GetCtime() uint64 {
+	if x != nil {
+		return x.Ctime
+	}
+	return 0
+}
+
+func (x *PendingInfo) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *PendingInfo) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *PendingInfo) GetApprovers() []string {
+	if x != nil {
+		return x.Approvers
+	}
+	return nil
+}
+
+func (x *PendingInfo) GetRequired() uint32 {
+	if x != nil {
+		return x.Required
+	}
+	return 0
+}
+
+type SscheduleReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groupname string `protobuf:"bytes,1,opt,name=groupname,proto3" json:"groupname,omitempty"`
+	Appname   string `protobuf:"bytes,2,opt,name=appname,proto3" json:"appname,omitempty"`
+	Index     uint64 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	FireTime  string `protobuf:"bytes,4,opt,name=fire_time,json=fireTime,proto3" json:"fire_time,omitempty"` //RFC3339,e.g. 2006-01-02T15:04:05+08:00,must be in the future
+}
+
+func (x *SscheduleReq) Reset() {
+	*x = SscheduleReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SscheduleReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SscheduleReq) ProtoMessage() {}
+
+func (x *SscheduleReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SscheduleReq.ProtoReflect.Descriptor instead.
+func (*SscheduleReq) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SscheduleReq) GetGroupname() string {
+	if x != nil {
+		return x.Groupname
+	}
+	return ""
+}
+
+func (x *SscheduleReq) GetAppname() string {
+	if x != nil {
+		return x.Appname
+	}
+	return ""
+}
+
+func (x *SscheduleReq) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *SscheduleReq) GetFireTime() string {
+	if x != nil {
+		return x.FireTime
+	}
+	return ""
+}
+
+type SscheduleResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FireTime uint64 `protobuf:"varint,2,opt,name=fire_time,json=fireTime,proto3" json:"fire_time,omitempty"` //unix timestamp,second
+}
+
+func (x *SscheduleResp) Reset() {
+	*x = SscheduleResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SscheduleResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SscheduleResp) ProtoMessage() {}
+
+func (x *SscheduleResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SscheduleResp.ProtoReflect.Descriptor instead.
+func (*SscheduleResp) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SscheduleResp) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SscheduleResp) GetFireTime() uint64 {
+	if x != nil {
+		return x.FireTime
+	}
+	return 0
+}
+
+type SscheduleListReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groupname string `protobuf:"bytes,1,opt,name=groupname,proto3" json:"groupname,omitempty"`
+	Appname   string `protobuf:"bytes,2,opt,name=appname,proto3" json:"appname,omitempty"` //empty means all apps in this group
+}
+
+func (x *SscheduleListReq) Reset() {
+	*x = SscheduleListReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SscheduleListReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SscheduleListReq) ProtoMessage() {}
+
+func (x *SscheduleListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SscheduleListReq.ProtoReflect.Descriptor instead.
+func (*SscheduleListReq) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SscheduleListReq) GetGroupname() string {
+	if x != nil {
+		return x.Groupname
+	}
+	return ""
+}
+
+func (x *SscheduleListReq) GetAppname() string {
+	if x != nil {
+		return x.Appname
+	}
+	return ""
+}
+
+type SscheduleListResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Schedules []*ScheduleInfo `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules,omitempty"`
+}
+
+func (x *SscheduleListResp) Reset() {
+	*x = SscheduleListResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SscheduleListResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SscheduleListResp) ProtoMessage() {}
+
+func (x *SscheduleListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SscheduleListResp.ProtoReflect.Descriptor instead.
+func (*SscheduleListResp) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SscheduleListResp) GetSchedules() []*ScheduleInfo {
+	if x != nil {
+		return x.Schedules
+	}
+	return nil
+}
+
+type ScheduleInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Appname  string `protobuf:"bytes,2,opt,name=appname,proto3" json:"appname,omitempty"`
+	Index    uint64 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	FireTime uint64 `protobuf:"varint,4,opt,name=fire_time,json=fireTime,proto3" json:"fire_time,omitempty"` //unix timestamp,second
+	Ctime    uint64 `protobuf:"varint,5,opt,name=ctime,proto3" json:"ctime,omitempty"`                       //unix timestamp,second
+	Author   string `protobuf:"bytes,6,opt,name=author,proto3" json:"author,omitempty"`
+}
+
+func (x *ScheduleInfo) Reset() {
+	*x = ScheduleInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduleInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleInfo) ProtoMessage() {}
+
+func (x *ScheduleInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleInfo.ProtoReflect.Descriptor instead.
+func (*ScheduleInfo) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ScheduleInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ScheduleInfo) GetAppname() string {
+	if x != nil {
+		return x.Appname
+	}
+	return ""
+}
+
+func (x *ScheduleInfo) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ScheduleInfo) GetFireTime() uint64 {
+	if x != nil {
+		return x.FireTime
+	}
+	return 0
+}
+
+func (x *ScheduleInfo) GetCtime() uint64 {
+	if x != nil {
+		return x.Ctime
+	}
+	return 0
+}
+
+func (x *ScheduleInfo) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+type SscheduleCancelReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groupname string `protobuf:"bytes,1,opt,name=groupname,proto3" json:"groupname,omitempty"`
+	Appname   string `protobuf:"bytes,2,opt,name=appname,proto3" json:"appname,omitempty"`
+	Id        string `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *SscheduleCancelReq) Reset() {
+	*x = SscheduleCancelReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SscheduleCancelReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SscheduleCancelReq) ProtoMessage() {}
+
+func (x *SscheduleCancelReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SscheduleCancelReq.ProtoReflect.Descriptor instead.
+func (*SscheduleCancelReq) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SscheduleCancelReq) GetGroupname() string {
+	if x != nil {
+		return x.Groupname
+	}
+	return ""
+}
+
+func (x *SscheduleCancelReq) GetAppname() string {
+	if x != nil {
+		return x.Appname
+	}
+	return ""
+}
+
+func (x *SscheduleCancelReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type SscheduleCancelResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SscheduleCancelResp) Reset() {
+	*x = SscheduleCancelResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SscheduleCancelResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SscheduleCancelResp) ProtoMessage() {}
+
+func (x *SscheduleCancelResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SscheduleCancelResp.ProtoReflect.Descriptor instead.
+func (*SscheduleCancelResp) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{48}
+}
+
+type ScanaryReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groupname     string   `protobuf:"bytes,1,opt,name=groupname,proto3" json:"groupname,omitempty"`
+	Appname       string   `protobuf:"bytes,2,opt,name=appname,proto3" json:"appname,omitempty"`
+	Index         uint64   `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	Percent       uint32   `protobuf:"varint,4,opt,name=percent,proto3" json:"percent,omitempty"`                                    //0-100,the instances are picked by the hash of the instance and the index
+	Hostnames     []string `protobuf:"bytes,5,rep,name=hostnames,proto3" json:"hostnames,omitempty"`                                 //the instances in this list will get the canary
+	Labels        []string `protobuf:"bytes,6,rep,name=labels,proto3" json:"labels,omitempty"`                                       //the instances have any of these labels will get the canary
+	ExpectedOpNum uint64   `protobuf:"varint,7,opt,name=expected_op_num,json=expectedOpNum,proto3" json:"expected_op_num,omitempty"` //if not 0,the canary will be rejected when it's different from the current op_num
+}
+
+func (x *ScanaryReq) Reset() {
+	*x = ScanaryReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScanaryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanaryReq) ProtoMessage() {}
+
+func (x *ScanaryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanaryReq.ProtoReflect.Descriptor instead.
+func (*ScanaryReq) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ScanaryReq) GetGroupname() string {
+	if x != nil {
+		return x.Groupname
+	}
+	return ""
+}
+
+func (x *ScanaryReq) GetAppname() string {
+	if x != nil {
+		return x.Appname
+	}
+	return ""
+}
+
+func (x *ScanaryReq) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ScanaryReq) GetPercent() uint32 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *ScanaryReq) GetHostnames() []string {
+	if x != nil {
+		return x.Hostnames
+	}
+	return nil
+}
+
+func (x *ScanaryReq) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *ScanaryReq) GetExpectedOpNum() uint64 {
+	if x != nil {
+		return x.ExpectedOpNum
+	}
+	return 0
+}
+
+type ScanaryResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OpNum uint64 `protobuf:"varint,1,opt,name=op_num,json=opNum,proto3" json:"op_num,omitempty"`
+}
+
+func (x *ScanaryResp) Reset() {
+	*x = ScanaryResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScanaryResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanaryResp) ProtoMessage() {}
+
+func (x *ScanaryResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanaryResp.ProtoReflect.Descriptor instead.
+func (*ScanaryResp) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ScanaryResp) GetOpNum() uint64 {
+	if x != nil {
+		return x.OpNum
+	}
+	return 0
+}
+
+type ScanaryPromoteReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groupname     string `protobuf:"bytes,1,opt,name=groupname,proto3" json:"groupname,omitempty"`
+	Appname       string `protobuf:"bytes,2,opt,name=appname,proto3" json:"appname,omitempty"`
+	ExpectedOpNum uint64 `protobuf:"varint,3,opt,name=expected_op_num,json=expectedOpNum,proto3" json:"expected_op_num,omitempty"` //if not 0,the promote will be rejected when it's different from the current op_num
+}
+
+func (x *ScanaryPromoteReq) Reset() {
+	*x = ScanaryPromoteReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScanaryPromoteReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanaryPromoteReq) ProtoMessage() {}
+
+func (x *ScanaryPromoteReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanaryPromoteReq.ProtoReflect.Descriptor instead.
+func (*ScanaryPromoteReq) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ScanaryPromoteReq) GetGroupname() string {
+	if x != nil {
+		return x.Groupname
+	}
+	return ""
+}
+
+func (x *ScanaryPromoteReq) GetAppname() string {
+	if x != nil {
+		return x.Appname
+	}
+	return ""
+}
+
+func (x *ScanaryPromoteReq) GetExpectedOpNum() uint64 {
+	if x != nil {
+		return x.ExpectedOpNum
+	}
+	return 0
+}
+
+type ScanaryPromoteResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CurIndex uint64 `protobuf:"varint,1,opt,name=cur_index,json=curIndex,proto3" json:"cur_index,omitempty"`
+	MaxIndex uint64 `protobuf:"varint,2,opt,name=max_index,json=maxIndex,proto3" json:"max_index,omitempty"`
+	OpNum    uint64 `protobuf:"varint,3,opt,name=op_num,json=opNum,proto3" json:"op_num,omitempty"`
+}
+
+func (x *ScanaryPromoteResp) Reset() {
+	*x = ScanaryPromoteResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScanaryPromoteResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanaryPromoteResp) ProtoMessage() {}
+
+func (x *ScanaryPromoteResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanaryPromoteResp.ProtoReflect.Descriptor instead.
+func (*ScanaryPromoteResp) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ScanaryPromoteResp) GetCurIndex() uint64 {
+	if x != nil {
+		return x.CurIndex
+	}
+	return 0
+}
+
+func (x *ScanaryPromoteResp) GetMaxIndex() uint64 {
+	if x != nil {
+		return x.MaxIndex
+	}
+	return 0
+}
+
+func (x *ScanaryPromoteResp) GetOpNum() uint64 {
+	if x != nil {
+		return x.OpNum
+	}
+	return 0
+}
+
+type ScanaryAbortReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groupname     string `protobuf:"bytes,1,opt,name=groupname,proto3" json:"groupname,omitempty"`
+	Appname       string `protobuf:"bytes,2,opt,name=appname,proto3" json:"appname,omitempty"`
+	ExpectedOpNum uint64 `protobuf:"varint,3,opt,name=expected_op_num,json=expectedOpNum,proto3" json:"expected_op_num,omitempty"` //if not 0,the abort will be rejected when it's different from the current op_num
+}
+
+func (x *ScanaryAbortReq) Reset() {
+	*x = ScanaryAbortReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScanaryAbortReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanaryAbortReq) ProtoMessage() {}
+
+func (x *ScanaryAbortReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanaryAbortReq.ProtoReflect.Descriptor instead.
+func (*ScanaryAbortReq) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ScanaryAbortReq) GetGroupname() string {
+	if x != nil {
+		return x.Groupname
+	}
+	return ""
+}
+
+func (x *ScanaryAbortReq) GetAppname() string {
+	if x != nil {
+		return x.Appname
+	}
+	return ""
+}
+
+func (x *ScanaryAbortReq) GetExpectedOpNum() uint64 {
+	if x != nil {
+		return x.ExpectedOpNum
+	}
+	return 0
+}
+
+type ScanaryAbortResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OpNum uint64 `protobuf:"varint,1,opt,name=op_num,json=opNum,proto3" json:"op_num,omitempty"`
+}
+
+func (x *ScanaryAbortResp) Reset() {
+	*x = ScanaryAbortResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScanaryAbortResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanaryAbortResp) ProtoMessage() {}
+
+func (x *ScanaryAbortResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanaryAbortResp.ProtoReflect.Descriptor instead.
+func (*ScanaryAbortResp) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ScanaryAbortResp) GetOpNum() uint64 {
+	if x != nil {
+		return x.OpNum
+	}
+	return 0
+}
+
+type SreportReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groupname string `protobuf:"bytes,1,opt,name=groupname,proto3" json:"groupname,omitempty"`
+	Appname   string `protobuf:"bytes,2,opt,name=appname,proto3" json:"appname,omitempty"`
+	Instance  string `protobuf:"bytes,3,opt,name=instance,proto3" json:"instance,omitempty"`         //the caller's hostname or instance id
+	OpNum     uint64 `protobuf:"varint,4,opt,name=op_num,json=opNum,proto3" json:"op_num,omitempty"` //the op_num in swatch's resp
+	Index     uint64 `protobuf:"varint,5,opt,name=index,proto3" json:"index,omitempty"`              //the cur_index in swatch's resp
+	Canary    bool   `protobuf:"varint,6,opt,name=canary,proto3" json:"canary,omitempty"`            //the canary in swatch's resp
+	Error     string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`               //empty means applied success
+	Health    string `protobuf:"bytes,8,opt,name=health,proto3" json:"health,omitempty"`             //the app defined health check's failure,empty means healthy
+}
+
+func (x *SreportReq) Reset() {
+	*x = SreportReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SreportReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SreportReq) ProtoMessage() {}
+
+func (x *SreportReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SreportReq.ProtoReflect.Descriptor instead.
+func (*SreportReq) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *SreportReq) GetGroupname() string {
+	if x != nil {
+		return x.Groupname
+	}
+	return ""
+}
+
+func (x *SreportReq) GetAppname() string {
+	if x != nil {
+		return x.Appname
+	}
+	return ""
+}
+
+func (x *SreportReq) GetInstance() string {
+	if x != nil {
+		return x.Instance
+	}
+	return ""
+}
+
+func (x *SreportReq) GetOpNum() uint64 {
+	if x != nil {
+		return x.OpNum
+	}
+	return 0
+}
+
+func (x *SreportReq) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *SreportReq) GetCanary() bool {
+	if x != nil {
+		return x.Canary
+	}
+	return false
+}
+
+func (x *SreportReq) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *SreportReq) GetHealth() string {
+	if x != nil {
+		return x.Health
+	}
+	return ""
+}
+
+type SreportResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SreportResp) Reset() {
+	*x = SreportResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SreportResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SreportResp) ProtoMessage() {}
+
+func (x *SreportResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SreportResp.ProtoReflect.Descriptor instead.
+func (*SreportResp) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{56}
+}
+
+type SrolloutReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groupname string `protobuf:"bytes,1,opt,name=groupname,proto3" json:"groupname,omitempty"`
+	Appname   string `protobuf:"bytes,2,opt,name=appname,proto3" json:"appname,omitempty"`
+}
+
+func (x *SrolloutReq) Reset() {
+	*x = SrolloutReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SrolloutReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SrolloutReq) ProtoMessage() {}
+
+func (x *SrolloutReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SrolloutReq.ProtoReflect.Descriptor instead.
+func (*SrolloutReq) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *SrolloutReq) GetGroupname() string {
+	if x != nil {
+		return x.Groupname
+	}
+	return ""
+}
+
+func (x *SrolloutReq) GetAppname() string {
+	if x != nil {
+		return x.Appname
+	}
+	return ""
+}
+
+type SrolloutResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CurIndex    uint64         `protobuf:"varint,1,opt,name=cur_index,json=curIndex,proto3" json:"cur_index,omitempty"`
+	OpNum       uint64         `protobuf:"varint,2,opt,name=op_num,json=opNum,proto3" json:"op_num,omitempty"`
+	CanaryIndex uint64         `protobuf:"varint,3,opt,name=canary_index,json=canaryIndex,proto3" json:"canary_index,omitempty"` //0 means no canary
+	Applied     uint32         `protobuf:"varint,4,opt,name=applied,proto3" json:"applied,omitempty"`                            //the instances applied the current op_num success
+	Failed      uint32         `protobuf:"varint,5,opt,name=failed,proto3" json:"failed,omitempty"`                              //the instances failed on their last apply
+	Stale       uint32         `protobuf:"varint,6,opt,name=stale,proto3" json:"stale,omitempty"`                                //the instances applied success but on an old op_num
+	Instances   []*RolloutInfo `protobuf:"bytes,7,rep,name=instances,proto3" json:"instances,omitempty"`
+}
+
+func (x *SrolloutResp) Reset() {
+	*x = SrolloutResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SrolloutResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SrolloutResp) ProtoMessage() {}
+
+func (x *SrolloutResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SrolloutResp.ProtoReflect.Descriptor instead.
+func (*SrolloutResp) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *SrolloutResp) GetCurIndex() uint64 {
+	if x != nil {
+		return x.CurIndex
+	}
+	return 0
+}
+
+func (x *SrolloutResp) GetOpNum() uint64 {
+	if x != nil {
+		return x.OpNum
+	}
+	return 0
+}
+
+func (x *SrolloutResp) GetCanaryIndex() uint64 {
+	if x != nil {
+		return x.CanaryIndex
+	}
+	return 0
+}
+
+func (x *SrolloutResp) GetApplied() uint32 {
+	if x != nil {
+		return x.Applied
+	}
+	return 0
+}
+
+func (x *SrolloutResp) GetFailed() uint32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *SrolloutResp) GetStale() uint32 {
+	if x != nil {
+		return x.Stale
+	}
+	return 0
+}
+
+func (x *SrolloutResp) GetInstances() []*RolloutInfo {
+	if x != nil {
+		return x.Instances
+	}
+	return nil
+}
+
+type RolloutInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Instance string `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
+	OpNum    uint64 `protobuf:"varint,2,opt,name=op_num,json=opNum,proto3" json:"op_num,omitempty"`
+	Index    uint64 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	Canary   bool   `protobuf:"varint,4,opt,name=canary,proto3" json:"canary,omitempty"`
+	Error    string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`   //empty means applied success
+	Utime    uint64 `protobuf:"varint,6,opt,name=utime,proto3" json:"utime,omitempty"`  //unix timestamp,second
+	Health   string `protobuf:"bytes,7,opt,name=health,proto3" json:"health,omitempty"` //the app defined health check's failure,empty means healthy
+}
+
+func (x *RolloutInfo) Reset() {
+	*x = RolloutInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RolloutInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolloutInfo) ProtoMessage() {}
+
+func (x *RolloutInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RolloutInfo.ProtoReflect.Descriptor instead.
+func (*RolloutInfo) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *RolloutInfo) GetInstance() string {
+	if x != nil {
+		return x.Instance
+	}
+	return ""
+}
+
+func (x *RolloutInfo) GetOpNum() uint64 {
+	if x != nil {
+		return x.OpNum
+	}
+	return 0
+}
+
+func (x *RolloutInfo) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *RolloutInfo) GetCanary() bool {
+	if x != nil {
+		return x.Canary
+	}
+	return false
+}
+
+func (x *RolloutInfo) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *RolloutInfo) GetUtime() uint64 {
+	if x != nil {
+		return x.Utime
+	}
+	return 0
+}
+
+func (x *RolloutInfo) GetHealth() string {
+	if x != nil {
+		return x.Health
+	}
+	return ""
+}
+
+//the server will rollback to the previous version automatically
+//when more than fail_percent of the reported instances failed to apply or reported unhealthy within the window
+type PublishGuard struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FailPercent uint32 `protobuf:"varint,1,opt,name=fail_percent,json=failPercent,proto3" json:"fail_percent,omitempty"` //0 means disable the guard,max is 100
+	Window      uint64 `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty"`                              //unit second,the guard only works within this time after the publish
+	MinReports  uint32 `protobuf:"varint,3,opt,name=min_reports,json=minReports,proto3" json:"min_reports,omitempty"`    //the guard only works after at least this many instances reported,0 means 1
+}
+
+func (x *PublishGuard) Reset() {
+	*x = PublishGuard{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishGuard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishGuard) ProtoMessage() {}
+
+func (x *PublishGuard) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishGuard.ProtoReflect.Descriptor instead.
+func (*PublishGuard) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *PublishGuard) GetFailPercent() uint32 {
+	if x != nil {
+		return x.FailPercent
+	}
+	return 0
+}
+
+func (x *PublishGuard) GetWindow() uint64 {
+	if x != nil {
+		return x.Window
+	}
+	return 0
+}
+
+func (x *PublishGuard) GetMinReports() uint32 {
+	if x != nil {
+		return x.MinReports
+	}
+	return 0
+}
+
+type SdeleteReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groupname string `protobuf:"bytes,1,opt,name=groupname,proto3" json:"groupname,omitempty"`
+	Appname   string `protobuf:"bytes,2,opt,name=appname,proto3" json:"appname,omitempty"` //empty means all apps in this group
+}
+
+func (x *SdeleteReq) Reset() {
+	*x = SdeleteReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SdeleteReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SdeleteReq) ProtoMessage() {}
+
+func (x *SdeleteReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SdeleteReq.ProtoReflect.Descriptor instead.
+func (*SdeleteReq) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *SdeleteReq) GetGroupname() string {
+	if x != nil {
+		return x.Groupname
+	}
+	return ""
+}
+
+func (x *SdeleteReq) GetAppname() string {
+	if x != nil {
+		return x.Appname
+	}
+	return ""
+}
+
+type SdeleteResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Appnames []string `protobuf:"bytes,1,rep,name=appnames,proto3" json:"appnames,omitempty"` //the deleted apps
+}
+
+func (x *SdeleteResp) Reset() {
+	*x = SdeleteResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SdeleteResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SdeleteResp) ProtoMessage() {}
+
+func (x *SdeleteResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SdeleteResp.ProtoReflect.Descriptor instead.
+func (*SdeleteResp) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *SdeleteResp) GetAppnames() []string {
+	if x != nil {
+		return x.Appnames
+	}
+	return nil
+}
+
+type SrestoreReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groupname string `protobuf:"bytes,1,opt,name=groupname,proto3" json:"groupname,omitempty"`
+	Appname   string `protobuf:"bytes,2,opt,name=appname,proto3" json:"appname,omitempty"` //empty means all deleted apps in this group
+}
+
+func (x *SrestoreReq) Reset() {
+	*x = SrestoreReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SrestoreReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SrestoreReq) ProtoMessage() {}
+
+func (x *SrestoreReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SrestoreReq.ProtoReflect.Descriptor instead.
+func (*SrestoreReq) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *SrestoreReq) GetGroupname() string {
+	if x != nil {
+		return x.Groupname
+	}
+	return ""
+}
+
+func (x *SrestoreReq) GetAppname() string {
+	if x != nil {
+		return x.Appname
+	}
+	return ""
+}
+
+type SrestoreResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Appnames []string `protobuf:"bytes,1,rep,name=appnames,proto3" json:"appnames,omitempty"` //the restored apps
+}
+
+func (x *SrestoreResp) Reset() {
+	*x = SrestoreResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SrestoreResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SrestoreResp) ProtoMessage() {}
+
+func (x *SrestoreResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SrestoreResp.ProtoReflect.Descriptor instead.
+func (*SrestoreResp) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *SrestoreResp) GetAppnames() []string {
+	if x != nil {
+		return x.Appnames
+	}
+	return nil
+}
+
+type SpurgeReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groupname string `protobuf:"bytes,1,opt,name=groupname,proto3" json:"groupname,omitempty"`
+	Appname   string `protobuf:"bytes,2,opt,name=appname,proto3" json:"appname,omitempty"` //empty means all deleted apps in this group
+}
+
+func (x *SpurgeReq) Reset() {
+	*x = SpurgeReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpurgeReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpurgeReq) ProtoMessage() {}
+
+func (x *SpurgeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpurgeReq.ProtoReflect.Descriptor instead.
+func (*SpurgeReq) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *SpurgeReq) GetGroupname() string {
+	if x != nil {
+		return x.Groupname
+	}
+	return ""
+}
+
+func (x *SpurgeReq) GetAppname() string {
+	if x != nil {
+		return x.Appname
+	}
+	return ""
+}
+
+type SpurgeResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Appnames []string `protobuf:"bytes,1,rep,name=appnames,proto3" json:"appnames,omitempty"` //the purged apps
+}
+
+func (x *SpurgeResp) Reset() {
+	*x = SpurgeResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpurgeResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpurgeResp) ProtoMessage() {}
+
+func (x *SpurgeResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpurgeResp.ProtoReflect.Descriptor instead.
+func (*SpurgeResp) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *SpurgeResp) GetAppnames() []string {
+	if x != nil {
+		return x.Appnames
+	}
+	return nil
+}
+
+type SrecycleReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groupname string `protobuf:"bytes,1,opt,name=groupname,proto3" json:"groupname,omitempty"` //empty means all groups
+}
+
+func (x *SrecycleReq) Reset() {
+	*x = SrecycleReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SrecycleReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SrecycleReq) ProtoMessage() {}
+
+func (x *SrecycleReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SrecycleReq.ProtoReflect.Descriptor instead.
+func (*SrecycleReq) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *SrecycleReq) GetGroupname() string {
+	if x != nil {
+		return x.Groupname
+	}
+	return ""
+}
+
+type SrecycleResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Apps []*RecycleInfo `protobuf:"bytes,1,rep,name=apps,proto3" json:"apps,omitempty"`
+}
+
+func (x *SrecycleResp) Reset() {
+	*x = SrecycleResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SrecycleResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SrecycleResp) ProtoMessage() {}
+
+func (x *SrecycleResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SrecycleResp.ProtoReflect.Descriptor instead.
+func (*SrecycleResp) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *SrecycleResp) GetApps() []*RecycleInfo {
+	if x != nil {
+		return x.Apps
+	}
+	return nil
+}
+
+type RecycleInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groupname string `protobuf:"bytes,1,opt,name=groupname,proto3" json:"groupname,omitempty"`
+	Appname   string `protobuf:"bytes,2,opt,name=appname,proto3" json:"appname,omitempty"`
+	Dtime     uint64 `protobuf:"varint,3,opt,name=dtime,proto3" json:"dtime,omitempty"`                          //unix timestamp,second
+	PurgeTime uint64 `protobuf:"varint,4,opt,name=purge_time,json=purgeTime,proto3" json:"purge_time,omitempty"` //unix timestamp,second,the app will be purged automatically after this time
+}
+
+func (x *RecycleInfo) Reset() {
+	*x = RecycleInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecycleInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecycleInfo) ProtoMessage() {}
+
+func (x *RecycleInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecycleInfo.ProtoReflect.Descriptor instead.
+func (*RecycleInfo) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *RecycleInfo) GetGroupname() string {
+	if x != nil {
+		return x.Groupname
+	}
+	return ""
+}
+
+func (x *RecycleInfo) GetAppname() string {
+	if x != nil {
+		return x.Appname
+	}
+	return ""
+}
+
+func (x *RecycleInfo) GetDtime() uint64 {
+	if x != nil {
+		return x.Dtime
+	}
+	return 0
+}
+
+func (x *RecycleInfo) GetPurgeTime() uint64 {
+	if x != nil {
+		return x.PurgeTime
+	}
+	return 0
+}
+
+type StagReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groupname string `protobuf:"bytes,1,opt,name=groupname,proto3" json:"groupname,omitempty"`
+	Appname   string `protobuf:"bytes,2,opt,name=appname,proto3" json:"appname,omitempty"`
+	Index     uint64 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	Tag       string `protobuf:"bytes,4,opt,name=tag,proto3" json:"tag,omitempty"` //empty means remove the tag,max 64 bytes
+}
+
+func (x *StagReq) Reset() {
+	*x = StagReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StagReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StagReq) ProtoMessage() {}
+
+func (x *StagReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StagReq.ProtoReflect.Descriptor instead.
+func (*StagReq) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *StagReq) GetGroupname() string {
+	if x != nil {
+		return x.Groupname
+	}
+	return ""
+}
+
+func (x *StagReq) GetAppname() string {
+	if x != nil {
+		return x.Appname
+	}
+	return ""
+}
+
+func (x *StagReq) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *StagReq) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+type StagResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StagResp) Reset() {
+	*x = StagResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StagResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StagResp) ProtoMessage() {}
+
+func (x *StagResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StagResp.ProtoReflect.Descriptor instead.
+func (*StagResp) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{71}
+}
+
+type SretentionSetReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groupname string `protobuf:"bytes,1,opt,name=groupname,proto3" json:"groupname,omitempty"`
+	KeepNum   uint32 `protobuf:"varint,2,opt,name=keep_num,json=keepNum,proto3" json:"keep_num,omitempty"`    //keep the newest keep_num versions,0 means not use this limit
+	KeepTime  uint64 `protobuf:"varint,3,opt,name=keep_time,json=keepTime,proto3" json:"keep_time,omitempty"` //unit second,keep the versions younger than keep_time,0 means not use this limit
+}
+
+func (x *SretentionSetReq) Reset() {
+	*x = SretentionSetReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SretentionSetReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SretentionSetReq) ProtoMessage() {}
+
+func (x *SretentionSetReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SretentionSetReq.ProtoReflect.Descriptor instead.
+func (*SretentionSetReq) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *SretentionSetReq) GetGroupname() string {
+	if x != nil {
+		return x.Groupname
+	}
+	return ""
+}
+
+func (x *SretentionSetReq) GetKeepNum() uint32 {
+	if x != nil {
+		return x.KeepNum
+	}
+	return 0
+}
+
+func (x *SretentionSetReq) GetKeepTime() uint64 {
+	if x != nil {
+		return x.KeepTime
+	}
+	return 0
+}
+
+type SretentionSetResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SretentionSetResp) Reset() {
+	*x = SretentionSetResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SretentionSetResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SretentionSetResp) ProtoMessage() {}
+
+func (x *SretentionSetResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SretentionSetResp.ProtoReflect.Descriptor instead.
+func (*SretentionSetResp) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{73}
+}
+
+type SretentionGetReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groupname string `protobuf:"bytes,1,opt,name=groupname,proto3" json:"groupname,omitempty"`
+}
+
+func (x *SretentionGetReq) Reset() {
+	*x = SretentionGetReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SretentionGetReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SretentionGetReq) ProtoMessage() {}
+
+func (x *SretentionGetReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SretentionGetReq.ProtoReflect.Descriptor instead.
+func (*SretentionGetReq) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *SretentionGetReq) GetGroupname() string {
+	if x != nil {
+		return x.Groupname
+	}
+	return ""
+}
+
+type SretentionGetResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KeepNum  uint32 `protobuf:"varint,1,opt,name=keep_num,json=keepNum,proto3" json:"keep_num,omitempty"`
+	KeepTime uint64 `protobuf:"varint,2,opt,name=keep_time,json=keepTime,proto3" json:"keep_time,omitempty"` //unit second
+	Ctime    uint64 `protobuf:"varint,3,opt,name=ctime,proto3" json:"ctime,omitempty"`                       //unix timestamp,second
+	Author   string `protobuf:"bytes,4,opt,name=author,proto3" json:"author,omitempty"`
+}
+
+func (x *SretentionGetResp) Reset() {
+	*x = SretentionGetResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SretentionGetResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SretentionGetResp) ProtoMessage() {}
+
+func (x *SretentionGetResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SretentionGetResp.ProtoReflect.Descriptor instead.
+func (*SretentionGetResp) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *SretentionGetResp) GetKeepNum() uint32 {
+	if x != nil {
+		return x.KeepNum
+	}
+	return 0
+}
+
+func (x *SretentionGetResp) GetKeepTime() uint64 {
+	if x != nil {
+		return x.KeepTime
+	}
+	return 0
+}
+
+func (x *SretentionGetResp) GetCtime() uint64 {
+	if x != nil {
+		return x.Ctime
+	}
+	return 0
+}
+
+func (x *SretentionGetResp) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+type SprincipalSetReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` //can't contain '.',max 64 bytes,root is reserved
+}
+
+func (x *SprincipalSetReq) Reset() {
+	*x = SprincipalSetReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SprincipalSetReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SprincipalSetReq) ProtoMessage() {}
+
+func (x *SprincipalSetReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SprincipalSetReq.ProtoReflect.Descriptor instead.
+func (*SprincipalSetReq) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *SprincipalSetReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type SprincipalSetResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"` //pass this by the Authorization metadata(rpc) or header(web,Bearer <token>)
+}
+
+func (x *SprincipalSetResp) Reset() {
+	*x = SprincipalSetResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SprincipalSetResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SprincipalSetResp) ProtoMessage() {}
+
+func (x *SprincipalSetResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SprincipalSetResp.ProtoReflect.Descriptor instead.
+func (*SprincipalSetResp) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *SprincipalSetResp) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type SprincipalDelReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *SprincipalDelReq) Reset() {
+	*x = SprincipalDelReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SprincipalDelReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SprincipalDelReq) ProtoMessage() {}
+
+func (x *SprincipalDelReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SprincipalDelReq.ProtoReflect.Descriptor instead.
+func (*SprincipalDelReq) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *SprincipalDelReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type SprincipalDelResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SprincipalDelResp) Reset() {
+	*x = SprincipalDelResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SprincipalDelResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SprincipalDelResp) ProtoMessage() {}
+
+func (x *SprincipalDelResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SprincipalDelResp.ProtoReflect.Descriptor instead.
+func (*SprincipalDelResp) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{79}
+}
+
+type SprincipalsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SprincipalsReq) Reset() {
+	*x = SprincipalsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SprincipalsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SprincipalsReq) ProtoMessage() {}
+
+func (x *SprincipalsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SprincipalsReq.ProtoReflect.Descriptor instead.
+func (*SprincipalsReq) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{80}
+}
+
+type SprincipalsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Principals []*PrincipalInfo `protobuf:"bytes,1,rep,name=principals,proto3" json:"principals,omitempty"`
+}
+
+func (x *SprincipalsResp) Reset() {
+	*x = SprincipalsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SprincipalsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SprincipalsResp) ProtoMessage() {}
+
+func (x *SprincipalsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SprincipalsResp.ProtoReflect.Descriptor instead.
+func (*SprincipalsResp) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *SprincipalsResp) GetPrincipals() []*PrincipalInfo {
+	if x != nil {
+		return x.Principals
+	}
+	return nil
+}
+
+type PrincipalInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Ctime  uint64 `protobuf:"varint,2,opt,name=ctime,proto3" json:"ctime,omitempty"` //unix timestamp,second
+	Author string `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+}
+
+func (x *PrincipalInfo) Reset() {
+	*x = PrincipalInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrincipalInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrincipalInfo) ProtoMessage() {}
+
+func (x *PrincipalInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrincipalInfo.ProtoReflect.Descriptor instead.
+func (*PrincipalInfo) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *PrincipalInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PrincipalInfo) GetCtime() uint64 {
+	if x != nil {
+		return x.Ctime
+	}
+	return 0
+}
+
+func (x *PrincipalInfo) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+type SgrantReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Principal string `protobuf:"bytes,1,opt,name=principal,proto3" json:"principal,omitempty"`
+	Groupname string `protobuf:"bytes,2,opt,name=groupname,proto3" json:"groupname,omitempty"` //empty means all groups
+	Appname   string `protobuf:"bytes,3,opt,name=appname,proto3" json:"appname,omitempty"`     //empty means all apps in the group,must be empty when groupname is empty
+	Role      string `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`           //viewer,editor,publisher,admin,empty means revoke
+	Reveal    bool   `protobuf:"varint,5,opt,name=reveal,proto3" json:"reveal,omitempty"`      //can see the redacted values on the scope
+}
+
+func (x *SgrantReq) Reset() {
+	*x = SgrantReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SgrantReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SgrantReq) ProtoMessage() {}
+
+func (x *SgrantReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SgrantReq.ProtoReflect.Descriptor instead.
+func (*SgrantReq) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *SgrantReq) GetPrincipal() string {
+	if x != nil {
+		return x.Principal
+	}
+	return ""
+}
+
+func (x *SgrantReq) GetGroupname() string {
+	if x != nil {
+		return x.Groupname
+	}
+	return ""
+}
+
+func (x *SgrantReq) GetAppname() string {
+	if x != nil {
+		return x.Appname
+	}
+	return ""
+}
+
+func (x *SgrantReq) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *SgrantReq) GetReveal() bool {
+	if x != nil {
+		return x.Reveal
+	}
+	return false
+}
+
+type SgrantResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SgrantResp) Reset() {
+	*x = SgrantResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SgrantResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SgrantResp) ProtoMessage() {}
+
+func (x *SgrantResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SgrantResp.ProtoReflect.Descriptor instead.
+func (*SgrantResp) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{84}
+}
+
+type SgrantsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Principal string `protobuf:"bytes,1,opt,name=principal,proto3" json:"principal,omitempty"` //empty means all principals
+	Groupname string `protobuf:"bytes,2,opt,name=groupname,proto3" json:"groupname,omitempty"` //empty means all groups,only the global admin can do this
+}
+
+func (x *SgrantsReq) Reset() {
+	*x = SgrantsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SgrantsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SgrantsReq) ProtoMessage() {}
+
+func (x *SgrantsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SgrantsReq.ProtoReflect.Descriptor instead.
+func (*SgrantsReq) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *SgrantsReq) GetPrincipal() string {
+	if x != nil {
+		return x.Principal
+	}
+	return ""
+}
+
+func (x *SgrantsReq) GetGroupname() string {
+	if x != nil {
+		return x.Groupname
+	}
+	return ""
+}
+
+type SgrantsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Grants []*GrantInfo `protobuf:"bytes,1,rep,name=grants,proto3" json:"grants,omitempty"`
+}
+
+func (x *SgrantsResp) Reset() {
+	*x = SgrantsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SgrantsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SgrantsResp) ProtoMessage() {}
+
+func (x *SgrantsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SgrantsResp.ProtoReflect.Descriptor instead.
+func (*SgrantsResp) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *SgrantsResp) GetGrants() []*GrantInfo {
+	if x != nil {
+		return x.Grants
+	}
+	return nil
+}
+
+type GrantInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Principal string `protobuf:"bytes,1,opt,name=principal,proto3" json:"principal,omitempty"`
+	Groupname string `protobuf:"bytes,2,opt,name=groupname,proto3" json:"groupname,omitempty"`
+	Appname   string `protobuf:"bytes,3,opt,name=appname,proto3" json:"appname,omitempty"`
+	Role      string `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	Ctime     uint64 `protobuf:"varint,5,opt,name=ctime,proto3" json:"ctime,omitempty"` //unix timestamp,second
+	Author    string `protobuf:"bytes,6,opt,name=author,proto3" json:"author,omitempty"`
+	Reveal    bool   `protobuf:"varint,7,opt,name=reveal,proto3" json:"reveal,omitempty"`
+}
+
+func (x *GrantInfo) Reset() {
+	*x = GrantInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GrantInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantInfo) ProtoMessage() {}
+
+func (x *GrantInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantInfo.ProtoReflect.Descriptor instead.
+func (*GrantInfo) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *GrantInfo) GetPrincipal() string {
+	if x != nil {
+		return x.Principal
+	}
+	return ""
+}
+
+func (x *GrantInfo) GetGroupname() string {
+	if x != nil {
+		return x.Groupname
+	}
+	return ""
+}
+
+func (x *GrantInfo) GetAppname() string {
+	if x != nil {
+		return x.Appname
+	}
+	return ""
+}
+
+func (x *GrantInfo) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *GrantInfo) GetCtime() uint64 {
+	if x != nil {
+		return x.Ctime
+	}
+	return 0
+}
+
+func (x *GrantInfo) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *GrantInfo) GetReveal() bool {
+	if x != nil {
+		return x.Reveal
+	}
+	return false
+}
+
+type StokenIssueReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groupname string `protobuf:"bytes,1,opt,name=groupname,proto3" json:"groupname,omitempty"`
+	Appname   string `protobuf:"bytes,2,opt,name=appname,proto3" json:"appname,omitempty"`
+	Comment   string `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	Expire    uint64 `protobuf:"varint,4,opt,name=expire,proto3" json:"expire,omitempty"` //unix timestamp,second,0 means never expire
+}
+
+func (x *StokenIssueReq) Reset() {
+	*x = StokenIssueReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StokenIssueReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StokenIssueReq) ProtoMessage() {}
+
+func (x *StokenIssueReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StokenIssueReq.ProtoReflect.Descriptor instead.
+func (*StokenIssueReq) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *StokenIssueReq) GetGroupname() string {
+	if x != nil {
+		return x.Groupname
+	}
+	return ""
+}
+
+func (x *StokenIssueReq) GetAppname() string {
+	if x != nil {
+		return x.Appname
+	}
+	return ""
+}
+
+func (x *StokenIssueReq) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *StokenIssueReq) GetExpire() uint64 {
+	if x != nil {
+		return x.Expire
+	}
+	return 0
+}
+
+type StokenIssueResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"` //pass this like the principal's token
+}
+
+func (x *StokenIssueResp) Reset() {
+	*x = StokenIssueResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StokenIssueResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StokenIssueResp) ProtoMessage() {}
+
+func (x *StokenIssueResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StokenIssueResp.ProtoReflect.Descriptor instead.
+func (*StokenIssueResp) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *StokenIssueResp) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StokenIssueResp) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type StokenRotateReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groupname string `protobuf:"bytes,1,opt,name=groupname,proto3" json:"groupname,omitempty"`
+	Appname   string `protobuf:"bytes,2,opt,name=appname,proto3" json:"appname,omitempty"`
+	Id        string `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`           //the old token's id
+	Grace     uint64 `protobuf:"varint,4,opt,name=grace,proto3" json:"grace,omitempty"`    //unit second,the old token can still be used in this time,0 means revoke the old token at once
+	Comment   string `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"` //the new token's comment,empty means use the old token's comment
+}
+
+func (x *StokenRotateReq) Reset() {
+	*x = StokenRotateReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StokenRotateReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StokenRotateReq) ProtoMessage() {}
+
+func (x *StokenRotateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StokenRotateReq.ProtoReflect.Descriptor instead.
+func (*StokenRotateReq) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *StokenRotateReq) GetGroupname() string {
+	if x != nil {
+		return x.Groupname
+	}
+	return ""
+}
+
+func (x *StokenRotateReq) GetAppname() string {
+	if x != nil {
+		return x.Appname
+	}
+	return ""
+}
+
+func (x *StokenRotateReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StokenRotateReq) GetGrace() uint64 {
+	if x != nil {
+		return x.Grace
+	}
+	return 0
+}
+
+func (x *StokenRotateReq) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type StokenRotateResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *StokenRotateResp) Reset() {
+	*x = StokenRotateResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StokenRotateResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StokenRotateResp) ProtoMessage() {}
+
+func (x *StokenRotateResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StokenRotateResp.ProtoReflect.Descriptor instead.
+func (*StokenRotateResp) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *StokenRotateResp) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StokenRotateResp) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type StokenRevokeReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groupname string `protobuf:"bytes,1,opt,name=groupname,proto3" json:"groupname,omitempty"`
+	Appname   string `protobuf:"bytes,2,opt,name=appname,proto3" json:"appname,omitempty"`
+	Id        string `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *StokenRevokeReq) Reset() {
+	*x = StokenRevokeReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StokenRevokeReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StokenRevokeReq) ProtoMessage() {}
+
+func (x *StokenRevokeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StokenRevokeReq.ProtoReflect.Descriptor instead.
+func (*StokenRevokeReq) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *StokenRevokeReq) GetGroupname() string {
+	if x != nil {
+		return x.Groupname
+	}
+	return ""
+}
+
+func (x *StokenRevokeReq) GetAppname() string {
+	if x != nil {
+		return x.Appname
+	}
+	return ""
+}
+
+func (x *StokenRevokeReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type StokenRevokeResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StokenRevokeResp) Reset() {
+	*x = StokenRevokeResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StokenRevokeResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StokenRevokeResp) ProtoMessage() {}
+
+func (x *StokenRevokeResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StokenRevokeResp.ProtoReflect.Descriptor instead.
+func (*StokenRevokeResp) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{93}
+}
+
+type StokensReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groupname string `protobuf:"bytes,1,opt,name=groupname,proto3" json:"groupname,omitempty"`
+	Appname   string `protobuf:"bytes,2,opt,name=appname,proto3" json:"appname,omitempty"`
+}
+
+func (x *StokensReq) Reset() {
+	*x = StokensReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StokensReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StokensReq) ProtoMessage() {}
+
+func (x *StokensReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StokensReq.ProtoReflect.Descriptor instead.
+func (*StokensReq) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *StokensReq) GetGroupname() string {
+	if x != nil {
+		return x.Groupname
+	}
+	return ""
+}
+
+func (x *StokensReq) GetAppname() string {
+	if x != nil {
+		return x.Appname
+	}
+	return ""
+}
+
+type StokensResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tokens []*ReadTokenInfo `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+}
+
+func (x *StokensResp) Reset() {
+	*x = StokensResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StokensResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StokensResp) ProtoMessage() {}
+
+func (x *StokensResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StokensResp.ProtoReflect.Descriptor instead.
+func (*StokensResp) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *StokensResp) GetTokens() []*ReadTokenInfo {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+type ReadTokenInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Expire  uint64 `protobuf:"varint,2,opt,name=expire,proto3" json:"expire,omitempty"` //unix timestamp,second,0 means never expire
+	Comment string `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	Ctime   uint64 `protobuf:"varint,4,opt,name=ctime,proto3" json:"ctime,omitempty"` //unix timestamp,second
+	Author  string `protobuf:"bytes,5,opt,name=author,proto3" json:"author,omitempty"`
+}
+
+func (x *ReadTokenInfo) Reset() {
+	*x = ReadTokenInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadTokenInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadTokenInfo) ProtoMessage() {}
+
+func (x *ReadTokenInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadTokenInfo.ProtoReflect.Descriptor instead.
+func (*ReadTokenInfo) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *ReadTokenInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReadTokenInfo) GetExpire() uint64 {
+	if x != nil {
+		return x.Expire
+	}
+	return 0
+}
+
+func (x *ReadTokenInfo) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *ReadTokenInfo) GetCtime() uint64 {
+	if x != nil {
+		return x.Ctime
+	}
+	return 0
+}
+
+func (x *ReadTokenInfo) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+type SbaseSetReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groupname     string `protobuf:"bytes,1,opt,name=groupname,proto3" json:"groupname,omitempty"`
+	AppConfig     string `protobuf:"bytes,2,opt,name=app_config,json=appConfig,proto3" json:"app_config,omitempty"`          //empty will be treated as {}
+	SourceConfig  string `protobuf:"bytes,3,opt,name=source_config,json=sourceConfig,proto3" json:"source_config,omitempty"` //empty will be treated as {}
+	Comment       string `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	ExpectedOpNum uint64 `protobuf:"varint,5,opt,name=expected_op_num,json=expectedOpNum,proto3" json:"expected_op_num,omitempty"` //0 means don't check,otherwise must equal to the base's current op_num
+}
+
+func (x *SbaseSetReq) Reset() {
+	*x = SbaseSetReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SbaseSetReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SbaseSetReq) ProtoMessage() {}
+
+func (x *SbaseSetReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SbaseSetReq.ProtoReflect.Descriptor instead.
+func (*SbaseSetReq) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *SbaseSetReq) GetGroupname() string {
+	if x != nil {
+		return x.Groupname
+	}
+	return ""
+}
+
+func (x *SbaseSetReq) GetAppConfig() string {
+	if x != nil {
+		return x.AppConfig
+	}
+	return ""
+}
+
+func (x *SbaseSetReq) GetSourceConfig() string {
+	if x != nil {
+		return x.SourceConfig
+	}
+	return ""
+}
+
+func (x *SbaseSetReq) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *SbaseSetReq) GetExpectedOpNum() uint64 {
+	if x != nil {
+		return x.ExpectedOpNum
+	}
+	return 0
+}
+
+type SbaseSetResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OpNum uint64 `protobuf:"varint,1,opt,name=op_num,json=opNum,proto3" json:"op_num,omitempty"`
+}
+
+func (x *SbaseSetResp) Reset() {
+	*x = SbaseSetResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SbaseSetResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SbaseSetResp) ProtoMessage() {}
+
+func (x *SbaseSetResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SbaseSetResp.ProtoReflect.Descriptor instead.
+func (*SbaseSetResp) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *SbaseSetResp) GetOpNum() uint64 {
+	if x != nil {
+		return x.OpNum
+	}
+	return 0
+}
+
+type SbaseGetReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groupname string `protobuf:"bytes,1,opt,name=groupname,proto3" json:"groupname,omitempty"`
+}
+
+func (x *SbaseGetReq) Reset() {
+	*x = SbaseGetReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SbaseGetReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SbaseGetReq) ProtoMessage() {}
+
+func (x *SbaseGetReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SbaseGetReq.ProtoReflect.Descriptor instead.
+func (*SbaseGetReq) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *SbaseGetReq) GetGroupname() string {
+	if x != nil {
+		return x.Groupname
+	}
+	return ""
+}
+
+type SbaseGetResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AppConfig    string `protobuf:"bytes,1,opt,name=app_config,json=appConfig,proto3" json:"app_config,omitempty"`
+	SourceConfig string `protobuf:"bytes,2,opt,name=source_config,json=sourceConfig,proto3" json:"source_config,omitempty"`
+	OpNum        uint64 `protobuf:"varint,3,opt,name=op_num,json=opNum,proto3" json:"op_num,omitempty"`
+	Ctime        uint64 `protobuf:"varint,4,opt,name=ctime,proto3" json:"ctime,omitempty"` //unix timestamp,second
+	Author       string `protobuf:"bytes,5,opt,name=author,proto3" json:"author,omitempty"`
+	Comment      string `protobuf:"bytes,6,opt,name=comment,proto3" json:"comment,omitempty"`
+}
+
+func (x *SbaseGetResp) Reset() {
+	*x = SbaseGetResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SbaseGetResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SbaseGetResp) ProtoMessage() {}
+
+func (x *SbaseGetResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SbaseGetResp.ProtoReflect.Descriptor instead.
+func (*SbaseGetResp) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *SbaseGetResp) GetAppConfig() string {
+	if x != nil {
+		return x.AppConfig
+	}
+	return ""
+}
+
+func (x *SbaseGetResp) GetSourceConfig() string {
+	if x != nil {
+		return x.SourceConfig
+	}
+	return ""
+}
+
+func (x *SbaseGetResp) GetOpNum() uint64 {
+	if x != nil {
+		return x.OpNum
+	}
+	return 0
+}
+
+func (x *SbaseGetResp) GetCtime() uint64 {
+	if x != nil {
+		return x.Ctime
+	}
+	return 0
+}
+
+func (x *SbaseGetResp) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *SbaseGetResp) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type SrenderReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groupname string `protobuf:"bytes,1,opt,name=groupname,proto3" json:"groupname,omitempty"`
+	Appname   string `protobuf:"bytes,2,opt,name=appname,proto3" json:"appname,omitempty"`
+	Index     uint64 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"` //0 means the current version
+}
+
+func (x *SrenderReq) Reset() {
+	*x = SrenderReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SrenderReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SrenderReq) ProtoMessage() {}
+
+func (x *SrenderReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SrenderReq.ProtoReflect.Descriptor instead.
+func (*SrenderReq) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *SrenderReq) GetGroupname() string {
+	if x != nil {
+		return x.Groupname
+	}
+	return ""
+}
+
+func (x *SrenderReq) GetAppname() string {
+	if x != nil {
+		return x.Appname
+	}
+	return ""
+}
+
+func (x *SrenderReq) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+type SrenderResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index        uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	BaseOpNum    uint64 `protobuf:"varint,2,opt,name=base_op_num,json=baseOpNum,proto3" json:"base_op_num,omitempty"` //0 means base config not exist
+	AppConfig    string `protobuf:"bytes,3,opt,name=app_config,json=appConfig,proto3" json:"app_config,omitempty"`
+	SourceConfig string `protobuf:"bytes,4,opt,name=source_config,json=sourceConfig,proto3" json:"source_config,omitempty"`
+}
+
+func (x *SrenderResp) Reset() {
+	*x = SrenderResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_sconfig_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SrenderResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SrenderResp) ProtoMessage() {}
+
+func (x *SrenderResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_sconfig_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SrenderResp.ProtoReflect.Descriptor instead.
+func (*SrenderResp) Descriptor() ([]byte, []int) {
+	return file_api_sconfig_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *SrenderResp) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *SrenderResp) GetBaseOpNum() uint64 {
+	if x != nil {
+		return x.BaseOpNum
+	}
+	return 0
+}
+
+func (x *SrenderResp) GetAppConfig() string {
+	if x != nil {
+		return x.AppConfig
+	}
+	return ""
+}
+
+func (x *SrenderResp) GetSourceConfig() string {
+	if x != nil {
+		return x.SourceConfig
+	}
+	return ""
+}
+
+var File_api_sconfig_proto protoreflect.FileDescriptor
+
+var file_api_sconfig_proto_rawDesc = []byte{
+	0x0a, 0x11, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x0f, 0x70, 0x62, 0x65,
+	0x78, 0x2f, 0x70, 0x62, 0x65, 0x78, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x4f, 0x0a, 0x09,
+	0x73, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x72, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x09, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xf0, 0x90,
+	0x4e, 0x00, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a,
+	0x07, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04,
+	0xf0, 0x90, 0x4e, 0x00, 0x52, 0x07, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xc9, 0x02,
+	0x0a, 0x0a, 0x73, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x12, 0x1b, 0x0a, 0x09,
+	0x63, 0x75, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x63, 0x75, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x61,
+	0x78, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x15, 0x0a, 0x06, 0x6f, 0x70, 0x5f, 0x6e, 0x75, 0x6d,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6f, 0x70, 0x4e, 0x75, 0x6d, 0x12, 0x24, 0x0a,
+	0x0e, 0x63, 0x75, 0x72, 0x5f, 0x61, 0x70, 0x70, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x41, 0x70, 0x70, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x2a, 0x0a, 0x11, 0x63, 0x75, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x63, 0x75, 0x72, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x21, 0x0a, 0x0c, 0x63, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x5f, 0x70, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x63, 0x61, 0x6e, 0x61,
+	0x72, 0x79, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x61, 0x6e,
+	0x61, 0x72, 0x79, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x48, 0x6f, 0x73, 0x74, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x5f, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x61, 0x6e,
+	0x61, 0x72, 0x79, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x22, 0x97, 0x02, 0x0a, 0x08, 0x73, 0x73,
+	0x65, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xf0, 0x90, 0x4e, 0x00, 0x52,
+	0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x07, 0x61, 0x70,
+	0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xf0, 0x90, 0x4e,
+	0x00, 0x52, 0x07, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x70,
+	0x70, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x61, 0x70, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x5f, 0x6f, 0x70, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4f, 0x70, 0x4e, 0x75, 0x6d,
+	0x12, 0x14, 0x0a, 0x05, 0x64, 0x72, 0x61, 0x66, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x05, 0x64, 0x72, 0x61, 0x66, 0x74, 0x12, 0x2b, 0x0a, 0x05, 0x67, 0x75, 0x61, 0x72, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x67, 0x75, 0x61, 0x72, 0x64, 0x52, 0x05, 0x67, 0x75,
+	0x61, 0x72, 0x64, 0x22, 0x5c, 0x0a, 0x09, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70,
+	0x12, 0x1b, 0x0a, 0x09, 0x63, 0x75, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x75, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1b, 0x0a,
+	0x09, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x6d, 0x61, 0x78, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x15, 0x0a, 0x06, 0x6f, 0x70,
+	0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6f, 0x70, 0x4e, 0x75,
+	0x6d, 0x22, 0x97, 0x01, 0x0a, 0x0d, 0x73, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f,
+	0x72, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xf0, 0x90, 0x4e, 0x00, 0x52, 0x09, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xf0, 0x90, 0x4e, 0x00, 0x52, 0x07,
+	0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x04, 0xa0, 0x91, 0x4e, 0x00, 0x52, 0x05, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x12, 0x26, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f,
+	0x6f, 0x70, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4f, 0x70, 0x4e, 0x75, 0x6d, 0x22, 0x61, 0x0a, 0x0e, 0x73,
+	0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x12, 0x1b, 0x0a,
+	0x09, 0x63, 0x75, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x63, 0x75, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61,
+	0x78, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d,
+	0x61, 0x78, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x15, 0x0a, 0x06, 0x6f, 0x70, 0x5f, 0x6e, 0x75,
+	0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6f, 0x70, 0x4e, 0x75, 0x6d, 0x22, 0x6a,
+	0x0a, 0x08, 0x73, 0x67, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x09, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xf0,
+	0x90, 0x4e, 0x00, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e,
+	0x0a, 0x07, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x04, 0xf0, 0x90, 0x4e, 0x00, 0x52, 0x07, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a,
+	0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x04, 0xa0,
+	0x91, 0x4e, 0x00, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0xd5, 0x01, 0x0a, 0x09, 0x73,
+	0x67, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1d,
+	0x0a, 0x0a, 0x61, 0x70, 0x70, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x61, 0x70, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x23, 0x0a,
+	0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x72,
+	0x61, 0x66, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x64, 0x72, 0x61, 0x66, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74,
+	0x61, 0x67, 0x22, 0x0d, 0x0a, 0x0b, 0x73, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x5f, 0x72, 0x65,
+	0x71, 0x22, 0x26, 0x0a, 0x0c, 0x73, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x5f, 0x72, 0x65, 0x73,
+	0x70, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x2f, 0x0a, 0x09, 0x73, 0x61, 0x70,
+	0x70, 0x73, 0x5f, 0x72, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xf0, 0x90, 0x4e, 0x00, 0x52,
+	0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x20, 0x0a, 0x0a, 0x73, 0x61,
+	0x70, 0x70, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x70, 0x70, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x70, 0x70, 0x73, 0x22, 0xbb, 0x01, 0x0a,
+	0x0a, 0x73, 0x77, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x72, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x09, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04,
+	0xf0, 0x90, 0x4e, 0x00, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x1e, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x04, 0xf0, 0x90, 0x4e, 0x00, 0x52, 0x07, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x15, 0x0a, 0x06, 0x6f, 0x70, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x6f, 0x70, 0x4e, 0x75, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x1e, 0x0a, 0x0b, 0x62, 0x61,
+	0x73, 0x65, 0x5f, 0x6f, 0x70, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x62, 0x61, 0x73, 0x65, 0x4f, 0x70, 0x4e, 0x75, 0x6d, 0x22, 0xbd, 0x01, 0x0a, 0x0b, 0x73,
+	0x77, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x12, 0x15, 0x0a, 0x06, 0x6f, 0x70,
+	0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6f, 0x70, 0x4e, 0x75,
+	0x6d, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x75, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x75, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1d,
+	0x0a, 0x0a, 0x61, 0x70, 0x70, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x61, 0x70, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x23, 0x0a,
+	0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x63, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x1e, 0x0a, 0x0b, 0x62, 0x61,
+	0x73, 0x65, 0x5f, 0x6f, 0x70, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x62, 0x61, 0x73, 0x65, 0x4f, 0x70, 0x4e, 0x75, 0x6d, 0x22, 0x7a, 0x0a, 0x0c, 0x73, 0x68,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x09, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xf0,
+	0x90, 0x4e, 0x00, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e,
+	0x0a, 0x07, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x04, 0xf0, 0x90, 0x4e, 0x00, 0x52, 0x07, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x57, 0x0a, 0x0d, 0x73, 0x68, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x30, 0x0a,
+	0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0xea, 0x01, 0x0a, 0x0c, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
+	0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x26,
+	0x0a, 0x0f, 0x61, 0x70, 0x70, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x61, 0x70, 0x70, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x10, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x72, 0x61, 0x66, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x64, 0x72, 0x61, 0x66, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61,
+	0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x22, 0xac, 0x01, 0x0a,
+	0x0a, 0x73, 0x61, 0x75, 0x64, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x09, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04,
+	0xf0, 0x90, 0x4e, 0x00, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x65, 0x67,
+	0x69, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x62,
+	0x65, 0x67, 0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x4f, 0x0a, 0x0b, 0x73,
+	0x61, 0x75, 0x64, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x12, 0x2a, 0x0a, 0x06, 0x61, 0x75, 0x64, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x74, 0x5f,
+	0x69, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x61, 0x75, 0x64, 0x69, 0x74, 0x73, 0x22, 0xfb, 0x01, 0x0a,
+	0x0a, 0x61, 0x75, 0x64, 0x69, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x6f, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x66,
+	0x72, 0x6f, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x66, 0x72, 0x6f, 0x6d, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x15, 0x0a, 0x06, 0x6f, 0x70, 0x5f, 0x6e, 0x75, 0x6d, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6f, 0x70, 0x4e, 0x75, 0x6d, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x74, 0x69,
+	0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x22, 0x8f, 0x01, 0x0a, 0x09, 0x73,
+	0x64, 0x69, 0x66, 0x66, 0x5f, 0x72, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xf0, 0x90, 0x4e,
+	0x00, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x07,
+	0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xf0,
+	0x90, 0x4e, 0x00, 0x52, 0x07, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0a,
+	0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x04, 0xa0, 0x91, 0x4e, 0x00, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0xb0, 0x01, 0x0a,
+	0x0a, 0x73, 0x64, 0x69, 0x66, 0x66, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x66,
+	0x72, 0x6f, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x66, 0x72, 0x6f, 0x6d, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x30, 0x0a, 0x0a, 0x61, 0x70, 0x70, 0x5f, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x64, 0x69, 0x66, 0x66, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x52, 0x09, 0x61, 0x70,
+	0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x36, 0x0a, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x64, 0x69, 0x66, 0x66, 0x5f, 0x69, 0x74, 0x65,
+	0x6d, 0x52, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22,
+	0x69, 0x0a, 0x09, 0x64, 0x69, 0x66, 0x66, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02,
+	0x6f, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x6c, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x6e, 0x65, 0x77, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6e, 0x65, 0x77, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xce, 0x02, 0x0a, 0x0a, 0x73,
+	0x70, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x72, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x09, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xf0, 0x90,
+	0x4e, 0x00, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a,
+	0x07, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04,
+	0xf0, 0x90, 0x4e, 0x00, 0x52, 0x07, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x61, 0x74, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x28, 0x0a, 0x10,
+	0x61, 0x70, 0x70, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x70, 0x61, 0x74, 0x63, 0x68,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x70, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x50, 0x61, 0x74, 0x63, 0x68, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x50, 0x61, 0x74, 0x63, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x26, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x6f, 0x70, 0x5f,
+	0x6e, 0x75, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x4f, 0x70, 0x4e, 0x75, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x72, 0x61, 0x66,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x64, 0x72, 0x61, 0x66, 0x74, 0x12, 0x2b,
+	0x0a, 0x05, 0x67, 0x75, 0x61, 0x72, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x67,
+	0x75, 0x61, 0x72, 0x64, 0x52, 0x05, 0x67, 0x75, 0x61, 0x72, 0x64, 0x22, 0x5e, 0x0a, 0x0b, 0x73,
+	0x70, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x75,
+	0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63,
+	0x75, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x15, 0x0a, 0x06, 0x6f, 0x70, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6f, 0x70, 0x4e, 0x75, 0x6d, 0x22, 0x99, 0x01, 0x0a, 0x0f,
+	0x73, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x12,
+	0x22, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x04, 0xf0, 0x90, 0x4e, 0x00, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xf0, 0x90, 0x4e, 0x00, 0x52, 0x07, 0x61, 0x70, 0x70, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x70, 0x70, 0x53, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x73, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x22, 0x28, 0x0a, 0x10, 0x73, 0x73, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x22, 0x6b, 0x0a, 0x0f, 0x73, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x67, 0x65, 0x74,
+	0x5f, 0x72, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xf0, 0x90, 0x4e, 0x00, 0x52, 0x09, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xf0, 0x90, 0x4e, 0x00, 0x52,
+	0x07, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x9a,
+	0x01, 0x0a, 0x10, 0x73, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x67, 0x65, 0x74, 0x5f, 0x72,
+	0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x70, 0x70,
+	0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61,
+	0x70, 0x70, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x74,
+	0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x22, 0xc3, 0x01, 0x0a, 0x0c,
+	0x73, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x72, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x09,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x04, 0xf0, 0x90, 0x4e, 0x00, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1e, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x04, 0xf0, 0x90, 0x4e, 0x00, 0x52, 0x07, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1a, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x04, 0xa0, 0x91, 0x4e, 0x00, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x26, 0x0a, 0x0f,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x6f, 0x70, 0x5f, 0x6e, 0x75, 0x6d, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4f,
+	0x70, 0x4e, 0x75, 0x6d, 0x12, 0x2b, 0x0a, 0x05, 0x67, 0x75, 0x61, 0x72, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x73, 0x68, 0x5f, 0x67, 0x75, 0x61, 0x72, 0x64, 0x52, 0x05, 0x67, 0x75, 0x61, 0x72,
+	0x64, 0x22, 0x60, 0x0a, 0x0d, 0x73, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x72, 0x65,
+	0x73, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x75, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x75, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x15, 0x0a, 0x06,
+	0x6f, 0x70, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6f, 0x70,
+	0x4e, 0x75, 0x6d, 0x22, 0x71, 0x0a, 0x0f, 0x73, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x73,
+	0x65, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xf0, 0x90, 0x4e, 0x00, 0x52,
+	0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x61, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x61, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x22, 0x12, 0x0a, 0x10, 0x73, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x35, 0x0a, 0x0f, 0x73, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x67, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x12, 0x22, 0x0a,
+	0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x04, 0xf0, 0x90, 0x4e, 0x00, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d,
+	0x65, 0x22, 0x7c, 0x0a, 0x10, 0x73, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x67, 0x65, 0x74,
+	0x5f, 0x72, 0x65, 0x73, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x65, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x22,
+	0x88, 0x01, 0x0a, 0x0c, 0x73, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x5f, 0x72, 0x65, 0x71,
+	0x12, 0x22, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x04, 0xf0, 0x90, 0x4e, 0x00, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xf0, 0x90, 0x4e, 0x00, 0x52, 0x07, 0x61, 0x70, 0x70,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x04, 0xa0, 0x91, 0x4e, 0x00, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x49, 0x0a, 0x0d, 0x73, 0x61,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x61,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
+	0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x87, 0x01, 0x0a, 0x0b, 0x73, 0x72, 0x65, 0x6a, 0x65, 0x63,
+	0x74, 0x5f, 0x72, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xf0, 0x90, 0x4e, 0x00, 0x52, 0x09,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x07, 0x61, 0x70, 0x70,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xf0, 0x90, 0x4e, 0x00,
+	0x52, 0x07, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x05, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x04, 0xa0, 0x91, 0x4e, 0x00, 0x52, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22,
+	0x0e, 0x0a, 0x0c, 0x73, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22,
+	0x4c, 0x0a, 0x0c, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x71, 0x12,
+	0x22, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x04, 0xf0, 0x90, 0x4e, 0x00, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3f, 0x0a,
+	0x0d, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x12, 0x2e,
+	0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0xc0,
+	0x01, 0x0a, 0x0c, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x63, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x64, 0x22, 0x92, 0x01, 0x0a, 0x0d, 0x73, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f,
+	0x72, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xf0, 0x90, 0x4e, 0x00, 0x52, 0x09, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xf0, 0x90, 0x4e, 0x00, 0x52, 0x07,
+	0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x04, 0xa0, 0x91, 0x4e, 0x00, 0x52, 0x05, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x12, 0x21, 0x0a, 0x09, 0x66, 0x69, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xf0, 0x90, 0x4e, 0x00, 0x52, 0x08, 0x66, 0x69,
+	0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x3d, 0x0a, 0x0e, 0x73, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x72, 0x65,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x66, 0x69, 0x72,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x52, 0x0a, 0x12, 0x73, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x09, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04,
+	0xf0, 0x90, 0x4e, 0x00, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x4a, 0x0a, 0x13, 0x73, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70,
+	0x12, 0x33, 0x0a, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x9a, 0x01, 0x0a, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x72, 0x65, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x66, 0x69, 0x72, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x22, 0x70, 0x0a, 0x14, 0x73, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f,
+	0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x5f, 0x72, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x09, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xf0,
+	0x90, 0x4e, 0x00, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e,
+	0x0a, 0x07, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x04, 0xf0, 0x90, 0x4e, 0x00, 0x52, 0x07, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xf0, 0x90, 0x4e, 0x00,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x73, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x5f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0xe5, 0x01,
+	0x0a, 0x0b, 0x73, 0x63, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x71, 0x12, 0x22, 0x0a,
+	0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x04, 0xf0, 0x90, 0x4e, 0x00, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1e, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x04, 0xf0, 0x90, 0x4e, 0x00, 0x52, 0x07, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1a, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x04, 0xa0, 0x91, 0x4e, 0x00, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
+	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x68, 0x6f, 0x73, 0x74, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x68, 0x6f, 0x73, 0x74,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x26, 0x0a,
+	0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x6f, 0x70, 0x5f, 0x6e, 0x75, 0x6d,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x4f, 0x70, 0x4e, 0x75, 0x6d, 0x22, 0x25, 0x0a, 0x0c, 0x73, 0x63, 0x61, 0x6e, 0x61, 0x72, 0x79,
+	0x5f, 0x72, 0x65, 0x73, 0x70, 0x12, 0x15, 0x0a, 0x06, 0x6f, 0x70, 0x5f, 0x6e, 0x75, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6f, 0x70, 0x4e, 0x75, 0x6d, 0x22, 0x81, 0x01, 0x0a,
+	0x13, 0x73, 0x63, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65,
+	0x5f, 0x72, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xf0, 0x90, 0x4e, 0x00, 0x52, 0x09, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xf0, 0x90, 0x4e, 0x00, 0x52,
+	0x07, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x5f, 0x6f, 0x70, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4f, 0x70, 0x4e, 0x75, 0x6d,
+	0x22, 0x67, 0x0a, 0x14, 0x73, 0x63, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x5f, 0x70, 0x72, 0x6f, 0x6d,
+	0x6f, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x75, 0x72, 0x5f,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x75, 0x72,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x15, 0x0a, 0x06, 0x6f, 0x70, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x6f, 0x70, 0x4e, 0x75, 0x6d, 0x22, 0x7f, 0x0a, 0x11, 0x73, 0x63, 0x61,
+	0x6e, 0x61, 0x72, 0x79, 0x5f, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x12, 0x22,
+	0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x04, 0xf0, 0x90, 0x4e, 0x00, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x04, 0xf0, 0x90, 0x4e, 0x00, 0x52, 0x07, 0x61, 0x70, 0x70, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x6f,
+	0x70, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x4f, 0x70, 0x4e, 0x75, 0x6d, 0x22, 0x2b, 0x0a, 0x12, 0x73, 0x63,
+	0x61, 0x6e, 0x61, 0x72, 0x79, 0x5f, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70,
+	0x12, 0x15, 0x0a, 0x06, 0x6f, 0x70, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x6f, 0x70, 0x4e, 0x75, 0x6d, 0x22, 0xe6, 0x01, 0x0a, 0x0b, 0x73, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xf0, 0x90, 0x4e, 0x00,
+	0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x07, 0x61,
+	0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xf0, 0x90,
+	0x4e, 0x00, 0x52, 0x07, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x08, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xf0,
+	0x90, 0x4e, 0x00, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x15, 0x0a,
+	0x06, 0x6f, 0x70, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6f,
+	0x70, 0x4e, 0x75, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61,
+	0x6e, 0x61, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x61, 0x6e, 0x61,
+	0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x22, 0x0e, 0x0a, 0x0c, 0x73, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70,
+	0x22, 0x52, 0x0a, 0x0c, 0x73, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x5f, 0x72, 0x65, 0x71,
+	0x12, 0x22, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x04, 0xf0, 0x90, 0x4e, 0x00, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xf0, 0x90, 0x4e, 0x00, 0x52, 0x07, 0x61, 0x70, 0x70,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0xe2, 0x01, 0x0a, 0x0d, 0x73, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x75,
+	0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x75, 0x72, 0x5f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x75, 0x72, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x12, 0x15, 0x0a, 0x06, 0x6f, 0x70, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x6f, 0x70, 0x4e, 0x75, 0x6d, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61,
+	0x6e, 0x61, 0x72, 0x79, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x63, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x6c, 0x65, 0x12, 0x32, 0x0a, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x52, 0x09,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x22, 0xb3, 0x01, 0x0a, 0x0c, 0x72, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x6f, 0x70, 0x5f, 0x6e, 0x75, 0x6d,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6f, 0x70, 0x4e, 0x75, 0x6d, 0x12, 0x14, 0x0a,
+	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x75, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x22,
+	0x6b, 0x0a, 0x0d, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x67, 0x75, 0x61, 0x72, 0x64,
+	0x12, 0x21, 0x0a, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x66, 0x61, 0x69, 0x6c, 0x50, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x1f, 0x0a, 0x0b, 0x6d,
+	0x69, 0x6e, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x22, 0x4b, 0x0a, 0x0b,
+	0x73, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x09, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04,
+	0xf0, 0x90, 0x4e, 0x00, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2a, 0x0a, 0x0c, 0x73, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x70, 0x70,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x61, 0x70, 0x70,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x4c, 0x0a, 0x0c, 0x73, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x5f, 0x72, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xf0, 0x90, 0x4e, 0x00, 0x52, 0x09,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0x2b, 0x0a, 0x0d, 0x73, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f,
+	0x72, 0x65, 0x73, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x22, 0x4a, 0x0a, 0x0a, 0x73, 0x70, 0x75, 0x72, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x12, 0x22,
+	0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x04, 0xf0, 0x90, 0x4e, 0x00, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x29, 0x0a, 0x0b,
+	0x73, 0x70, 0x75, 0x72, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x61,
+	0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x61,
+	0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x2c, 0x0a, 0x0c, 0x73, 0x72, 0x65, 0x63, 0x79,
+	0x63, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x39, 0x0a, 0x0d, 0x73, 0x72, 0x65, 0x63, 0x79, 0x63, 0x6c,
+	0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x12, 0x28, 0x0a, 0x04, 0x61, 0x70, 0x70, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x72, 0x65,
+	0x63, 0x79, 0x63, 0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x61, 0x70, 0x70, 0x73,
+	0x22, 0x7b, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
+	0x12, 0x1c, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x64, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x75, 0x72, 0x67, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x70, 0x75, 0x72, 0x67, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x7c, 0x0a,
+	0x08, 0x73, 0x74, 0x61, 0x67, 0x5f, 0x72, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x09, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xf0, 0x90,
+	0x4e, 0x00, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a,
+	0x07, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04,
+	0xf0, 0x90, 0x4e, 0x00, 0x52, 0x07, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
+	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x04, 0xa0, 0x91,
+	0x4e, 0x00, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x22, 0x0b, 0x0a, 0x09, 0x73,
+	0x74, 0x61, 0x67, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x70, 0x0a, 0x12, 0x73, 0x72, 0x65, 0x74,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x12, 0x22,
+	0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x04, 0xf0, 0x90, 0x4e, 0x00, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6b, 0x65, 0x65, 0x70, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6b, 0x65, 0x65, 0x70, 0x4e, 0x75, 0x6d, 0x12, 0x1b, 0x0a,
+	0x09, 0x6b, 0x65, 0x65, 0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x6b, 0x65, 0x65, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x73, 0x72,
+	0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x73,
+	0x70, 0x22, 0x38, 0x0a, 0x12, 0x73, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x67, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xf0, 0x90, 0x4e, 0x00,
+	0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x7b, 0x0a, 0x13, 0x73,
+	0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x67, 0x65, 0x74, 0x5f, 0x72, 0x65,
+	0x73, 0x70, 0x12, 0x19, 0x0a, 0x08, 0x6b, 0x65, 0x65, 0x70, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6b, 0x65, 0x65, 0x70, 0x4e, 0x75, 0x6d, 0x12, 0x1b, 0x0a,
+	0x09, 0x6b, 0x65, 0x65, 0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x6b, 0x65, 0x65, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x22, 0x2e, 0x0a, 0x12, 0x73, 0x70, 0x72, 0x69,
+	0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x12, 0x18,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xf0, 0x90,
+	0x4e, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2b, 0x0a, 0x13, 0x73, 0x70, 0x72, 0x69,
+	0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x2e, 0x0a, 0x12, 0x73, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69,
+	0x70, 0x61, 0x6c, 0x5f, 0x64, 0x65, 0x6c, 0x5f, 0x72, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xf0, 0x90, 0x4e, 0x00, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x73, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69,
+	0x70, 0x61, 0x6c, 0x5f, 0x64, 0x65, 0x6c, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x11, 0x0a, 0x0f,
+	0x73, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x73, 0x5f, 0x72, 0x65, 0x71, 0x22,
+	0x4a, 0x0a, 0x10, 0x73, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x73, 0x5f, 0x72,
+	0x65, 0x73, 0x70, 0x12, 0x36, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x52,
+	0x0a, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x73, 0x22, 0x52, 0x0a, 0x0e, 0x70,
+	0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x22,
+	0x94, 0x01, 0x0a, 0x0a, 0x73, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x12, 0x22,
+	0x0a, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x04, 0xf0, 0x90, 0x4e, 0x00, 0x52, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70,
+	0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f,
+	0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x22, 0x0d, 0x0a, 0x0b, 0x73, 0x67, 0x72, 0x61, 0x6e, 0x74,
+	0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x49, 0x0a, 0x0b, 0x73, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73,
+	0x5f, 0x72, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70,
+	0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65,
+	0x22, 0x3a, 0x0a, 0x0c, 0x73, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x70,
+	0x12, 0x2a, 0x0a, 0x06, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x5f,
+	0x69, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x22, 0xbc, 0x01, 0x0a,
+	0x0a, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x70,
+	0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x22, 0x88, 0x01, 0x0a, 0x10,
+	0x73, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x5f, 0x72, 0x65, 0x71,
+	0x12, 0x22, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x04, 0xf0, 0x90, 0x4e, 0x00, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xf0, 0x90, 0x4e, 0x00, 0x52, 0x07, 0x61, 0x70, 0x70,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x22, 0x39, 0x0a, 0x11, 0x73, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x5f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x9d, 0x01, 0x0a, 0x11, 0x73, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x72, 0x6f, 0x74,
+	0x61, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xf0, 0x90, 0x4e, 0x00,
+	0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x07, 0x61,
+	0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xf0, 0x90,
+	0x4e, 0x00, 0x52, 0x07, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xf0, 0x90, 0x4e, 0x00, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x67, 0x72, 0x61, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x22, 0x3a, 0x0a, 0x12, 0x73, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x72, 0x6f, 0x74, 0x61,
+	0x74, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x6d, 0x0a,
+	0x11, 0x73, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x5f, 0x72,
+	0x65, 0x71, 0x12, 0x22, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xf0, 0x90, 0x4e, 0x00, 0x52, 0x09, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xf0, 0x90, 0x4e, 0x00, 0x52, 0x07, 0x61,
+	0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x04, 0xf0, 0x90, 0x4e, 0x00, 0x52, 0x02, 0x69, 0x64, 0x22, 0x14, 0x0a, 0x12,
+	0x73, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x5f, 0x72, 0x65,
+	0x73, 0x70, 0x22, 0x51, 0x0a, 0x0b, 0x73, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x5f, 0x72, 0x65,
+	0x71, 0x12, 0x22, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xf0, 0x90, 0x4e, 0x00, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xf0, 0x90, 0x4e, 0x00, 0x52, 0x07, 0x61, 0x70,
+	0x70, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3f, 0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
+	0x5f, 0x72, 0x65, 0x73, 0x70, 0x12, 0x2f, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x72,
+	0x65, 0x61, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x52, 0x06,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x22, 0x81, 0x01, 0x0a, 0x0f, 0x72, 0x65, 0x61, 0x64, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x74, 0x69,
+	0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x22, 0xb9, 0x01, 0x0a, 0x0d, 0x73,
+	0x62, 0x61, 0x73, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x09,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x04, 0xf0, 0x90, 0x4e, 0x00, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x70, 0x70, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x70, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x23, 0x0a, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x26,
+	0x0a, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x6f, 0x70, 0x5f, 0x6e, 0x75,
+	0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x4f, 0x70, 0x4e, 0x75, 0x6d, 0x22, 0x27, 0x0a, 0x0e, 0x73, 0x62, 0x61, 0x73, 0x65, 0x5f,
+	0x73, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x12, 0x15, 0x0a, 0x06, 0x6f, 0x70, 0x5f, 0x6e,
+	0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6f, 0x70, 0x4e, 0x75, 0x6d, 0x22,
+	0x33, 0x0a, 0x0d, 0x73, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x67, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x71,
+	0x12, 0x22, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x04, 0xf0, 0x90, 0x4e, 0x00, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0xb3, 0x01, 0x0a, 0x0e, 0x73, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x67,
+	0x65, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x70, 0x70, 0x5f, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x70, 0x70,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x15, 0x0a, 0x06, 0x6f,
+	0x70, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6f, 0x70, 0x4e,
+	0x75, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x67, 0x0a, 0x0b, 0x73, 0x72,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x09, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xf0, 0x90,
+	0x4e, 0x00, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a,
+	0x07, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04,
+	0xf0, 0x90, 0x4e, 0x00, 0x52, 0x07, 0x61, 0x70, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x22, 0x88, 0x01, 0x0a, 0x0c, 0x73, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f,
+	0x72, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1e, 0x0a, 0x0b, 0x62, 0x61,
+	0x73, 0x65, 0x5f, 0x6f, 0x70, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x62, 0x61, 0x73, 0x65, 0x4f, 0x70, 0x4e, 0x75, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x70,
+	0x70, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x61, 0x70, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x32, 0x9f,
+	0x1c, 0x0a, 0x07, 0x73, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x40, 0x0a, 0x05, 0x73, 0x69,
+	0x6e, 0x66, 0x6f, 0x12, 0x11, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x69, 0x6e,
+	0x66, 0x6f, 0x5f, 0x72, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x73, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x10, 0x8a, 0x9f, 0x49, 0x03,
+	0x67, 0x65, 0x74, 0x92, 0x9f, 0x49, 0x05, 0x32, 0x35, 0x30, 0x6d, 0x73, 0x12, 0x3e, 0x0a, 0x04,
+	0x73, 0x73, 0x65, 0x74, 0x12, 0x10, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x73,
+	0x65, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x73, 0x73, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x11, 0x8a, 0x9f, 0x49, 0x04, 0x70,
+	0x6f, 0x73, 0x74, 0x92, 0x9f, 0x49, 0x05, 0x32, 0x35, 0x30, 0x6d, 0x73, 0x12, 0x4d, 0x0a, 0x09,
+	0x73, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x15, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x73, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x72, 0x65, 0x71,
+	0x1a, 0x16, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x72, 0x6f, 0x6c, 0x6c, 0x62,
+	0x61, 0x63, 0x6b, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x11, 0x8a, 0x9f, 0x49, 0x04, 0x70, 0x6f,
+	0x73, 0x74, 0x92, 0x9f, 0x49, 0x05, 0x32, 0x35, 0x30, 0x6d, 0x73, 0x12, 0x3d, 0x0a, 0x04, 0x73,
+	0x67, 0x65, 0x74, 0x12, 0x10, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x67, 0x65,
+	0x74, 0x5f, 0x72, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73,
+	0x67, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x10, 0x8a, 0x9f, 0x49, 0x03, 0x67, 0x65,
+	0x74, 0x92, 0x9f, 0x49, 0x05, 0x32, 0x35, 0x30, 0x6d, 0x73, 0x12, 0x46, 0x0a, 0x07, 0x73, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x13, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x5f, 0x72, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x73, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x70,
+	0x22, 0x10, 0x8a, 0x9f, 0x49, 0x03, 0x67, 0x65, 0x74, 0x92, 0x9f, 0x49, 0x05, 0x32, 0x35, 0x30,
+	0x6d, 0x73, 0x12, 0x40, 0x0a, 0x05, 0x73, 0x61, 0x70, 0x70, 0x73, 0x12, 0x11, 0x2e, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x61, 0x70, 0x70, 0x73, 0x5f, 0x72, 0x65, 0x71, 0x1a, 0x12,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x61, 0x70, 0x70, 0x73, 0x5f, 0x72, 0x65,
+	0x73, 0x70, 0x22, 0x10, 0x8a, 0x9f, 0x49, 0x03, 0x67, 0x65, 0x74, 0x92, 0x9f, 0x49, 0x05, 0x32,
+	0x35, 0x30, 0x6d, 0x73, 0x12, 0x41, 0x0a, 0x06, 0x73, 0x77, 0x61, 0x74, 0x63, 0x68, 0x12, 0x12,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x77, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x72,
+	0x65, 0x71, 0x1a, 0x13, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x77, 0x61, 0x74,
+	0x63, 0x68, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x0e, 0x8a, 0x9f, 0x49, 0x03, 0x67, 0x65, 0x74,
+	0x92, 0x9f, 0x49, 0x03, 0x33, 0x30, 0x73, 0x12, 0x49, 0x0a, 0x08, 0x73, 0x68, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x12, 0x14, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x68, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x73, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x73, 0x70,
+	0x22, 0x10, 0x8a, 0x9f, 0x49, 0x03, 0x67, 0x65, 0x74, 0x92, 0x9f, 0x49, 0x05, 0x32, 0x35, 0x30,
+	0x6d, 0x73, 0x12, 0x43, 0x0a, 0x06, 0x73, 0x61, 0x75, 0x64, 0x69, 0x74, 0x12, 0x12, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x61, 0x75, 0x64, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x71,
+	0x1a, 0x13, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x61, 0x75, 0x64, 0x69, 0x74,
+	0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x10, 0x8a, 0x9f, 0x49, 0x03, 0x67, 0x65, 0x74, 0x92, 0x9f,
+	0x49, 0x05, 0x32, 0x35, 0x30, 0x6d, 0x73, 0x12, 0x44, 0x0a, 0x06, 0x73, 0x70, 0x61, 0x74, 0x63,
+	0x68, 0x12, 0x12, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x70, 0x61, 0x74, 0x63,
+	0x68, 0x5f, 0x72, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73,
+	0x70, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x11, 0x8a, 0x9f, 0x49, 0x04,
+	0x70, 0x6f, 0x73, 0x74, 0x92, 0x9f, 0x49, 0x05, 0x32, 0x35, 0x30, 0x6d, 0x73, 0x12, 0x53, 0x0a,
+	0x0b, 0x73, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x74, 0x12, 0x17, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65,
+	0x74, 0x5f, 0x72, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73,
+	0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22,
+	0x11, 0x8a, 0x9f, 0x49, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x92, 0x9f, 0x49, 0x05, 0x32, 0x35, 0x30,
+	0x6d, 0x73, 0x12, 0x52, 0x0a, 0x0b, 0x73, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x67, 0x65,
+	0x74, 0x12, 0x17, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x73, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x5f, 0x67, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x73, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x67, 0x65, 0x74, 0x5f,
+	0x72, 0x65, 0x73, 0x70, 0x22, 0x10, 0x8a, 0x9f, 0x49, 0x03, 0x67, 0x65, 0x74, 0x92, 0x9f, 0x49,
+	0x05, 0x32, 0x35, 0x30, 0x6d, 0x73, 0x12, 0x40, 0x0a, 0x05, 0x73, 0x64, 0x69, 0x66, 0x66, 0x12,
+	0x11, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x64, 0x69, 0x66, 0x66, 0x5f, 0x72,
+	0x65, 0x71, 0x1a, 0x12, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x64, 0x69, 0x66,
+	0x66, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x10, 0x8a, 0x9f, 0x49, 0x03, 0x67, 0x65, 0x74, 0x92,
+	0x9f, 0x49, 0x05, 0x32, 0x35, 0x30, 0x6d, 0x73, 0x12, 0x4a, 0x0a, 0x08, 0x73, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x73, 0x68, 0x12, 0x14, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x72, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x73, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x72, 0x65, 0x73,
+	0x70, 0x22, 0x11, 0x8a, 0x9f, 0x49, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x92, 0x9f, 0x49, 0x05, 0x32,
+	0x35, 0x30, 0x6d, 0x73, 0x12, 0x53, 0x0a, 0x0b, 0x73, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f,
+	0x73, 0x65, 0x74, 0x12, 0x17, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x73, 0x65,
+	0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x11, 0x8a, 0x9f, 0x49, 0x04, 0x70, 0x6f, 0x73, 0x74,
+	0x92, 0x9f, 0x49, 0x05, 0x32, 0x35, 0x30, 0x6d, 0x73, 0x12, 0x52, 0x0a, 0x0b, 0x73, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x5f, 0x67, 0x65, 0x74, 0x12, 0x17, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x73, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x67, 0x65, 0x74, 0x5f, 0x72, 0x65,
+	0x71, 0x1a, 0x18, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x5f, 0x67, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x10, 0x8a, 0x9f, 0x49,
+	0x03, 0x67, 0x65, 0x74, 0x92, 0x9f, 0x49, 0x05, 0x32, 0x35, 0x30, 0x6d, 0x73, 0x12, 0x4a, 0x0a,
+	0x08, 0x73, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x12, 0x14, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x73, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x1a,
+	0x15, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x11, 0x8a, 0x9f, 0x49, 0x04, 0x70, 0x6f, 0x73, 0x74,
+	0x92, 0x9f, 0x49, 0x05, 0x32, 0x35, 0x30, 0x6d, 0x73, 0x12, 0x47, 0x0a, 0x07, 0x73, 0x72, 0x65,
+	0x6a, 0x65, 0x63, 0x74, 0x12, 0x13, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x72,
+	0x65, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x73, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22,
+	0x11, 0x8a, 0x9f, 0x49, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x92, 0x9f, 0x49, 0x05, 0x32, 0x35, 0x30,
+	0x6d, 0x73, 0x12, 0x49, 0x0a, 0x08, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x14,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x5f, 0x72, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x70,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x10, 0x8a, 0x9f, 0x49,
+	0x03, 0x67, 0x65, 0x74, 0x92, 0x9f, 0x49, 0x05, 0x35, 0x30, 0x30, 0x6d, 0x73, 0x12, 0x4d, 0x0a,
+	0x09, 0x73, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x15, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x73, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x72, 0x65,
+	0x71, 0x1a, 0x16, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x11, 0x8a, 0x9f, 0x49, 0x04, 0x70,
+	0x6f, 0x73, 0x74, 0x92, 0x9f, 0x49, 0x05, 0x32, 0x35, 0x30, 0x6d, 0x73, 0x12, 0x5b, 0x0a, 0x0e,
+	0x73, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x1a,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x73, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6c, 0x69,
+	0x73, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x10, 0x8a, 0x9f, 0x49, 0x03, 0x67, 0x65, 0x74,
+	0x92, 0x9f, 0x49, 0x05, 0x32, 0x35, 0x30, 0x6d, 0x73, 0x12, 0x62, 0x0a, 0x10, 0x73, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x12, 0x1c, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x5f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x5f, 0x72, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x63,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x11, 0x8a, 0x9f, 0x49, 0x04,
+	0x70, 0x6f, 0x73, 0x74, 0x92, 0x9f, 0x49, 0x05, 0x32, 0x35, 0x30, 0x6d, 0x73, 0x12, 0x47, 0x0a,
+	0x07, 0x73, 0x63, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x13, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x73, 0x63, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x71, 0x1a, 0x14, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x63, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x5f, 0x72,
+	0x65, 0x73, 0x70, 0x22, 0x11, 0x8a, 0x9f, 0x49, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x92, 0x9f, 0x49,
+	0x05, 0x32, 0x35, 0x30, 0x6d, 0x73, 0x12, 0x5f, 0x0a, 0x0f, 0x73, 0x63, 0x61, 0x6e, 0x61, 0x72,
+	0x79, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x73, 0x63, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x6f,
+	0x74, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x73, 0x63, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x5f,
+	0x72, 0x65, 0x73, 0x70, 0x22, 0x11, 0x8a, 0x9f, 0x49, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x92, 0x9f,
+	0x49, 0x05, 0x32, 0x35, 0x30, 0x6d, 0x73, 0x12, 0x59, 0x0a, 0x0d, 0x73, 0x63, 0x61, 0x6e, 0x61,
+	0x72, 0x79, 0x5f, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x12, 0x19, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x73, 0x63, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x5f, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x5f,
+	0x72, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x63, 0x61,
+	0x6e, 0x61, 0x72, 0x79, 0x5f, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22,
+	0x11, 0x8a, 0x9f, 0x49, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x92, 0x9f, 0x49, 0x05, 0x32, 0x35, 0x30,
+	0x6d, 0x73, 0x12, 0x47, 0x0a, 0x07, 0x73, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x13, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x72,
+	0x65, 0x71, 0x1a, 0x14, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x11, 0x8a, 0x9f, 0x49, 0x04, 0x70, 0x6f,
+	0x73, 0x74, 0x92, 0x9f, 0x49, 0x05, 0x32, 0x35, 0x30, 0x6d, 0x73, 0x12, 0x49, 0x0a, 0x08, 0x73,
+	0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x12, 0x14, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x73, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x1a, 0x15, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x5f,
+	0x72, 0x65, 0x73, 0x70, 0x22, 0x10, 0x8a, 0x9f, 0x49, 0x03, 0x67, 0x65, 0x74, 0x92, 0x9f, 0x49,
+	0x05, 0x32, 0x35, 0x30, 0x6d, 0x73, 0x12, 0x47, 0x0a, 0x07, 0x73, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x12, 0x13, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x73, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x11, 0x8a, 0x9f,
+	0x49, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x92, 0x9f, 0x49, 0x05, 0x35, 0x30, 0x30, 0x6d, 0x73, 0x12,
+	0x4a, 0x0a, 0x08, 0x73, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x2e, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x72, 0x65,
+	0x71, 0x1a, 0x15, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x72, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x11, 0x8a, 0x9f, 0x49, 0x04, 0x70, 0x6f,
+	0x73, 0x74, 0x92, 0x9f, 0x49, 0x05, 0x35, 0x30, 0x30, 0x6d, 0x73, 0x12, 0x44, 0x0a, 0x06, 0x73,
+	0x70, 0x75, 0x72, 0x67, 0x65, 0x12, 0x12, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73,
+	0x70, 0x75, 0x72, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x73, 0x70, 0x75, 0x72, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x11,
+	0x8a, 0x9f, 0x49, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x92, 0x9f, 0x49, 0x05, 0x35, 0x30, 0x30, 0x6d,
+	0x73, 0x12, 0x49, 0x0a, 0x08, 0x73, 0x72, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x12, 0x14, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x72, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x5f,
+	0x72, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x72, 0x65,
+	0x63, 0x79, 0x63, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x10, 0x8a, 0x9f, 0x49, 0x03,
+	0x67, 0x65, 0x74, 0x92, 0x9f, 0x49, 0x05, 0x35, 0x30, 0x30, 0x6d, 0x73, 0x12, 0x3e, 0x0a, 0x04,
+	0x73, 0x74, 0x61, 0x67, 0x12, 0x10, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x74,
+	0x61, 0x67, 0x5f, 0x72, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x73, 0x74, 0x61, 0x67, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x11, 0x8a, 0x9f, 0x49, 0x04, 0x70,
+	0x6f, 0x73, 0x74, 0x92, 0x9f, 0x49, 0x05, 0x32, 0x35, 0x30, 0x6d, 0x73, 0x12, 0x5c, 0x0a, 0x0e,
+	0x73, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x74, 0x12, 0x1a,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x73, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73,
+	0x65, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x11, 0x8a, 0x9f, 0x49, 0x04, 0x70, 0x6f, 0x73,
+	0x74, 0x92, 0x9f, 0x49, 0x05, 0x32, 0x35, 0x30, 0x6d, 0x73, 0x12, 0x5b, 0x0a, 0x0e, 0x73, 0x72,
+	0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x67, 0x65, 0x74, 0x12, 0x1a, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x67, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x73, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x67, 0x65, 0x74,
+	0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x10, 0x8a, 0x9f, 0x49, 0x03, 0x67, 0x65, 0x74, 0x92, 0x9f,
+	0x49, 0x05, 0x32, 0x35, 0x30, 0x6d, 0x73, 0x12, 0x5c, 0x0a, 0x0e, 0x73, 0x70, 0x72, 0x69, 0x6e,
+	0x63, 0x69, 0x70, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x74, 0x12, 0x1a, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x73, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x5f, 0x73, 0x65,
+	0x74, 0x5f, 0x72, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73,
+	0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x72, 0x65,
+	0x73, 0x70, 0x22, 0x11, 0x8a, 0x9f, 0x49, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x92, 0x9f, 0x49, 0x05,
+	0x32, 0x35, 0x30, 0x6d, 0x73, 0x12, 0x5c, 0x0a, 0x0e, 0x73, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69,
+	0x70, 0x61, 0x6c, 0x5f, 0x64, 0x65, 0x6c, 0x12, 0x1a, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x73, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x5f, 0x64, 0x65, 0x6c, 0x5f,
+	0x72, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x70, 0x72,
+	0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x5f, 0x64, 0x65, 0x6c, 0x5f, 0x72, 0x65, 0x73, 0x70,
+	0x22, 0x11, 0x8a, 0x9f, 0x49, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x92, 0x9f, 0x49, 0x05, 0x32, 0x35,
+	0x30, 0x6d, 0x73, 0x12, 0x52, 0x0a, 0x0b, 0x73, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61,
+	0x6c, 0x73, 0x12, 0x17, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x70, 0x72, 0x69,
+	0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x73, 0x5f, 0x72, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x73,
+	0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x10, 0x8a, 0x9f, 0x49, 0x03, 0x67, 0x65, 0x74, 0x92, 0x9f,
+	0x49, 0x05, 0x32, 0x35, 0x30, 0x6d, 0x73, 0x12, 0x44, 0x0a, 0x06, 0x73, 0x67, 0x72, 0x61, 0x6e,
+	0x74, 0x12, 0x12, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x67, 0x72, 0x61, 0x6e,
+	0x74, 0x5f, 0x72, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73,
+	0x67, 0x72, 0x61, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x11, 0x8a, 0x9f, 0x49, 0x04,
+	0x70, 0x6f, 0x73, 0x74, 0x92, 0x9f, 0x49, 0x05, 0x32, 0x35, 0x30, 0x6d, 0x73, 0x12, 0x46, 0x0a,
+	0x07, 0x73, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x13, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x73, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x5f, 0x72, 0x65, 0x71, 0x1a, 0x14, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x5f, 0x72,
+	0x65, 0x73, 0x70, 0x22, 0x10, 0x8a, 0x9f, 0x49, 0x03, 0x67, 0x65, 0x74, 0x92, 0x9f, 0x49, 0x05,
+	0x32, 0x35, 0x30, 0x6d, 0x73, 0x12, 0x56, 0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f,
+	0x69, 0x73, 0x73, 0x75, 0x65, 0x12, 0x18, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x1a,
+	0x19, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f,
+	0x69, 0x73, 0x73, 0x75, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x11, 0x8a, 0x9f, 0x49, 0x04,
+	0x70, 0x6f, 0x73, 0x74, 0x92, 0x9f, 0x49, 0x05, 0x32, 0x35, 0x30, 0x6d, 0x73, 0x12, 0x59, 0x0a,
+	0x0d, 0x73, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x12, 0x19,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x72,
+	0x6f, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x73, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x65,
+	0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x11, 0x8a, 0x9f, 0x49, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x92,
+	0x9f, 0x49, 0x05, 0x32, 0x35, 0x30, 0x6d, 0x73, 0x12, 0x59, 0x0a, 0x0d, 0x73, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x5f, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x12, 0x19, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x73, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65,
+	0x5f, 0x72, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70,
+	0x22, 0x11, 0x8a, 0x9f, 0x49, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x92, 0x9f, 0x49, 0x05, 0x32, 0x35,
+	0x30, 0x6d, 0x73, 0x12, 0x46, 0x0a, 0x07, 0x73, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x13,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x5f,
+	0x72, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x10, 0x8a, 0x9f, 0x49, 0x03, 0x67,
+	0x65, 0x74, 0x92, 0x9f, 0x49, 0x05, 0x32, 0x35, 0x30, 0x6d, 0x73, 0x12, 0x4d, 0x0a, 0x09, 0x73,
+	0x62, 0x61, 0x73, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x12, 0x15, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x73, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x1a,
+	0x16, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x73,
+	0x65, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x11, 0x8a, 0x9f, 0x49, 0x04, 0x70, 0x6f, 0x73,
+	0x74, 0x92, 0x9f, 0x49, 0x05, 0x35, 0x30, 0x30, 0x6d, 0x73, 0x12, 0x4c, 0x0a, 0x09, 0x73, 0x62,
+	0x61, 0x73, 0x65, 0x5f, 0x67, 0x65, 0x74, 0x12, 0x15, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x73, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x67, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x1a, 0x16,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x67, 0x65,
+	0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x10, 0x8a, 0x9f, 0x49, 0x03, 0x67, 0x65, 0x74, 0x92,
+	0x9f, 0x49, 0x05, 0x32, 0x35, 0x30, 0x6d, 0x73, 0x12, 0x46, 0x0a, 0x07, 0x73, 0x72, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x12, 0x13, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x72, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x73, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x10,
+	0x8a, 0x9f, 0x49, 0x03, 0x67, 0x65, 0x74, 0x92, 0x9f, 0x49, 0x05, 0x32, 0x35, 0x30, 0x6d, 0x73,
+	0x42, 0x10, 0x5a, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x3b, 0x61,
+	0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_sconfig_proto_rawDescOnce sync.Once
+	file_api_sconfig_proto_rawDescData = file_api_sconfig_proto_rawDesc
+)
+
+func file_api_sconfig_proto_rawDescGZIP() []byte {
+	file_api_sconfig_proto_rawDescOnce.Do(func() {
+		file_api_sconfig_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_sconfig_proto_rawDescData)
+	})
+	return file_api_sconfig_proto_rawDescData
+}
+
+var file_api_sconfig_proto_msgTypes = make([]protoimpl.MessageInfo, 103)
+var file_api_sconfig_proto_goTypes = []interface{}{
+	(*SinfoReq)(nil),            // 0: config.sinfo_req
+	(*SinfoResp)(nil),           // 1: config.sinfo_resp
+	(*SsetReq)(nil),             // 2: config.sset_req
+	(*SsetResp)(nil),            // 3: config.sset_resp
+	(*SrollbackReq)(nil),        // 4: config.srollback_req
+	(*SrollbackResp)(nil),       // 5: config.srollback_resp
+	(*SgetReq)(nil),             // 6: config.sget_req
+	(*SgetResp)(nil),            // 7: config.sget_resp
+	(*SgroupsReq)(nil),          // 8: config.sgroups_req
+	(*SgroupsResp)(nil),         // 9: config.sgroups_resp
+	(*SappsReq)(nil),            // 10: config.sapps_req
+	(*SappsResp)(nil),           // 11: config.sapps_resp
+	(*SwatchReq)(nil),           // 12: config.swatch_req
+	(*SwatchResp)(nil),          // 13: config.swatch_resp
+	(*ShistoryReq)(nil),         // 14: config.shistory_req
+	(*ShistoryResp)(nil),        // 15: config.shistory_resp
+	(*HistoryInfo)(nil),         // 16: config.history_info
+	(*SauditReq)(nil),           // 17: config.saudit_req
+	(*SauditResp)(nil),          // 18: config.saudit_resp
+	(*AuditInfo)(nil),           // 19: config.audit_info
+	(*SdiffReq)(nil),            // 20: config.sdiff_req
+	(*SdiffResp)(nil),           // 21: config.sdiff_resp
+	(*DiffItem)(nil),            // 22: config.diff_item
+	(*SpatchReq)(nil),           // 23: config.spatch_req
+	(*SpatchResp)(nil),          // 24: config.spatch_resp
+	(*SschemaSetReq)(nil),       // 25: config.sschema_set_req
+	(*SschemaSetResp)(nil),      // 26: config.sschema_set_resp
+	(*SschemaGetReq)(nil),       // 27: config.sschema_get_req
+	(*SschemaGetResp)(nil),      // 28: config.sschema_get_resp
+	(*SpublishReq)(nil),         // 29: config.spublish_req
+	(*SpublishResp)(nil),        // 30: config.spublish_resp
+	(*SpolicySetReq)(nil),       // 31: config.spolicy_set_req
+	(*SpolicySetResp)(nil),      // 32: config.spolicy_set_resp
+	(*SpolicyGetReq)(nil),       // 33: config.spolicy_get_req
+	(*SpolicyGetResp)(nil),      // 34: config.spolicy_get_resp
+	(*SapproveReq)(nil),         // 35: config.sapprove_req
+	(*SapproveResp)(nil),        // 36: config.sapprove_resp
+	(*SrejectReq)(nil),          // 37: config.sreject_req
+	(*SrejectResp)(nil),         // 38: config.sreject_resp
+	(*SpendingReq)(nil),         // 39: config.spending_req
+	(*SpendingResp)(nil),        // 40: config.spending_resp
+	(*PendingInfo)(nil),         // 41: config.pending_info
+	(*SscheduleReq)(nil),        // 42: config.sschedule_req
+	(*SscheduleResp)(nil),       // 43: config.sschedule_resp
+	(*SscheduleListReq)(nil),    // 44: config.sschedule_list_req
+	(*SscheduleListResp)(nil),   // 45: config.sschedule_list_resp
+	(*ScheduleInfo)(nil),        // 46: config.schedule_info
+	(*SscheduleCancelReq)(nil),  // 47: config.sschedule_cancel_req
+	(*SscheduleCancelResp)(nil), // 48: config.sschedule_cancel_resp
+	(*ScanaryReq)(nil),          // 49: config.scanary_req
+	(*ScanaryResp)(nil),         // 50: config.scanary_resp
+	(*ScanaryPromoteReq)(nil),   // 51: config.scanary_promote_req
+	(*ScanaryPromoteResp)(nil),  // 52: config.scanary_promote_resp
+	(*ScanaryAbortReq)(nil),     // 53: config.scanary_abort_req
+	(*ScanaryAbortResp)(nil),    // 54: config.scanary_abort_resp
+	(*SreportReq)(nil),          // 55: config.sreport_req
+	(*SreportResp)(nil),         // 56: config.sreport_resp
+	(*SrolloutReq)(nil),         // 57: config.srollout_req
+	(*SrolloutResp)(nil),        // 58: config.srollout_resp
+	(*RolloutInfo)(nil),         // 59: config.rollout_info
+	(*PublishGuard)(nil),        // 60: config.publish_guard
+	(*SdeleteReq)(nil),          // 61: config.sdelete_req
+	(*SdeleteResp)(nil),         // 62: config.sdelete_resp
+	(*SrestoreReq)(nil),         // 63: config.srestore_req
+	(*SrestoreResp)(nil),        // 64: config.srestore_resp
+	(*SpurgeReq)(nil),           // 65: config.spurge_req
+	(*SpurgeResp)(nil),          // 66: config.spurge_resp
+	(*SrecycleReq)(nil),         // 67: config.srecycle_req
+	(*SrecycleResp)(nil),        // 68: config.srecycle_resp
+	(*RecycleInfo)(nil),         // 69: config.recycle_info
+	(*StagReq)(nil),             // 70: config.stag_req
+	(*StagResp)(nil),            // 71: config.stag_resp
+	(*SretentionSetReq)(nil),    // 72: config.sretention_set_req
+	(*SretentionSetResp)(nil),   // 73: config.sretention_set_resp
+	(*SretentionGetReq)(nil),    // 74: config.sretention_get_req
+	(*SretentionGetResp)(nil),   // 75: config.sretention_get_resp
+	(*SprincipalSetReq)(nil),    // 76: config.sprincipal_set_req
+	(*SprincipalSetResp)(nil),   // 77: config.sprincipal_set_resp
+	(*SprincipalDelReq)(nil),    // 78: config.sprincipal_del_req
+	(*SprincipalDelResp)(nil),   // 79: config.sprincipal_del_resp
+	(*SprincipalsReq)(nil),      // 80: config.sprincipals_req
+	(*SprincipalsResp)(nil),     // 81: config.sprincipals_resp
+	(*PrincipalInfo)(nil),       // 82: config.principal_info
+	(*SgrantReq)(nil),           // 83: config.sgrant_req
+	(*SgrantResp)(nil),          // 84: config.sgrant_resp
+	(*SgrantsReq)(nil),          // 85: config.sgrants_req
+	(*SgrantsResp)(nil),         // 86: config.sgrants_resp
+	(*GrantInfo)(nil),           // 87: config.grant_info
+	(*StokenIssueReq)(nil),      // 88: config.stoken_issue_req
+	(*StokenIssueResp)(nil),     // 89: config.stoken_issue_resp
+	(*StokenRotateReq)(nil),     // 90: config.stoken_rotate_req
+	(*StokenRotateResp)(nil),    // 91: config.stoken_rotate_resp
+	(*StokenRevokeReq)(nil),     // 92: config.stoken_revoke_req
+	(*StokenRevokeResp)(nil),    // 93: config.stoken_revoke_resp
+	(*StokensReq)(nil),          // 94: config.stokens_req
+	(*StokensResp)(nil),         // 95: config.stokens_resp
+	(*ReadTokenInfo)(nil),       // 96: config.read_token_info
+	(*SbaseSetReq)(nil),         // 97: config.sbase_set_req
+	(*SbaseSetResp)(nil),        // 98: config.sbase_set_resp
+	(*SbaseGetReq)(nil),         // 99: config.sbase_get_req
+	(*SbaseGetResp)(nil),        // 100: config.sbase_get_resp
+	(*SrenderReq)(nil),          // 101: config.srender_req
+	(*SrenderResp)(nil),         // 102: config.srender_resp
+}
+var file_api_sconfig_proto_depIdxs = []int32{
+	60,  // 0: config.sset_req.guard:type_name -> config.publish_guard
+	16,  // 1: config.shistory_resp.versions:type_name -> config.history_info
+	19,  // 2: config.saudit_resp.audits:type_name -> config.audit_info
+	22,  // 3: config.sdiff_resp.app_config:type_name -> config.diff_item
+	22,  // 4: config.sdiff_resp.source_config:type_name -> config.diff_item
+	60,  // 5: config.spatch_req.guard:type_name -> config.publish_guard
+	60,  // 6: config.spublish_req.guard:type_name -> config.publish_guard
+	41,  // 7: config.spending_resp.changes:type_name -> config.pending_info
+	46,  // 8: config.sschedule_list_resp.schedules:type_name -> config.schedule_info
+	59,  // 9: config.srollout_resp.instances:type_name -> config.rollout_info
+	69,  // 10: config.srecycle_resp.apps:type_name -> config.recycle_info
+	82,  // 11: config.sprincipals_resp.principals:type_name -> config.principal_info
+	87,  // 12: config.sgrants_resp.grants:type_name -> config.grant_info
+	96,  // 13: config.stokens_resp.tokens:type_name -> config.read_token_info
+	0,   // 14: config.sconfig.sinfo:input_type -> config.sinfo_req
+	2,   // 15: config.sconfig.sset:input_type -> config.sset_req
+	4,   // 16: config.sconfig.srollback:input_type -> config.srollback_req
+	6,   // 17: config.sconfig.sget:input_type -> config.sget_req
+	8,   // 18: config.sconfig.sgroups:input_type -> config.sgroups_req
+	10,  // 19: config.sconfig.sapps:input_type -> config.sapps_req
+	12,  // 20: config.sconfig.swatch:input_type -> config.swatch_req
+	14,  // 21: config.sconfig.shistory:input_type -> config.shistory_req
+	17,  // 22: config.sconfig.saudit:input_type -> config.saudit_req
+	23,  // 23: config.sconfig.spatch:input_type -> config.spatch_req
+	25,  // 24: config.sconfig.sschema_set:input_type -> config.sschema_set_req
+	27,  // 25: config.sconfig.sschema_get:input_type -> config.sschema_get_req
+	20,  // 26: config.sconfig.sdiff:input_type -> config.sdiff_req
+	29,  // 27: config.sconfig.spublish:input_type -> config.spublish_req
+	31,  // 28: config.sconfig.spolicy_set:input_type -> config.spolicy_set_req
+	33,  // 29: config.sconfig.spolicy_get:input_type -> config.spolicy_get_req
+	35,  // 30: config.sconfig.sapprove:input_type -> config.sapprove_req
+	37,  // 31: config.sconfig.sreject:input_type -> config.sreject_req
+	39,  // 32: config.sconfig.spending:input_type -> config.spending_req
+	42,  // 33: config.sconfig.sschedule:input_type -> config.sschedule_req
+	44,  // 34: config.sconfig.sschedule_list:input_type -> config.sschedule_list_req
+	47,  // 35: config.sconfig.sschedule_cancel:input_type -> config.sschedule_cancel_req
+	49,  // 36: config.sconfig.scanary:input_type -> config.scanary_req
+	51,  // 37: config.sconfig.scanary_promote:input_type -> config.scanary_promote_req
+	53,  // 38: config.sconfig.scanary_abort:input_type -> config.scanary_abort_req
+	55,  // 39: config.sconfig.sreport:input_type -> config.sreport_req
+	57,  // 40: config.sconfig.srollout:input_type -> config.srollout_req
+	61,  // 41: config.sconfig.sdelete:input_type -> config.sdelete_req
+	63,  // 42: config.sconfig.srestore:input_type -> config.srestore_req
+	65,  // 43: config.sconfig.spurge:input_type -> config.spurge_req
+	67,  // 44: config.sconfig.srecycle:input_type -> config.srecycle_req
+	70,  // 45: config.sconfig.stag:input_type -> config.stag_req
+	72,  // 46: config.sconfig.sretention_set:input_type -> config.sretention_set_req
+	74,  // 47: config.sconfig.sretention_get:input_type -> config.sretention_get_req
+	76,  // 48: config.sconfig.sprincipal_set:input_type -> config.sprincipal_set_req
+	78,  // 49: config.sconfig.sprincipal_del:input_type -> config.sprincipal_del_req
+	80,  // 50: config.sconfig.sprincipals:input_type -> config.sprincipals_req
+	83,  // 51: config.sconfig.sgrant:input_type -> config.sgrant_req
+	85,  // 52: config.sconfig.sgrants:input_type -> config.sgrants_req
+	88,  // 53: config.sconfig.stoken_issue:input_type -> config.stoken_issue_req
+	90,  // 54: config.sconfig.stoken_rotate:input_type -> config.stoken_rotate_req
+	92,  // 55: config.sconfig.stoken_revoke:input_type -> config.stoken_revoke_req
+	94,  // 56: config.sconfig.stokens:input_type -> config.stokens_req
+	97,  // 57: config.sconfig.sbase_set:input_type -> config.sbase_set_req
+	99,  // 58: config.sconfig.sbase_get:input_type -> config.sbase_get_req
+	101, // 59: config.sconfig.srender:input_type -> config.srender_req
+	1,   // 60: config.sconfig.sinfo:output_type -> config.sinfo_resp
+	3,   // 61: config.sconfig.sset:output_type -> config.sset_resp
+	5,   // 62: config.sconfig.srollback:output_type -> config.srollback_resp
+	7,   // 63: config.sconfig.sget:output_type -> config.sget_resp
+	9,   // 64: config.sconfig.sgroups:output_type -> config.sgroups_resp
+	11,  // 65: config.sconfig.sapps:output_type -> config.sapps_resp
+	13,  // 66: config.sconfig.swatch:output_type -> config.swatch_resp
+	15,  // 67: config.sconfig.shistory:output_type -> config.shistory_resp
+	18,  // 68: config.sconfig.saudit:output_type -> config.saudit_resp
+	24,  // 69: config.sconfig.spatch:output_type -> config.spatch_resp
+	26,  // 70: config.sconfig.sschema_set:output_type -> config.sschema_set_resp
+	28,  // 71: config.sconfig.sschema_get:output_type -> config.sschema_get_resp
+	21,  // 72: config.sconfig.sdiff:output_type -> config.sdiff_resp
+	30,  // 73: config.sconfig.spublish:output_type -> config.spublish_resp
+	32,  // 74: config.sconfig.spolicy_set:output_type -> config.spolicy_set_resp
+	34,  // 75: config.sconfig.spolicy_get:output_type -> config.spolicy_get_resp
+	36,  // 76: config.sconfig.sapprove:output_type -> config.sapprove_resp
+	38,  // 77: config.sconfig.sreject:output_type -> config.sreject_resp
+	40,  // 78: config.sconfig.spending:output_type -> config.spending_resp
+	43,  // 79: config.sconfig.sschedule:output_type -> config.sschedule_resp
+	45,  // 80: config.sconfig.sschedule_list:output_type -> config.sschedule_list_resp
+	48,  // 81: config.sconfig.sschedule_cancel:output_type -> config.sschedule_cancel_resp
+	50,  // 82: config.sconfig.scanary:output_type -> config.scanary_resp
+	52,  // 83: config.sconfig.scanary_promote:output_type -> config.scanary_promote_resp
+	54,  // 84: config.sconfig.scanary_abort:output_type -> config.scanary_abort_resp
+	56,  // 85: config.sconfig.sreport:output_type -> config.sreport_resp
+	58,  // 86: config.sconfig.srollout:output_type -> config.srollout_resp
+	62,  // 87: config.sconfig.sdelete:output_type -> config.sdelete_resp
+	64,  // 88: config.sconfig.srestore:output_type -> config.srestore_resp
+	66,  // 89: config.sconfig.spurge:output_type -> config.spurge_resp
+	68,  // 90: config.sconfig.srecycle:output_type -> config.srecycle_resp
+	71,  // 91: config.sconfig.stag:output_type -> config.stag_resp
+	73,  // 92: config.sconfig.sretention_set:output_type -> config.sretention_set_resp
+	75,  // 93: config.sconfig.sretention_get:output_type -> config.sretention_get_resp
+	77,  // 94: config.sconfig.sprincipal_set:output_type -> config.sprincipal_set_resp
+	79,  // 95: config.sconfig.sprincipal_del:output_type -> config.sprincipal_del_resp
+	81,  // 96: config.sconfig.sprincipals:output_type -> config.sprincipals_resp
+	84,  // 97: config.sconfig.sgrant:output_type -> config.sgrant_resp
+	86,  // 98: config.sconfig.sgrants:output_type -> config.sgrants_resp
+	89,  // 99: config.sconfig.stoken_issue:output_type -> config.stoken_issue_resp
+	91,  // 100: config.sconfig.stoken_rotate:output_type -> config.stoken_rotate_resp
+	93,  // 101: config.sconfig.stoken_revoke:output_type -> config.stoken_revoke_resp
+	95,  // 102: config.sconfig.stokens:output_type -> config.stokens_resp
+	98,  // 103: config.sconfig.sbase_set:output_type -> config.sbase_set_resp
+	100, // 104: config.sconfig.sbase_get:output_type -> config.sbase_get_resp
+	102, // 105: config.sconfig.srender:output_type -> config.srender_resp
+	60,  // [60:106] is the sub-list for method output_type
+	14,  // [14:60] is the sub-list for method input_type
+	14,  // [14:14] is the sub-list for extension type_name
+	14,  // [14:14] is the sub-list for extension extendee
+	0,   // [0:14] is the sub-list for field type_name
+}
+
+func init() { file_api_sconfig_proto_init() }
+func file_api_sconfig_proto_init() {
+	if File_api_sconfig_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_sconfig_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SinfoReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SinfoResp); i {
 			case 0:
 				return &v.state
@@ -969,8 +7752,800 @@ func file_api_sconfig_proto_init() {
 				return nil
 			}
 		}
-		file_api_sconfig_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SsetReq); i {
+		file_api_sconfig_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SsetReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SsetResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SrollbackReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SrollbackResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SgetReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SgetResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SgroupsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SgroupsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SappsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SappsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwatchReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwatchResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShistoryReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShistoryResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HistoryInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SauditReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SauditResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SdiffReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SdiffResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpatchReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpatchResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SschemaSetReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SschemaSetResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SschemaGetReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SschemaGetResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpublishReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpublishResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpolicySetReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpolicySetResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpolicyGetReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpolicyGetResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SapproveReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SapproveResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SrejectReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SrejectResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpendingReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpendingResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PendingInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SscheduleReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SscheduleResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SscheduleListReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SscheduleListResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduleInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SscheduleCancelReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SscheduleCancelResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScanaryReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScanaryResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScanaryPromoteReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScanaryPromoteResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScanaryAbortReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScanaryAbortResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SreportReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SreportResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SrolloutReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SrolloutResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RolloutInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishGuard); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SdeleteReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SdeleteResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SrestoreReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SrestoreResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpurgeReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpurgeResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SrecycleReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SrecycleResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -981,8 +8556,8 @@ func file_api_sconfig_proto_init() {
 				return nil
 			}
 		}
-		file_api_sconfig_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SsetResp); i {
+		file_api_sconfig_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecycleInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -993,8 +8568,8 @@ func file_api_sconfig_proto_init() {
 				return nil
 			}
 		}
-		file_api_sconfig_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SrollbackReq); i {
+		file_api_sconfig_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StagReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1005,8 +8580,8 @@ func file_api_sconfig_proto_init() {
 				return nil
 			}
 		}
-		file_api_sconfig_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SrollbackResp); i {
+		file_api_sconfig_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StagResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1017,8 +8592,8 @@ func file_api_sconfig_proto_init() {
 				return nil
 			}
 		}
-		file_api_sconfig_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SgetReq); i {
+		file_api_sconfig_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SretentionSetReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1029,8 +8604,8 @@ func file_api_sconfig_proto_init() {
 				return nil
 			}
 		}
-		file_api_sconfig_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SgetResp); i {
+		file_api_sconfig_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SretentionSetResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1041,8 +8616,8 @@ func file_api_sconfig_proto_init() {
 				return nil
 			}
 		}
-		file_api_sconfig_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SgroupsReq); i {
+		file_api_sconfig_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SretentionGetReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1053,8 +8628,8 @@ func file_api_sconfig_proto_init() {
 				return nil
 			}
 		}
-		file_api_sconfig_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SgroupsResp); i {
+		file_api_sconfig_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SretentionGetResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1065,8 +8640,8 @@ func file_api_sconfig_proto_init() {
 				return nil
 			}
 		}
-		file_api_sconfig_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SappsReq); i {
+		file_api_sconfig_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SprincipalSetReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1077,8 +8652,8 @@ func file_api_sconfig_proto_init() {
 				return nil
 			}
 		}
-		file_api_sconfig_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SappsResp); i {
+		file_api_sconfig_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SprincipalSetResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1089,8 +8664,8 @@ func file_api_sconfig_proto_init() {
 				return nil
 			}
 		}
-		file_api_sconfig_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SwatchaddrReq); i {
+		file_api_sconfig_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SprincipalDelReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1101,8 +8676,284 @@ func file_api_sconfig_proto_init() {
 				return nil
 			}
 		}
-		file_api_sconfig_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SwatchaddrResp); i {
+		file_api_sconfig_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SprincipalDelResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SprincipalsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SprincipalsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrincipalInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SgrantReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SgrantResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SgrantsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SgrantsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrantInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StokenIssueReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StokenIssueResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StokenRotateReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StokenRotateResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StokenRevokeReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StokenRevokeResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StokensReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StokensResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadTokenInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SbaseSetReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SbaseSetResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SbaseGetReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SbaseGetResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SrenderReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_sconfig_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SrenderResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1120,7 +8971,7 @@ func file_api_sconfig_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_sconfig_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   103,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
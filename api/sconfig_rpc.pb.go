@@ -18,7 +18,7 @@ import (
 var _SconfigRpcCheckers map[string]func(req interface{}) string
 
 func init() {
-	_SconfigRpcCheckers = make(map[string]func(req interface{}) string, 5)
+	_SconfigRpcCheckers = make(map[string]func(req interface{}) string, 42)
 	_SconfigRpcCheckers["\"\\\"Config/api\\\"\".SappsReq"] = func(r interface{}) string {
 		req := r.(*SappsReq)
 		if len(req.Groupname) <= 0 {
@@ -72,6 +72,367 @@ func init() {
 		}
 		return ""
 	}
+	_SconfigRpcCheckers["\"\\\"Config/api\\\"\".SwatchReq"] = func(r interface{}) string {
+		req := r.(*SwatchReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: swatch_req check value str len gt failed"
+		}
+		if len(req.Appname) <= 0 {
+			return "field: appname in object: swatch_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigRpcCheckers["\"\\\"Config/api\\\"\".ShistoryReq"] = func(r interface{}) string {
+		req := r.(*ShistoryReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: shistory_req check value str len gt failed"
+		}
+		if len(req.Appname) <= 0 {
+			return "field: appname in object: shistory_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigRpcCheckers["\"\\\"Config/api\\\"\".SauditReq"] = func(r interface{}) string {
+		req := r.(*SauditReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: saudit_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigRpcCheckers["\"\\\"Config/api\\\"\".SdiffReq"] = func(r interface{}) string {
+		req := r.(*SdiffReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: sdiff_req check value str len gt failed"
+		}
+		if len(req.Appname) <= 0 {
+			return "field: appname in object: sdiff_req check value str len gt failed"
+		}
+		if req.FromIndex <= 0 {
+			return "field: from_index in object: sdiff_req check value uint gt failed"
+		}
+		return ""
+	}
+	_SconfigRpcCheckers["\"\\\"Config/api\\\"\".SpatchReq"] = func(r interface{}) string {
+		req := r.(*SpatchReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: spatch_req check value str len gt failed"
+		}
+		if len(req.Appname) <= 0 {
+			return "field: appname in object: spatch_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigRpcCheckers["\"\\\"Config/api\\\"\".SschemaGetReq"] = func(r interface{}) string {
+		req := r.(*SschemaGetReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: sschema_get_req check value str len gt failed"
+		}
+		if len(req.Appname) <= 0 {
+			return "field: appname in object: sschema_get_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigRpcCheckers["\"\\\"Config/api\\\"\".SschemaSetReq"] = func(r interface{}) string {
+		req := r.(*SschemaSetReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: sschema_set_req check value str len gt failed"
+		}
+		if len(req.Appname) <= 0 {
+			return "field: appname in object: sschema_set_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigRpcCheckers["\"\\\"Config/api\\\"\".SpublishReq"] = func(r interface{}) string {
+		req := r.(*SpublishReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: spublish_req check value str len gt failed"
+		}
+		if len(req.Appname) <= 0 {
+			return "field: appname in object: spublish_req check value str len gt failed"
+		}
+		if req.Index <= 0 {
+			return "field: index in object: spublish_req check value uint gt failed"
+		}
+		return ""
+	}
+	_SconfigRpcCheckers["\"\\\"Config/api\\\"\".SapproveReq"] = func(r interface{}) string {
+		req := r.(*SapproveReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: sapprove_req check value str len gt failed"
+		}
+		if len(req.Appname) <= 0 {
+			return "field: appname in object: sapprove_req check value str len gt failed"
+		}
+		if req.Index <= 0 {
+			return "field: index in object: sapprove_req check value uint gt failed"
+		}
+		return ""
+	}
+	_SconfigRpcCheckers["\"\\\"Config/api\\\"\".SpendingReq"] = func(r interface{}) string {
+		req := r.(*SpendingReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: spending_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigRpcCheckers["\"\\\"Config/api\\\"\".SpolicyGetReq"] = func(r interface{}) string {
+		req := r.(*SpolicyGetReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: spolicy_get_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigRpcCheckers["\"\\\"Config/api\\\"\".SpolicySetReq"] = func(r interface{}) string {
+		req := r.(*SpolicySetReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: spolicy_set_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigRpcCheckers["\"\\\"Config/api\\\"\".SrejectReq"] = func(r interface{}) string {
+		req := r.(*SrejectReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: sreject_req check value str len gt failed"
+		}
+		if len(req.Appname) <= 0 {
+			return "field: appname in object: sreject_req check value str len gt failed"
+		}
+		if req.Index <= 0 {
+			return "field: index in object: sreject_req check value uint gt failed"
+		}
+		return ""
+	}
+	_SconfigRpcCheckers["\"\\\"Config/api\\\"\".SscheduleCancelReq"] = func(r interface{}) string {
+		req := r.(*SscheduleCancelReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: sschedule_cancel_req check value str len gt failed"
+		}
+		if len(req.Appname) <= 0 {
+			return "field: appname in object: sschedule_cancel_req check value str len gt failed"
+		}
+		if len(req.Id) <= 0 {
+			return "field: id in object: sschedule_cancel_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigRpcCheckers["\"\\\"Config/api\\\"\".SscheduleListReq"] = func(r interface{}) string {
+		req := r.(*SscheduleListReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: sschedule_list_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigRpcCheckers["\"\\\"Config/api\\\"\".SscheduleReq"] = func(r interface{}) string {
+		req := r.(*SscheduleReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: sschedule_req check value str len gt failed"
+		}
+		if len(req.Appname) <= 0 {
+			return "field: appname in object: sschedule_req check value str len gt failed"
+		}
+		if req.Index <= 0 {
+			return "field: index in object: sschedule_req check value uint gt failed"
+		}
+		if len(req.FireTime) <= 0 {
+			return "field: fire_time in object: sschedule_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigRpcCheckers["\"\\\"Config/api\\\"\".ScanaryAbortReq"] = func(r interface{}) string {
+		req := r.(*ScanaryAbortReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: scanary_abort_req check value str len gt failed"
+		}
+		if len(req.Appname) <= 0 {
+			return "field: appname in object: scanary_abort_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigRpcCheckers["\"\\\"Config/api\\\"\".ScanaryPromoteReq"] = func(r interface{}) string {
+		req := r.(*ScanaryPromoteReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: scanary_promote_req check value str len gt failed"
+		}
+		if len(req.Appname) <= 0 {
+			return "field: appname in object: scanary_promote_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigRpcCheckers["\"\\\"Config/api\\\"\".ScanaryReq"] = func(r interface{}) string {
+		req := r.(*ScanaryReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: scanary_req check value str len gt failed"
+		}
+		if len(req.Appname) <= 0 {
+			return "field: appname in object: scanary_req check value str len gt failed"
+		}
+		if req.Index <= 0 {
+			return "field: index in object: scanary_req check value uint gt failed"
+		}
+		return ""
+	}
+	_SconfigRpcCheckers["\"\\\"Config/api\\\"\".SreportReq"] = func(r interface{}) string {
+		req := r.(*SreportReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: sreport_req check value str len gt failed"
+		}
+		if len(req.Appname) <= 0 {
+			return "field: appname in object: sreport_req check value str len gt failed"
+		}
+		if len(req.Instance) <= 0 {
+			return "field: instance in object: sreport_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigRpcCheckers["\"\\\"Config/api\\\"\".SrolloutReq"] = func(r interface{}) string {
+		req := r.(*SrolloutReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: srollout_req check value str len gt failed"
+		}
+		if len(req.Appname) <= 0 {
+			return "field: appname in object: srollout_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigRpcCheckers["\"\\\"Config/api\\\"\".SdeleteReq"] = func(r interface{}) string {
+		req := r.(*SdeleteReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: sdelete_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigRpcCheckers["\"\\\"Config/api\\\"\".SpurgeReq"] = func(r interface{}) string {
+		req := r.(*SpurgeReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: spurge_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigRpcCheckers["\"\\\"Config/api\\\"\".SrestoreReq"] = func(r interface{}) string {
+		req := r.(*SrestoreReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: srestore_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigRpcCheckers["\"\\\"Config/api\\\"\".SretentionGetReq"] = func(r interface{}) string {
+		req := r.(*SretentionGetReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: sretention_get_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigRpcCheckers["\"\\\"Config/api\\\"\".SretentionSetReq"] = func(r interface{}) string {
+		req := r.(*SretentionSetReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: sretention_set_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigRpcCheckers["\"\\\"Config/api\\\"\".StagReq"] = func(r interface{}) string {
+		req := r.(*StagReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: stag_req check value str len gt failed"
+		}
+		if len(req.Appname) <= 0 {
+			return "field: appname in object: stag_req check value str len gt failed"
+		}
+		if req.Index <= 0 {
+			return "field: index in object: stag_req check value uint gt failed"
+		}
+		return ""
+	}
+	_SconfigRpcCheckers["\"\\\"Config/api\\\"\".SgrantReq"] = func(r interface{}) string {
+		req := r.(*SgrantReq)
+		if len(req.Principal) <= 0 {
+			return "field: principal in object: sgrant_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigRpcCheckers["\"\\\"Config/api\\\"\".SprincipalDelReq"] = func(r interface{}) string {
+		req := r.(*SprincipalDelReq)
+		if len(req.Name) <= 0 {
+			return "field: name in object: sprincipal_del_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigRpcCheckers["\"\\\"Config/api\\\"\".SprincipalSetReq"] = func(r interface{}) string {
+		req := r.(*SprincipalSetReq)
+		if len(req.Name) <= 0 {
+			return "field: name in object: sprincipal_set_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigRpcCheckers["\"\\\"Config/api\\\"\".StokenIssueReq"] = func(r interface{}) string {
+		req := r.(*StokenIssueReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: stoken_issue_req check value str len gt failed"
+		}
+		if len(req.Appname) <= 0 {
+			return "field: appname in object: stoken_issue_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigRpcCheckers["\"\\\"Config/api\\\"\".StokenRevokeReq"] = func(r interface{}) string {
+		req := r.(*StokenRevokeReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: stoken_revoke_req check value str len gt failed"
+		}
+		if len(req.Appname) <= 0 {
+			return "field: appname in object: stoken_revoke_req check value str len gt failed"
+		}
+		if len(req.Id) <= 0 {
+			return "field: id in object: stoken_revoke_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigRpcCheckers["\"\\\"Config/api\\\"\".StokenRotateReq"] = func(r interface{}) string {
+		req := r.(*StokenRotateReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: stoken_rotate_req check value str len gt failed"
+		}
+		if len(req.Appname) <= 0 {
+			return "field: appname in object: stoken_rotate_req check value str len gt failed"
+		}
+		if len(req.Id) <= 0 {
+			return "field: id in object: stoken_rotate_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigRpcCheckers["\"\\\"Config/api\\\"\".StokensReq"] = func(r interface{}) string {
+		req := r.(*StokensReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: stokens_req check value str len gt failed"
+		}
+		if len(req.Appname) <= 0 {
+			return "field: appname in object: stokens_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigRpcCheckers["\"\\\"Config/api\\\"\".SbaseGetReq"] = func(r interface{}) string {
+		req := r.(*SbaseGetReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: sbase_get_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigRpcCheckers["\"\\\"Config/api\\\"\".SbaseSetReq"] = func(r interface{}) string {
+		req := r.(*SbaseSetReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: sbase_set_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigRpcCheckers["\"\\\"Config/api\\\"\".SrenderReq"] = func(r interface{}) string {
+		req := r.(*SrenderReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: srender_req check value str len gt failed"
+		}
+		if len(req.Appname) <= 0 {
+			return "field: appname in object: srender_req check value str len gt failed"
+		}
+		return ""
+	}
 }
 
 var _RpcPathSconfigSinfo = "/config.sconfig/sinfo"
@@ -80,7 +441,46 @@ var _RpcPathSconfigSrollback = "/config.sconfig/srollback"
 var _RpcPathSconfigSget = "/config.sconfig/sget"
 var _RpcPathSconfigSgroups = "/config.sconfig/sgroups"
 var _RpcPathSconfigSapps = "/config.sconfig/sapps"
-var _RpcPathSconfigSwatchaddr = "/config.sconfig/swatchaddr"
+var _RpcPathSconfigSwatch = "/config.sconfig/swatch"
+var _RpcPathSconfigShistory = "/config.sconfig/shistory"
+var _RpcPathSconfigSaudit = "/config.sconfig/saudit"
+var _RpcPathSconfigSpatch = "/config.sconfig/spatch"
+var _RpcPathSconfigSschemaSet = "/config.sconfig/sschema_set"
+var _RpcPathSconfigSschemaGet = "/config.sconfig/sschema_get"
+var _RpcPathSconfigSdiff = "/config.sconfig/sdiff"
+var _RpcPathSconfigSpublish = "/config.sconfig/spublish"
+var _RpcPathSconfigSpolicySet = "/config.sconfig/spolicy_set"
+var _RpcPathSconfigSpolicyGet = "/config.sconfig/spolicy_get"
+var _RpcPathSconfigSapprove = "/config.sconfig/sapprove"
+var _RpcPathSconfigSreject = "/config.sconfig/sreject"
+var _RpcPathSconfigSpending = "/config.sconfig/spending"
+var _RpcPathSconfigSschedule = "/config.sconfig/sschedule"
+var _RpcPathSconfigSscheduleList = "/config.sconfig/sschedule_list"
+var _RpcPathSconfigSscheduleCancel = "/config.sconfig/sschedule_cancel"
+var _RpcPathSconfigScanary = "/config.sconfig/scanary"
+var _RpcPathSconfigScanaryPromote = "/config.sconfig/scanary_promote"
+var _RpcPathSconfigScanaryAbort = "/config.sconfig/scanary_abort"
+var _RpcPathSconfigSreport = "/config.sconfig/sreport"
+var _RpcPathSconfigSrollout = "/config.sconfig/srollout"
+var _RpcPathSconfigSdelete = "/config.sconfig/sdelete"
+var _RpcPathSconfigSrestore = "/config.sconfig/srestore"
+var _RpcPathSconfigSpurge = "/config.sconfig/spurge"
+var _RpcPathSconfigSrecycle = "/config.sconfig/srecycle"
+var _RpcPathSconfigStag = "/config.sconfig/stag"
+var _RpcPathSconfigSretentionSet = "/config.sconfig/sretention_set"
+var _RpcPathSconfigSretentionGet = "/config.sconfig/sretention_get"
+var _RpcPathSconfigSprincipalSet = "/config.sconfig/sprincipal_set"
+var _RpcPathSconfigSprincipalDel = "/config.sconfig/sprincipal_del"
+var _RpcPathSconfigSprincipals = "/config.sconfig/sprincipals"
+var _RpcPathSconfigSgrant = "/config.sconfig/sgrant"
+var _RpcPathSconfigSgrants = "/config.sconfig/sgrants"
+var _RpcPathSconfigStokenIssue = "/config.sconfig/stoken_issue"
+var _RpcPathSconfigStokenRotate = "/config.sconfig/stoken_rotate"
+var _RpcPathSconfigStokenRevoke = "/config.sconfig/stoken_revoke"
+var _RpcPathSconfigStokens = "/config.sconfig/stokens"
+var _RpcPathSconfigSbaseSet = "/config.sconfig/sbase_set"
+var _RpcPathSconfigSbaseGet = "/config.sconfig/sbase_get"
+var _RpcPathSconfigSrender = "/config.sconfig/srender"
 
 type SconfigRpcClient interface {
 	//one specific app's current info
@@ -95,8 +495,98 @@ type SconfigRpcClient interface {
 	Sgroups(context.Context, *SgroupsReq) (*SgroupsResp, error)
 	//get all apps
 	Sapps(context.Context, *SappsReq) (*SappsResp, error)
-	//get watch addr
-	Swatchaddr(context.Context, *SwatchaddrReq) (*SwatchaddrResp, error)
+	//watch one specific app's config
+	//this is a long poll,it returns at once when the op_num in req is different from the current
+	//otherwise it returns when the config changed or the timeout is near
+	Swatch(context.Context, *SwatchReq) (*SwatchResp, error)
+	//get one specific app's versions,newest first
+	Shistory(context.Context, *ShistoryReq) (*ShistoryResp, error)
+	//get the op records,newest first
+	Saudit(context.Context, *SauditReq) (*SauditResp, error)
+	//patch one specific app's current config and create a new version
+	Spatch(context.Context, *SpatchReq) (*SpatchResp, error)
+	//set one specific app's json schema,this will create a new schema version
+	//the config will be checked with the newest schema when set,patch and rollback
+	SschemaSet(context.Context, *SschemaSetReq) (*SschemaSetResp, error)
+	//get one specific app's json schema
+	SschemaGet(context.Context, *SschemaGetReq) (*SschemaGetResp, error)
+	//diff one specific app's two config versions
+	Sdiff(context.Context, *SdiffReq) (*SdiffResp, error)
+	//make one specific app's version current,usually used to publish a draft
+	Spublish(context.Context, *SpublishReq) (*SpublishResp, error)
+	//set one specific group's approval policy
+	//when the policy is enabled,sset and spatch in this group will always save a draft
+	//and the draft can only be published after enough reviewers approved it
+	SpolicySet(context.Context, *SpolicySetReq) (*SpolicySetResp, error)
+	//get one specific group's approval policy
+	SpolicyGet(context.Context, *SpolicyGetReq) (*SpolicyGetResp, error)
+	//approve one specific app's draft,the caller must be one of the group's reviewers and not the draft's author
+	Sapprove(context.Context, *SapproveReq) (*SapproveResp, error)
+	//reject one specific app's draft,the caller must be one of the group's reviewers and not the draft's author
+	Sreject(context.Context, *SrejectReq) (*SrejectResp, error)
+	//get the drafts waiting for approval,newest first
+	Spending(context.Context, *SpendingReq) (*SpendingResp, error)
+	//schedule one specific app's version to become current at the given time
+	//drafts still need enough approvals when the schedule fires
+	Sschedule(context.Context, *SscheduleReq) (*SscheduleResp, error)
+	//get the pending schedules,sorted by fire_time asc
+	SscheduleList(context.Context, *SscheduleListReq) (*SscheduleListResp, error)
+	//cancel one pending schedule
+	SscheduleCancel(context.Context, *SscheduleCancelReq) (*SscheduleCancelResp, error)
+	//deliver one specific app's version to part of the instances,other instances still use the current version
+	//set again will replace the former canary
+	Scanary(context.Context, *ScanaryReq) (*ScanaryResp, error)
+	//make the canary version current for all instances
+	ScanaryPromote(context.Context, *ScanaryPromoteReq) (*ScanaryPromoteResp, error)
+	//stop the canary,all instances will use the current version
+	ScanaryAbort(context.Context, *ScanaryAbortReq) (*ScanaryAbortResp, error)
+	//the sdk reports the version it applied
+	Sreport(context.Context, *SreportReq) (*SreportResp, error)
+	//get the applied version of every instance
+	Srollout(context.Context, *SrolloutReq) (*SrolloutResp, error)
+	//move one specific app or all apps in one group into the recycle bin
+	//the sdk will get empty config after this,the data will be purged after the retention
+	Sdelete(context.Context, *SdeleteReq) (*SdeleteResp, error)
+	//move one specific app or all deleted apps in one group out of the recycle bin
+	Srestore(context.Context, *SrestoreReq) (*SrestoreResp, error)
+	//remove one specific app or all deleted apps in one group from the recycle bin,this can't be undone
+	Spurge(context.Context, *SpurgeReq) (*SpurgeResp, error)
+	//get the apps in the recycle bin
+	Srecycle(context.Context, *SrecycleReq) (*SrecycleResp, error)
+	//tag one specific app's version,the tagged version will never be pruned
+	Stag(context.Context, *StagReq) (*StagResp, error)
+	//set one specific group's history retention
+	//the current,canary,previous,draft,tagged and scheduled versions will never be pruned
+	SretentionSet(context.Context, *SretentionSetReq) (*SretentionSetResp, error)
+	//get one specific group's history retention
+	SretentionGet(context.Context, *SretentionGetReq) (*SretentionGetResp, error)
+	//create a principal or reset it's token,only the global admin can do this
+	//the token is only returned here,the server only keeps it's hash
+	SprincipalSet(context.Context, *SprincipalSetReq) (*SprincipalSetResp, error)
+	//delete a principal and all it's grants,only the global admin can do this
+	SprincipalDel(context.Context, *SprincipalDelReq) (*SprincipalDelResp, error)
+	//get all principals,only the global admin can do this
+	Sprincipals(context.Context, *SprincipalsReq) (*SprincipalsResp, error)
+	//give a principal one role on the scope or revoke it,the caller must be the admin of the scope
+	Sgrant(context.Context, *SgrantReq) (*SgrantResp, error)
+	//get the grants of one principal or one group
+	Sgrants(context.Context, *SgrantsReq) (*SgrantsResp, error)
+	//issue a new read token for one specific app,the app's sdk can read and watch it's own config with it
+	//the token is only returned here,the server only keeps it's hash
+	StokenIssue(context.Context, *StokenIssueReq) (*StokenIssueResp, error)
+	//issue a new read token to replace the old one,the old one will expire after the grace
+	StokenRotate(context.Context, *StokenRotateReq) (*StokenRotateResp, error)
+	//revoke one read token at once
+	StokenRevoke(context.Context, *StokenRevokeReq) (*StokenRevokeResp, error)
+	//get one specific app's read tokens,the tokens themselves are not returned
+	Stokens(context.Context, *StokensReq) (*StokensResp, error)
+	//set one specific group's base config,every app in the group inherits it
+	//the merged config of every app in the group will be checked,the watchers will get the new merged config
+	SbaseSet(context.Context, *SbaseSetReq) (*SbaseSetResp, error)
+	//get one specific group's base config
+	SbaseGet(context.Context, *SbaseGetReq) (*SbaseGetResp, error)
+	//get one specific app's effective config:the app's version merged into the group's current base config
+	Srender(context.Context, *SrenderReq) (*SrenderResp, error)
 }
 
 type sconfigRpcClient struct {
@@ -239,16 +729,20 @@ func (c *sconfigRpcClient) Sapps(ctx context.Context, req *SappsReq) (*SappsResp
 	}
 	return resp, nil
 }
-func (c *sconfigRpcClient) Swatchaddr(ctx context.Context, req *SwatchaddrReq) (*SwatchaddrResp, error) {
+func (c *sconfigRpcClient) Swatch(ctx context.Context, req *SwatchReq) (*SwatchResp, error) {
 	if req == nil {
 		return nil, error1.ErrReq
 	}
+	if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SwatchReq"](req); s != "" {
+		log.Error("[/config.sconfig/swatch]", s)
+		return nil, error1.ErrReq
+	}
 	reqd, _ := proto.Marshal(req)
-	respd, e := c.cc.Call(ctx, 250000000, _RpcPathSconfigSwatchaddr, reqd, metadata.GetAllMetadata(ctx))
+	respd, e := c.cc.Call(ctx, 30000000000, _RpcPathSconfigSwatch, reqd, metadata.GetAllMetadata(ctx))
 	if e != nil {
 		return nil, error1.StdErrorToError(e)
 	}
-	resp := new(SwatchaddrResp)
+	resp := new(SwatchResp)
 	if len(respd) == 0 {
 		return resp, nil
 	}
@@ -257,54 +751,990 @@ func (c *sconfigRpcClient) Swatchaddr(ctx context.Context, req *SwatchaddrReq) (
 	}
 	return resp, nil
 }
-
-type SconfigRpcServer interface {
-	//one specific app's current info
-	Sinfo(context.Context, *SinfoReq) (*SinfoResp, error)
-	//set one specific app's config
-	Sset(context.Context, *SsetReq) (*SsetResp, error)
-	//rollback one specific app's config
-	Srollback(context.Context, *SrollbackReq) (*SrollbackResp, error)
-	//get one specific app's config
-	Sget(context.Context, *SgetReq) (*SgetResp, error)
-	//get all groups
-	Sgroups(context.Context, *SgroupsReq) (*SgroupsResp, error)
-	//get all apps
-	Sapps(context.Context, *SappsReq) (*SappsResp, error)
-	//get watch addr
-	Swatchaddr(context.Context, *SwatchaddrReq) (*SwatchaddrResp, error)
-}
-
-func _Sconfig_Sinfo_RpcHandler(handler func(context.Context, *SinfoReq) (*SinfoResp, error)) rpc.OutsideHandler {
-	return func(ctx *rpc.Context) {
-		req := new(SinfoReq)
-		if e := proto.Unmarshal(ctx.GetBody(), req); e != nil {
-			ctx.Abort(error1.ErrReq)
-			return
-		}
-		if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SinfoReq"](req); s != "" {
-			log.Error("[/config.sconfig/sinfo]", s)
-			ctx.Abort(error1.ErrReq)
-		}
-		resp, e := handler(ctx, req)
-		if e != nil {
-			ctx.Abort(e)
-			return
-		}
-		if resp == nil {
-			resp = new(SinfoResp)
-		}
-		respd, _ := proto.Marshal(resp)
-		ctx.Write(respd)
+func (c *sconfigRpcClient) Shistory(ctx context.Context, req *ShistoryReq) (*ShistoryResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
 	}
-}
-func _Sconfig_Sset_RpcHandler(handler func(context.Context, *SsetReq) (*SsetResp, error)) rpc.OutsideHandler {
-	return func(ctx *rpc.Context) {
-		req := new(SsetReq)
-		if e := proto.Unmarshal(ctx.GetBody(), req); e != nil {
-			ctx.Abort(error1.ErrReq)
-			return
-		}
+	if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".ShistoryReq"](req); s != "" {
+		log.Error("[/config.sconfig/shistory]", s)
+		return nil, error1.ErrReq
+	}
+	reqd, _ := proto.Marshal(req)
+	respd, e := c.cc.Call(ctx, 250000000, _RpcPathSconfigShistory, reqd, metadata.GetAllMetadata(ctx))
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	resp := new(ShistoryResp)
+	if len(respd) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(respd, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigRpcClient) Saudit(ctx context.Context, req *SauditReq) (*SauditResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SauditReq"](req); s != "" {
+		log.Error("[/config.sconfig/saudit]", s)
+		return nil, error1.ErrReq
+	}
+	reqd, _ := proto.Marshal(req)
+	respd, e := c.cc.Call(ctx, 250000000, _RpcPathSconfigSaudit, reqd, metadata.GetAllMetadata(ctx))
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	resp := new(SauditResp)
+	if len(respd) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(respd, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigRpcClient) Spatch(ctx context.Context, req *SpatchReq) (*SpatchResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SpatchReq"](req); s != "" {
+		log.Error("[/config.sconfig/spatch]", s)
+		return nil, error1.ErrReq
+	}
+	reqd, _ := proto.Marshal(req)
+	respd, e := c.cc.Call(ctx, 250000000, _RpcPathSconfigSpatch, reqd, metadata.GetAllMetadata(ctx))
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	resp := new(SpatchResp)
+	if len(respd) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(respd, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigRpcClient) SschemaSet(ctx context.Context, req *SschemaSetReq) (*SschemaSetResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SschemaSetReq"](req); s != "" {
+		log.Error("[/config.sconfig/sschema_set]", s)
+		return nil, error1.ErrReq
+	}
+	reqd, _ := proto.Marshal(req)
+	respd, e := c.cc.Call(ctx, 250000000, _RpcPathSconfigSschemaSet, reqd, metadata.GetAllMetadata(ctx))
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	resp := new(SschemaSetResp)
+	if len(respd) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(respd, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigRpcClient) SschemaGet(ctx context.Context, req *SschemaGetReq) (*SschemaGetResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SschemaGetReq"](req); s != "" {
+		log.Error("[/config.sconfig/sschema_get]", s)
+		return nil, error1.ErrReq
+	}
+	reqd, _ := proto.Marshal(req)
+	respd, e := c.cc.Call(ctx, 250000000, _RpcPathSconfigSschemaGet, reqd, metadata.GetAllMetadata(ctx))
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	resp := new(SschemaGetResp)
+	if len(respd) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(respd, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigRpcClient) Sdiff(ctx context.Context, req *SdiffReq) (*SdiffResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SdiffReq"](req); s != "" {
+		log.Error("[/config.sconfig/sdiff]", s)
+		return nil, error1.ErrReq
+	}
+	reqd, _ := proto.Marshal(req)
+	respd, e := c.cc.Call(ctx, 250000000, _RpcPathSconfigSdiff, reqd, metadata.GetAllMetadata(ctx))
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	resp := new(SdiffResp)
+	if len(respd) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(respd, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigRpcClient) Spublish(ctx context.Context, req *SpublishReq) (*SpublishResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SpublishReq"](req); s != "" {
+		log.Error("[/config.sconfig/spublish]", s)
+		return nil, error1.ErrReq
+	}
+	reqd, _ := proto.Marshal(req)
+	respd, e := c.cc.Call(ctx, 250000000, _RpcPathSconfigSpublish, reqd, metadata.GetAllMetadata(ctx))
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	resp := new(SpublishResp)
+	if len(respd) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(respd, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigRpcClient) SpolicySet(ctx context.Context, req *SpolicySetReq) (*SpolicySetResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SpolicySetReq"](req); s != "" {
+		log.Error("[/config.sconfig/spolicy_set]", s)
+		return nil, error1.ErrReq
+	}
+	reqd, _ := proto.Marshal(req)
+	respd, e := c.cc.Call(ctx, 250000000, _RpcPathSconfigSpolicySet, reqd, metadata.GetAllMetadata(ctx))
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	resp := new(SpolicySetResp)
+	if len(respd) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(respd, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigRpcClient) SpolicyGet(ctx context.Context, req *SpolicyGetReq) (*SpolicyGetResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SpolicyGetReq"](req); s != "" {
+		log.Error("[/config.sconfig/spolicy_get]", s)
+		return nil, error1.ErrReq
+	}
+	reqd, _ := proto.Marshal(req)
+	respd, e := c.cc.Call(ctx, 250000000, _RpcPathSconfigSpolicyGet, reqd, metadata.GetAllMetadata(ctx))
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	resp := new(SpolicyGetResp)
+	if len(respd) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(respd, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigRpcClient) Sapprove(ctx context.Context, req *SapproveReq) (*SapproveResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SapproveReq"](req); s != "" {
+		log.Error("[/config.sconfig/sapprove]", s)
+		return nil, error1.ErrReq
+	}
+	reqd, _ := proto.Marshal(req)
+	respd, e := c.cc.Call(ctx, 250000000, _RpcPathSconfigSapprove, reqd, metadata.GetAllMetadata(ctx))
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	resp := new(SapproveResp)
+	if len(respd) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(respd, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigRpcClient) Sreject(ctx context.Context, req *SrejectReq) (*SrejectResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SrejectReq"](req); s != "" {
+		log.Error("[/config.sconfig/sreject]", s)
+		return nil, error1.ErrReq
+	}
+	reqd, _ := proto.Marshal(req)
+	respd, e := c.cc.Call(ctx, 250000000, _RpcPathSconfigSreject, reqd, metadata.GetAllMetadata(ctx))
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	resp := new(SrejectResp)
+	if len(respd) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(respd, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigRpcClient) Spending(ctx context.Context, req *SpendingReq) (*SpendingResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SpendingReq"](req); s != "" {
+		log.Error("[/config.sconfig/spending]", s)
+		return nil, error1.ErrReq
+	}
+	reqd, _ := proto.Marshal(req)
+	respd, e := c.cc.Call(ctx, 500000000, _RpcPathSconfigSpending, reqd, metadata.GetAllMetadata(ctx))
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	resp := new(SpendingResp)
+	if len(respd) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(respd, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigRpcClient) Sschedule(ctx context.Context, req *SscheduleReq) (*SscheduleResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SscheduleReq"](req); s != "" {
+		log.Error("[/config.sconfig/sschedule]", s)
+		return nil, error1.ErrReq
+	}
+	reqd, _ := proto.Marshal(req)
+	respd, e := c.cc.Call(ctx, 250000000, _RpcPathSconfigSschedule, reqd, metadata.GetAllMetadata(ctx))
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	resp := new(SscheduleResp)
+	if len(respd) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(respd, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigRpcClient) SscheduleList(ctx context.Context, req *SscheduleListReq) (*SscheduleListResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SscheduleListReq"](req); s != "" {
+		log.Error("[/config.sconfig/sschedule_list]", s)
+		return nil, error1.ErrReq
+	}
+	reqd, _ := proto.Marshal(req)
+	respd, e := c.cc.Call(ctx, 250000000, _RpcPathSconfigSscheduleList, reqd, metadata.GetAllMetadata(ctx))
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	resp := new(SscheduleListResp)
+	if len(respd) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(respd, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigRpcClient) SscheduleCancel(ctx context.Context, req *SscheduleCancelReq) (*SscheduleCancelResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SscheduleCancelReq"](req); s != "" {
+		log.Error("[/config.sconfig/sschedule_cancel]", s)
+		return nil, error1.ErrReq
+	}
+	reqd, _ := proto.Marshal(req)
+	respd, e := c.cc.Call(ctx, 250000000, _RpcPathSconfigSscheduleCancel, reqd, metadata.GetAllMetadata(ctx))
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	resp := new(SscheduleCancelResp)
+	if len(respd) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(respd, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigRpcClient) Scanary(ctx context.Context, req *ScanaryReq) (*ScanaryResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".ScanaryReq"](req); s != "" {
+		log.Error("[/config.sconfig/scanary]", s)
+		return nil, error1.ErrReq
+	}
+	reqd, _ := proto.Marshal(req)
+	respd, e := c.cc.Call(ctx, 250000000, _RpcPathSconfigScanary, reqd, metadata.GetAllMetadata(ctx))
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	resp := new(ScanaryResp)
+	if len(respd) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(respd, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigRpcClient) ScanaryPromote(ctx context.Context, req *ScanaryPromoteReq) (*ScanaryPromoteResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".ScanaryPromoteReq"](req); s != "" {
+		log.Error("[/config.sconfig/scanary_promote]", s)
+		return nil, error1.ErrReq
+	}
+	reqd, _ := proto.Marshal(req)
+	respd, e := c.cc.Call(ctx, 250000000, _RpcPathSconfigScanaryPromote, reqd, metadata.GetAllMetadata(ctx))
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	resp := new(ScanaryPromoteResp)
+	if len(respd) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(respd, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigRpcClient) ScanaryAbort(ctx context.Context, req *ScanaryAbortReq) (*ScanaryAbortResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".ScanaryAbortReq"](req); s != "" {
+		log.Error("[/config.sconfig/scanary_abort]", s)
+		return nil, error1.ErrReq
+	}
+	reqd, _ := proto.Marshal(req)
+	respd, e := c.cc.Call(ctx, 250000000, _RpcPathSconfigScanaryAbort, reqd, metadata.GetAllMetadata(ctx))
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	resp := new(ScanaryAbortResp)
+	if len(respd) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(respd, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigRpcClient) Sreport(ctx context.Context, req *SreportReq) (*SreportResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SreportReq"](req); s != "" {
+		log.Error("[/config.sconfig/sreport]", s)
+		return nil, error1.ErrReq
+	}
+	reqd, _ := proto.Marshal(req)
+	respd, e := c.cc.Call(ctx, 250000000, _RpcPathSconfigSreport, reqd, metadata.GetAllMetadata(ctx))
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	resp := new(SreportResp)
+	if len(respd) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(respd, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigRpcClient) Srollout(ctx context.Context, req *SrolloutReq) (*SrolloutResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SrolloutReq"](req); s != "" {
+		log.Error("[/config.sconfig/srollout]", s)
+		return nil, error1.ErrReq
+	}
+	reqd, _ := proto.Marshal(req)
+	respd, e := c.cc.Call(ctx, 250000000, _RpcPathSconfigSrollout, reqd, metadata.GetAllMetadata(ctx))
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	resp := new(SrolloutResp)
+	if len(respd) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(respd, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigRpcClient) Sdelete(ctx context.Context, req *SdeleteReq) (*SdeleteResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SdeleteReq"](req); s != "" {
+		log.Error("[/config.sconfig/sdelete]", s)
+		return nil, error1.ErrReq
+	}
+	reqd, _ := proto.Marshal(req)
+	respd, e := c.cc.Call(ctx, 500000000, _RpcPathSconfigSdelete, reqd, metadata.GetAllMetadata(ctx))
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	resp := new(SdeleteResp)
+	if len(respd) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(respd, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigRpcClient) Srestore(ctx context.Context, req *SrestoreReq) (*SrestoreResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SrestoreReq"](req); s != "" {
+		log.Error("[/config.sconfig/srestore]", s)
+		return nil, error1.ErrReq
+	}
+	reqd, _ := proto.Marshal(req)
+	respd, e := c.cc.Call(ctx, 500000000, _RpcPathSconfigSrestore, reqd, metadata.GetAllMetadata(ctx))
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	resp := new(SrestoreResp)
+	if len(respd) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(respd, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigRpcClient) Spurge(ctx context.Context, req *SpurgeReq) (*SpurgeResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SpurgeReq"](req); s != "" {
+		log.Error("[/config.sconfig/spurge]", s)
+		return nil, error1.ErrReq
+	}
+	reqd, _ := proto.Marshal(req)
+	respd, e := c.cc.Call(ctx, 500000000, _RpcPathSconfigSpurge, reqd, metadata.GetAllMetadata(ctx))
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	resp := new(SpurgeResp)
+	if len(respd) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(respd, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigRpcClient) Srecycle(ctx context.Context, req *SrecycleReq) (*SrecycleResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	reqd, _ := proto.Marshal(req)
+	respd, e := c.cc.Call(ctx, 500000000, _RpcPathSconfigSrecycle, reqd, metadata.GetAllMetadata(ctx))
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	resp := new(SrecycleResp)
+	if len(respd) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(respd, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigRpcClient) Stag(ctx context.Context, req *StagReq) (*StagResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".StagReq"](req); s != "" {
+		log.Error("[/config.sconfig/stag]", s)
+		return nil, error1.ErrReq
+	}
+	reqd, _ := proto.Marshal(req)
+	respd, e := c.cc.Call(ctx, 250000000, _RpcPathSconfigStag, reqd, metadata.GetAllMetadata(ctx))
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	resp := new(StagResp)
+	if len(respd) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(respd, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigRpcClient) SretentionSet(ctx context.Context, req *SretentionSetReq) (*SretentionSetResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SretentionSetReq"](req); s != "" {
+		log.Error("[/config.sconfig/sretention_set]", s)
+		return nil, error1.ErrReq
+	}
+	reqd, _ := proto.Marshal(req)
+	respd, e := c.cc.Call(ctx, 250000000, _RpcPathSconfigSretentionSet, reqd, metadata.GetAllMetadata(ctx))
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	resp := new(SretentionSetResp)
+	if len(respd) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(respd, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigRpcClient) SretentionGet(ctx context.Context, req *SretentionGetReq) (*SretentionGetResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SretentionGetReq"](req); s != "" {
+		log.Error("[/config.sconfig/sretention_get]", s)
+		return nil, error1.ErrReq
+	}
+	reqd, _ := proto.Marshal(req)
+	respd, e := c.cc.Call(ctx, 250000000, _RpcPathSconfigSretentionGet, reqd, metadata.GetAllMetadata(ctx))
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	resp := new(SretentionGetResp)
+	if len(respd) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(respd, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigRpcClient) SprincipalSet(ctx context.Context, req *SprincipalSetReq) (*SprincipalSetResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SprincipalSetReq"](req); s != "" {
+		log.Error("[/config.sconfig/sprincipal_set]", s)
+		return nil, error1.ErrReq
+	}
+	reqd, _ := proto.Marshal(req)
+	respd, e := c.cc.Call(ctx, 250000000, _RpcPathSconfigSprincipalSet, reqd, metadata.GetAllMetadata(ctx))
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	resp := new(SprincipalSetResp)
+	if len(respd) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(respd, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigRpcClient) SprincipalDel(ctx context.Context, req *SprincipalDelReq) (*SprincipalDelResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SprincipalDelReq"](req); s != "" {
+		log.Error("[/config.sconfig/sprincipal_del]", s)
+		return nil, error1.ErrReq
+	}
+	reqd, _ := proto.Marshal(req)
+	respd, e := c.cc.Call(ctx, 250000000, _RpcPathSconfigSprincipalDel, reqd, metadata.GetAllMetadata(ctx))
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	resp := new(SprincipalDelResp)
+	if len(respd) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(respd, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigRpcClient) Sprincipals(ctx context.Context, req *SprincipalsReq) (*SprincipalsResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	reqd, _ := proto.Marshal(req)
+	respd, e := c.cc.Call(ctx, 250000000, _RpcPathSconfigSprincipals, reqd, metadata.GetAllMetadata(ctx))
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	resp := new(SprincipalsResp)
+	if len(respd) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(respd, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigRpcClient) Sgrant(ctx context.Context, req *SgrantReq) (*SgrantResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SgrantReq"](req); s != "" {
+		log.Error("[/config.sconfig/sgrant]", s)
+		return nil, error1.ErrReq
+	}
+	reqd, _ := proto.Marshal(req)
+	respd, e := c.cc.Call(ctx, 250000000, _RpcPathSconfigSgrant, reqd, metadata.GetAllMetadata(ctx))
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	resp := new(SgrantResp)
+	if len(respd) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(respd, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigRpcClient) Sgrants(ctx context.Context, req *SgrantsReq) (*SgrantsResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	reqd, _ := proto.Marshal(req)
+	respd, e := c.cc.Call(ctx, 250000000, _RpcPathSconfigSgrants, reqd, metadata.GetAllMetadata(ctx))
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	resp := new(SgrantsResp)
+	if len(respd) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(respd, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigRpcClient) StokenIssue(ctx context.Context, req *StokenIssueReq) (*StokenIssueResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".StokenIssueReq"](req); s != "" {
+		log.Error("[/config.sconfig/stoken_issue]", s)
+		return nil, error1.ErrReq
+	}
+	reqd, _ := proto.Marshal(req)
+	respd, e := c.cc.Call(ctx, 250000000, _RpcPathSconfigStokenIssue, reqd, metadata.GetAllMetadata(ctx))
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	resp := new(StokenIssueResp)
+	if len(respd) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(respd, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigRpcClient) StokenRotate(ctx context.Context, req *StokenRotateReq) (*StokenRotateResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".StokenRotateReq"](req); s != "" {
+		log.Error("[/config.sconfig/stoken_rotate]", s)
+		return nil, error1.ErrReq
+	}
+	reqd, _ := proto.Marshal(req)
+	respd, e := c.cc.Call(ctx, 250000000, _RpcPathSconfigStokenRotate, reqd, metadata.GetAllMetadata(ctx))
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	resp := new(StokenRotateResp)
+	if len(respd) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(respd, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigRpcClient) StokenRevoke(ctx context.Context, req *StokenRevokeReq) (*StokenRevokeResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".StokenRevokeReq"](req); s != "" {
+		log.Error("[/config.sconfig/stoken_revoke]", s)
+		return nil, error1.ErrReq
+	}
+	reqd, _ := proto.Marshal(req)
+	respd, e := c.cc.Call(ctx, 250000000, _RpcPathSconfigStokenRevoke, reqd, metadata.GetAllMetadata(ctx))
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	resp := new(StokenRevokeResp)
+	if len(respd) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(respd, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigRpcClient) Stokens(ctx context.Context, req *StokensReq) (*StokensResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".StokensReq"](req); s != "" {
+		log.Error("[/config.sconfig/stokens]", s)
+		return nil, error1.ErrReq
+	}
+	reqd, _ := proto.Marshal(req)
+	respd, e := c.cc.Call(ctx, 250000000, _RpcPathSconfigStokens, reqd, metadata.GetAllMetadata(ctx))
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	resp := new(StokensResp)
+	if len(respd) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(respd, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigRpcClient) SbaseSet(ctx context.Context, req *SbaseSetReq) (*SbaseSetResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SbaseSetReq"](req); s != "" {
+		log.Error("[/config.sconfig/sbase_set]", s)
+		return nil, error1.ErrReq
+	}
+	reqd, _ := proto.Marshal(req)
+	respd, e := c.cc.Call(ctx, 500000000, _RpcPathSconfigSbaseSet, reqd, metadata.GetAllMetadata(ctx))
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	resp := new(SbaseSetResp)
+	if len(respd) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(respd, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigRpcClient) SbaseGet(ctx context.Context, req *SbaseGetReq) (*SbaseGetResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SbaseGetReq"](req); s != "" {
+		log.Error("[/config.sconfig/sbase_get]", s)
+		return nil, error1.ErrReq
+	}
+	reqd, _ := proto.Marshal(req)
+	respd, e := c.cc.Call(ctx, 250000000, _RpcPathSconfigSbaseGet, reqd, metadata.GetAllMetadata(ctx))
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	resp := new(SbaseGetResp)
+	if len(respd) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(respd, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigRpcClient) Srender(ctx context.Context, req *SrenderReq) (*SrenderResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SrenderReq"](req); s != "" {
+		log.Error("[/config.sconfig/srender]", s)
+		return nil, error1.ErrReq
+	}
+	reqd, _ := proto.Marshal(req)
+	respd, e := c.cc.Call(ctx, 250000000, _RpcPathSconfigSrender, reqd, metadata.GetAllMetadata(ctx))
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	resp := new(SrenderResp)
+	if len(respd) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(respd, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+
+type SconfigRpcServer interface {
+	//one specific app's current info
+	Sinfo(context.Context, *SinfoReq) (*SinfoResp, error)
+	//set one specific app's config
+	Sset(context.Context, *SsetReq) (*SsetResp, error)
+	//rollback one specific app's config
+	Srollback(context.Context, *SrollbackReq) (*SrollbackResp, error)
+	//get one specific app's config
+	Sget(context.Context, *SgetReq) (*SgetResp, error)
+	//get all groups
+	Sgroups(context.Context, *SgroupsReq) (*SgroupsResp, error)
+	//get all apps
+	Sapps(context.Context, *SappsReq) (*SappsResp, error)
+	//watch one specific app's config
+	//this is a long poll,it returns at once when the op_num in req is different from the current
+	//otherwise it returns when the config changed or the timeout is near
+	Swatch(context.Context, *SwatchReq) (*SwatchResp, error)
+	//get one specific app's versions,newest first
+	Shistory(context.Context, *ShistoryReq) (*ShistoryResp, error)
+	//get the op records,newest first
+	Saudit(context.Context, *SauditReq) (*SauditResp, error)
+	//patch one specific app's current config and create a new version
+	Spatch(context.Context, *SpatchReq) (*SpatchResp, error)
+	//set one specific app's json schema,this will create a new schema version
+	//the config will be checked with the newest schema when set,patch and rollback
+	SschemaSet(context.Context, *SschemaSetReq) (*SschemaSetResp, error)
+	//get one specific app's json schema
+	SschemaGet(context.Context, *SschemaGetReq) (*SschemaGetResp, error)
+	//diff one specific app's two config versions
+	Sdiff(context.Context, *SdiffReq) (*SdiffResp, error)
+	//make one specific app's version current,usually used to publish a draft
+	Spublish(context.Context, *SpublishReq) (*SpublishResp, error)
+	//set one specific group's approval policy
+	//when the policy is enabled,sset and spatch in this group will always save a draft
+	//and the draft can only be published after enough reviewers approved it
+	SpolicySet(context.Context, *SpolicySetReq) (*SpolicySetResp, error)
+	//get one specific group's approval policy
+	SpolicyGet(context.Context, *SpolicyGetReq) (*SpolicyGetResp, error)
+	//approve one specific app's draft,the caller must be one of the group's reviewers and not the draft's author
+	Sapprove(context.Context, *SapproveReq) (*SapproveResp, error)
+	//reject one specific app's draft,the caller must be one of the group's reviewers and not the draft's author
+	Sreject(context.Context, *SrejectReq) (*SrejectResp, error)
+	//get the drafts waiting for approval,newest first
+	Spending(context.Context, *SpendingReq) (*SpendingResp, error)
+	//schedule one specific app's version to become current at the given time
+	//drafts still need enough approvals when the schedule fires
+	Sschedule(context.Context, *SscheduleReq) (*SscheduleResp, error)
+	//get the pending schedules,sorted by fire_time asc
+	SscheduleList(context.Context, *SscheduleListReq) (*SscheduleListResp, error)
+	//cancel one pending schedule
+	SscheduleCancel(context.Context, *SscheduleCancelReq) (*SscheduleCancelResp, error)
+	//deliver one specific app's version to part of the instances,other instances still use the current version
+	//set again will replace the former canary
+	Scanary(context.Context, *ScanaryReq) (*ScanaryResp, error)
+	//make the canary version current for all instances
+	ScanaryPromote(context.Context, *ScanaryPromoteReq) (*ScanaryPromoteResp, error)
+	//stop the canary,all instances will use the current version
+	ScanaryAbort(context.Context, *ScanaryAbortReq) (*ScanaryAbortResp, error)
+	//the sdk reports the version it applied
+	Sreport(context.Context, *SreportReq) (*SreportResp, error)
+	//get the applied version of every instance
+	Srollout(context.Context, *SrolloutReq) (*SrolloutResp, error)
+	//move one specific app or all apps in one group into the recycle bin
+	//the sdk will get empty config after this,the data will be purged after the retention
+	Sdelete(context.Context, *SdeleteReq) (*SdeleteResp, error)
+	//move one specific app or all deleted apps in one group out of the recycle bin
+	Srestore(context.Context, *SrestoreReq) (*SrestoreResp, error)
+	//remove one specific app or all deleted apps in one group from the recycle bin,this can't be undone
+	Spurge(context.Context, *SpurgeReq) (*SpurgeResp, error)
+	//get the apps in the recycle bin
+	Srecycle(context.Context, *SrecycleReq) (*SrecycleResp, error)
+	//tag one specific app's version,the tagged version will never be pruned
+	Stag(context.Context, *StagReq) (*StagResp, error)
+	//set one specific group's history retention
+	//the current,canary,previous,draft,tagged and scheduled versions will never be pruned
+	SretentionSet(context.Context, *SretentionSetReq) (*SretentionSetResp, error)
+	//get one specific group's history retention
+	SretentionGet(context.Context, *SretentionGetReq) (*SretentionGetResp, error)
+	//create a principal or reset it's token,only the global admin can do this
+	//the token is only returned here,the server only keeps it's hash
+	SprincipalSet(context.Context, *SprincipalSetReq) (*SprincipalSetResp, error)
+	//delete a principal and all it's grants,only the global admin can do this
+	SprincipalDel(context.Context, *SprincipalDelReq) (*SprincipalDelResp, error)
+	//get all principals,only the global admin can do this
+	Sprincipals(context.Context, *SprincipalsReq) (*SprincipalsResp, error)
+	//give a principal one role on the scope or revoke it,the caller must be the admin of the scope
+	Sgrant(context.Context, *SgrantReq) (*SgrantResp, error)
+	//get the grants of one principal or one group
+	Sgrants(context.Context, *SgrantsReq) (*SgrantsResp, error)
+	//issue a new read token for one specific app,the app's sdk can read and watch it's own config with it
+	//the token is only returned here,the server only keeps it's hash
+	StokenIssue(context.Context, *StokenIssueReq) (*StokenIssueResp, error)
+	//issue a new read token to replace the old one,the old one will expire after the grace
+	StokenRotate(context.Context, *StokenRotateReq) (*StokenRotateResp, error)
+	//revoke one read token at once
+	StokenRevoke(context.Context, *StokenRevokeReq) (*StokenRevokeResp, error)
+	//get one specific app's read tokens,the tokens themselves are not returned
+	Stokens(context.Context, *StokensReq) (*StokensResp, error)
+	//set one specific group's base config,every app in the group inherits it
+	//the merged config of every app in the group will be checked,the watchers will get the new merged config
+	SbaseSet(context.Context, *SbaseSetReq) (*SbaseSetResp, error)
+	//get one specific group's base config
+	SbaseGet(context.Context, *SbaseGetReq) (*SbaseGetResp, error)
+	//get one specific app's effective config:the app's version merged into the group's current base config
+	Srender(context.Context, *SrenderReq) (*SrenderResp, error)
+}
+
+func _Sconfig_Sinfo_RpcHandler(handler func(context.Context, *SinfoReq) (*SinfoResp, error)) rpc.OutsideHandler {
+	return func(ctx *rpc.Context) {
+		req := new(SinfoReq)
+		if e := proto.Unmarshal(ctx.GetBody(), req); e != nil {
+			ctx.Abort(error1.ErrReq)
+			return
+		}
+		if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SinfoReq"](req); s != "" {
+			log.Error("[/config.sconfig/sinfo]", s)
+			ctx.Abort(error1.ErrReq)
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			ctx.Abort(e)
+			return
+		}
+		if resp == nil {
+			resp = new(SinfoResp)
+		}
+		respd, _ := proto.Marshal(resp)
+		ctx.Write(respd)
+	}
+}
+func _Sconfig_Sset_RpcHandler(handler func(context.Context, *SsetReq) (*SsetResp, error)) rpc.OutsideHandler {
+	return func(ctx *rpc.Context) {
+		req := new(SsetReq)
+		if e := proto.Unmarshal(ctx.GetBody(), req); e != nil {
+			ctx.Abort(error1.ErrReq)
+			return
+		}
 		if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SsetReq"](req); s != "" {
 			log.Error("[/config.sconfig/sset]", s)
 			ctx.Abort(error1.ErrReq)
@@ -315,21 +1745,726 @@ func _Sconfig_Sset_RpcHandler(handler func(context.Context, *SsetReq) (*SsetResp
 			return
 		}
 		if resp == nil {
-			resp = new(SsetResp)
+			resp = new(SsetResp)
+		}
+		respd, _ := proto.Marshal(resp)
+		ctx.Write(respd)
+	}
+}
+func _Sconfig_Srollback_RpcHandler(handler func(context.Context, *SrollbackReq) (*SrollbackResp, error)) rpc.OutsideHandler {
+	return func(ctx *rpc.Context) {
+		req := new(SrollbackReq)
+		if e := proto.Unmarshal(ctx.GetBody(), req); e != nil {
+			ctx.Abort(error1.ErrReq)
+			return
+		}
+		if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SrollbackReq"](req); s != "" {
+			log.Error("[/config.sconfig/srollback]", s)
+			ctx.Abort(error1.ErrReq)
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			ctx.Abort(e)
+			return
+		}
+		if resp == nil {
+			resp = new(SrollbackResp)
+		}
+		respd, _ := proto.Marshal(resp)
+		ctx.Write(respd)
+	}
+}
+func _Sconfig_Sget_RpcHandler(handler func(context.Context, *SgetReq) (*SgetResp, error)) rpc.OutsideHandler {
+	return func(ctx *rpc.Context) {
+		req := new(SgetReq)
+		if e := proto.Unmarshal(ctx.GetBody(), req); e != nil {
+			ctx.Abort(error1.ErrReq)
+			return
+		}
+		if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SgetReq"](req); s != "" {
+			log.Error("[/config.sconfig/sget]", s)
+			ctx.Abort(error1.ErrReq)
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			ctx.Abort(e)
+			return
+		}
+		if resp == nil {
+			resp = new(SgetResp)
+		}
+		respd, _ := proto.Marshal(resp)
+		ctx.Write(respd)
+	}
+}
+func _Sconfig_Sgroups_RpcHandler(handler func(context.Context, *SgroupsReq) (*SgroupsResp, error)) rpc.OutsideHandler {
+	return func(ctx *rpc.Context) {
+		req := new(SgroupsReq)
+		if e := proto.Unmarshal(ctx.GetBody(), req); e != nil {
+			ctx.Abort(error1.ErrReq)
+			return
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			ctx.Abort(e)
+			return
+		}
+		if resp == nil {
+			resp = new(SgroupsResp)
+		}
+		respd, _ := proto.Marshal(resp)
+		ctx.Write(respd)
+	}
+}
+func _Sconfig_Sapps_RpcHandler(handler func(context.Context, *SappsReq) (*SappsResp, error)) rpc.OutsideHandler {
+	return func(ctx *rpc.Context) {
+		req := new(SappsReq)
+		if e := proto.Unmarshal(ctx.GetBody(), req); e != nil {
+			ctx.Abort(error1.ErrReq)
+			return
+		}
+		if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SappsReq"](req); s != "" {
+			log.Error("[/config.sconfig/sapps]", s)
+			ctx.Abort(error1.ErrReq)
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			ctx.Abort(e)
+			return
+		}
+		if resp == nil {
+			resp = new(SappsResp)
+		}
+		respd, _ := proto.Marshal(resp)
+		ctx.Write(respd)
+	}
+}
+func _Sconfig_Swatch_RpcHandler(handler func(context.Context, *SwatchReq) (*SwatchResp, error)) rpc.OutsideHandler {
+	return func(ctx *rpc.Context) {
+		req := new(SwatchReq)
+		if e := proto.Unmarshal(ctx.GetBody(), req); e != nil {
+			ctx.Abort(error1.ErrReq)
+			return
+		}
+		if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SwatchReq"](req); s != "" {
+			log.Error("[/config.sconfig/swatch]", s)
+			ctx.Abort(error1.ErrReq)
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			ctx.Abort(e)
+			return
+		}
+		if resp == nil {
+			resp = new(SwatchResp)
+		}
+		respd, _ := proto.Marshal(resp)
+		ctx.Write(respd)
+	}
+}
+func _Sconfig_Shistory_RpcHandler(handler func(context.Context, *ShistoryReq) (*ShistoryResp, error)) rpc.OutsideHandler {
+	return func(ctx *rpc.Context) {
+		req := new(ShistoryReq)
+		if e := proto.Unmarshal(ctx.GetBody(), req); e != nil {
+			ctx.Abort(error1.ErrReq)
+			return
+		}
+		if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".ShistoryReq"](req); s != "" {
+			log.Error("[/config.sconfig/shistory]", s)
+			ctx.Abort(error1.ErrReq)
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			ctx.Abort(e)
+			return
+		}
+		if resp == nil {
+			resp = new(ShistoryResp)
+		}
+		respd, _ := proto.Marshal(resp)
+		ctx.Write(respd)
+	}
+}
+func _Sconfig_Saudit_RpcHandler(handler func(context.Context, *SauditReq) (*SauditResp, error)) rpc.OutsideHandler {
+	return func(ctx *rpc.Context) {
+		req := new(SauditReq)
+		if e := proto.Unmarshal(ctx.GetBody(), req); e != nil {
+			ctx.Abort(error1.ErrReq)
+			return
+		}
+		if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SauditReq"](req); s != "" {
+			log.Error("[/config.sconfig/saudit]", s)
+			ctx.Abort(error1.ErrReq)
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			ctx.Abort(e)
+			return
+		}
+		if resp == nil {
+			resp = new(SauditResp)
+		}
+		respd, _ := proto.Marshal(resp)
+		ctx.Write(respd)
+	}
+}
+func _Sconfig_Spatch_RpcHandler(handler func(context.Context, *SpatchReq) (*SpatchResp, error)) rpc.OutsideHandler {
+	return func(ctx *rpc.Context) {
+		req := new(SpatchReq)
+		if e := proto.Unmarshal(ctx.GetBody(), req); e != nil {
+			ctx.Abort(error1.ErrReq)
+			return
+		}
+		if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SpatchReq"](req); s != "" {
+			log.Error("[/config.sconfig/spatch]", s)
+			ctx.Abort(error1.ErrReq)
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			ctx.Abort(e)
+			return
+		}
+		if resp == nil {
+			resp = new(SpatchResp)
+		}
+		respd, _ := proto.Marshal(resp)
+		ctx.Write(respd)
+	}
+}
+func _Sconfig_SschemaSet_RpcHandler(handler func(context.Context, *SschemaSetReq) (*SschemaSetResp, error)) rpc.OutsideHandler {
+	return func(ctx *rpc.Context) {
+		req := new(SschemaSetReq)
+		if e := proto.Unmarshal(ctx.GetBody(), req); e != nil {
+			ctx.Abort(error1.ErrReq)
+			return
+		}
+		if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SschemaSetReq"](req); s != "" {
+			log.Error("[/config.sconfig/sschema_set]", s)
+			ctx.Abort(error1.ErrReq)
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			ctx.Abort(e)
+			return
+		}
+		if resp == nil {
+			resp = new(SschemaSetResp)
+		}
+		respd, _ := proto.Marshal(resp)
+		ctx.Write(respd)
+	}
+}
+func _Sconfig_SschemaGet_RpcHandler(handler func(context.Context, *SschemaGetReq) (*SschemaGetResp, error)) rpc.OutsideHandler {
+	return func(ctx *rpc.Context) {
+		req := new(SschemaGetReq)
+		if e := proto.Unmarshal(ctx.GetBody(), req); e != nil {
+			ctx.Abort(error1.ErrReq)
+			return
+		}
+		if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SschemaGetReq"](req); s != "" {
+			log.Error("[/config.sconfig/sschema_get]", s)
+			ctx.Abort(error1.ErrReq)
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			ctx.Abort(e)
+			return
+		}
+		if resp == nil {
+			resp = new(SschemaGetResp)
+		}
+		respd, _ := proto.Marshal(resp)
+		ctx.Write(respd)
+	}
+}
+func _Sconfig_Sdiff_RpcHandler(handler func(context.Context, *SdiffReq) (*SdiffResp, error)) rpc.OutsideHandler {
+	return func(ctx *rpc.Context) {
+		req := new(SdiffReq)
+		if e := proto.Unmarshal(ctx.GetBody(), req); e != nil {
+			ctx.Abort(error1.ErrReq)
+			return
+		}
+		if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SdiffReq"](req); s != "" {
+			log.Error("[/config.sconfig/sdiff]", s)
+			ctx.Abort(error1.ErrReq)
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			ctx.Abort(e)
+			return
+		}
+		if resp == nil {
+			resp = new(SdiffResp)
+		}
+		respd, _ := proto.Marshal(resp)
+		ctx.Write(respd)
+	}
+}
+func _Sconfig_Spublish_RpcHandler(handler func(context.Context, *SpublishReq) (*SpublishResp, error)) rpc.OutsideHandler {
+	return func(ctx *rpc.Context) {
+		req := new(SpublishReq)
+		if e := proto.Unmarshal(ctx.GetBody(), req); e != nil {
+			ctx.Abort(error1.ErrReq)
+			return
+		}
+		if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SpublishReq"](req); s != "" {
+			log.Error("[/config.sconfig/spublish]", s)
+			ctx.Abort(error1.ErrReq)
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			ctx.Abort(e)
+			return
+		}
+		if resp == nil {
+			resp = new(SpublishResp)
+		}
+		respd, _ := proto.Marshal(resp)
+		ctx.Write(respd)
+	}
+}
+func _Sconfig_SpolicySet_RpcHandler(handler func(context.Context, *SpolicySetReq) (*SpolicySetResp, error)) rpc.OutsideHandler {
+	return func(ctx *rpc.Context) {
+		req := new(SpolicySetReq)
+		if e := proto.Unmarshal(ctx.GetBody(), req); e != nil {
+			ctx.Abort(error1.ErrReq)
+			return
+		}
+		if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SpolicySetReq"](req); s != "" {
+			log.Error("[/config.sconfig/spolicy_set]", s)
+			ctx.Abort(error1.ErrReq)
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			ctx.Abort(e)
+			return
+		}
+		if resp == nil {
+			resp = new(SpolicySetResp)
+		}
+		respd, _ := proto.Marshal(resp)
+		ctx.Write(respd)
+	}
+}
+func _Sconfig_SpolicyGet_RpcHandler(handler func(context.Context, *SpolicyGetReq) (*SpolicyGetResp, error)) rpc.OutsideHandler {
+	return func(ctx *rpc.Context) {
+		req := new(SpolicyGetReq)
+		if e := proto.Unmarshal(ctx.GetBody(), req); e != nil {
+			ctx.Abort(error1.ErrReq)
+			return
+		}
+		if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SpolicyGetReq"](req); s != "" {
+			log.Error("[/config.sconfig/spolicy_get]", s)
+			ctx.Abort(error1.ErrReq)
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			ctx.Abort(e)
+			return
+		}
+		if resp == nil {
+			resp = new(SpolicyGetResp)
+		}
+		respd, _ := proto.Marshal(resp)
+		ctx.Write(respd)
+	}
+}
+func _Sconfig_Sapprove_RpcHandler(handler func(context.Context, *SapproveReq) (*SapproveResp, error)) rpc.OutsideHandler {
+	return func(ctx *rpc.Context) {
+		req := new(SapproveReq)
+		if e := proto.Unmarshal(ctx.GetBody(), req); e != nil {
+			ctx.Abort(error1.ErrReq)
+			return
+		}
+		if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SapproveReq"](req); s != "" {
+			log.Error("[/config.sconfig/sapprove]", s)
+			ctx.Abort(error1.ErrReq)
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			ctx.Abort(e)
+			return
+		}
+		if resp == nil {
+			resp = new(SapproveResp)
+		}
+		respd, _ := proto.Marshal(resp)
+		ctx.Write(respd)
+	}
+}
+func _Sconfig_Sreject_RpcHandler(handler func(context.Context, *SrejectReq) (*SrejectResp, error)) rpc.OutsideHandler {
+	return func(ctx *rpc.Context) {
+		req := new(SrejectReq)
+		if e := proto.Unmarshal(ctx.GetBody(), req); e != nil {
+			ctx.Abort(error1.ErrReq)
+			return
+		}
+		if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SrejectReq"](req); s != "" {
+			log.Error("[/config.sconfig/sreject]", s)
+			ctx.Abort(error1.ErrReq)
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			ctx.Abort(e)
+			return
+		}
+		if resp == nil {
+			resp = new(SrejectResp)
+		}
+		respd, _ := proto.Marshal(resp)
+		ctx.Write(respd)
+	}
+}
+func _Sconfig_Spending_RpcHandler(handler func(context.Context, *SpendingReq) (*SpendingResp, error)) rpc.OutsideHandler {
+	return func(ctx *rpc.Context) {
+		req := new(SpendingReq)
+		if e := proto.Unmarshal(ctx.GetBody(), req); e != nil {
+			ctx.Abort(error1.ErrReq)
+			return
+		}
+		if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SpendingReq"](req); s != "" {
+			log.Error("[/config.sconfig/spending]", s)
+			ctx.Abort(error1.ErrReq)
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			ctx.Abort(e)
+			return
+		}
+		if resp == nil {
+			resp = new(SpendingResp)
+		}
+		respd, _ := proto.Marshal(resp)
+		ctx.Write(respd)
+	}
+}
+func _Sconfig_Sschedule_RpcHandler(handler func(context.Context, *SscheduleReq) (*SscheduleResp, error)) rpc.OutsideHandler {
+	return func(ctx *rpc.Context) {
+		req := new(SscheduleReq)
+		if e := proto.Unmarshal(ctx.GetBody(), req); e != nil {
+			ctx.Abort(error1.ErrReq)
+			return
+		}
+		if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SscheduleReq"](req); s != "" {
+			log.Error("[/config.sconfig/sschedule]", s)
+			ctx.Abort(error1.ErrReq)
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			ctx.Abort(e)
+			return
+		}
+		if resp == nil {
+			resp = new(SscheduleResp)
+		}
+		respd, _ := proto.Marshal(resp)
+		ctx.Write(respd)
+	}
+}
+func _Sconfig_SscheduleList_RpcHandler(handler func(context.Context, *SscheduleListReq) (*SscheduleListResp, error)) rpc.OutsideHandler {
+	return func(ctx *rpc.Context) {
+		req := new(SscheduleListReq)
+		if e := proto.Unmarshal(ctx.GetBody(), req); e != nil {
+			ctx.Abort(error1.ErrReq)
+			return
+		}
+		if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SscheduleListReq"](req); s != "" {
+			log.Error("[/config.sconfig/sschedule_list]", s)
+			ctx.Abort(error1.ErrReq)
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			ctx.Abort(e)
+			return
+		}
+		if resp == nil {
+			resp = new(SscheduleListResp)
+		}
+		respd, _ := proto.Marshal(resp)
+		ctx.Write(respd)
+	}
+}
+func _Sconfig_SscheduleCancel_RpcHandler(handler func(context.Context, *SscheduleCancelReq) (*SscheduleCancelResp, error)) rpc.OutsideHandler {
+	return func(ctx *rpc.Context) {
+		req := new(SscheduleCancelReq)
+		if e := proto.Unmarshal(ctx.GetBody(), req); e != nil {
+			ctx.Abort(error1.ErrReq)
+			return
+		}
+		if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SscheduleCancelReq"](req); s != "" {
+			log.Error("[/config.sconfig/sschedule_cancel]", s)
+			ctx.Abort(error1.ErrReq)
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			ctx.Abort(e)
+			return
+		}
+		if resp == nil {
+			resp = new(SscheduleCancelResp)
+		}
+		respd, _ := proto.Marshal(resp)
+		ctx.Write(respd)
+	}
+}
+func _Sconfig_Scanary_RpcHandler(handler func(context.Context, *ScanaryReq) (*ScanaryResp, error)) rpc.OutsideHandler {
+	return func(ctx *rpc.Context) {
+		req := new(ScanaryReq)
+		if e := proto.Unmarshal(ctx.GetBody(), req); e != nil {
+			ctx.Abort(error1.ErrReq)
+			return
+		}
+		if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".ScanaryReq"](req); s != "" {
+			log.Error("[/config.sconfig/scanary]", s)
+			ctx.Abort(error1.ErrReq)
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			ctx.Abort(e)
+			return
+		}
+		if resp == nil {
+			resp = new(ScanaryResp)
+		}
+		respd, _ := proto.Marshal(resp)
+		ctx.Write(respd)
+	}
+}
+func _Sconfig_ScanaryPromote_RpcHandler(handler func(context.Context, *ScanaryPromoteReq) (*ScanaryPromoteResp, error)) rpc.OutsideHandler {
+	return func(ctx *rpc.Context) {
+		req := new(ScanaryPromoteReq)
+		if e := proto.Unmarshal(ctx.GetBody(), req); e != nil {
+			ctx.Abort(error1.ErrReq)
+			return
+		}
+		if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".ScanaryPromoteReq"](req); s != "" {
+			log.Error("[/config.sconfig/scanary_promote]", s)
+			ctx.Abort(error1.ErrReq)
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			ctx.Abort(e)
+			return
+		}
+		if resp == nil {
+			resp = new(ScanaryPromoteResp)
+		}
+		respd, _ := proto.Marshal(resp)
+		ctx.Write(respd)
+	}
+}
+func _Sconfig_ScanaryAbort_RpcHandler(handler func(context.Context, *ScanaryAbortReq) (*ScanaryAbortResp, error)) rpc.OutsideHandler {
+	return func(ctx *rpc.Context) {
+		req := new(ScanaryAbortReq)
+		if e := proto.Unmarshal(ctx.GetBody(), req); e != nil {
+			ctx.Abort(error1.ErrReq)
+			return
+		}
+		if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".ScanaryAbortReq"](req); s != "" {
+			log.Error("[/config.sconfig/scanary_abort]", s)
+			ctx.Abort(error1.ErrReq)
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			ctx.Abort(e)
+			return
+		}
+		if resp == nil {
+			resp = new(ScanaryAbortResp)
+		}
+		respd, _ := proto.Marshal(resp)
+		ctx.Write(respd)
+	}
+}
+func _Sconfig_Sreport_RpcHandler(handler func(context.Context, *SreportReq) (*SreportResp, error)) rpc.OutsideHandler {
+	return func(ctx *rpc.Context) {
+		req := new(SreportReq)
+		if e := proto.Unmarshal(ctx.GetBody(), req); e != nil {
+			ctx.Abort(error1.ErrReq)
+			return
+		}
+		if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SreportReq"](req); s != "" {
+			log.Error("[/config.sconfig/sreport]", s)
+			ctx.Abort(error1.ErrReq)
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			ctx.Abort(e)
+			return
+		}
+		if resp == nil {
+			resp = new(SreportResp)
+		}
+		respd, _ := proto.Marshal(resp)
+		ctx.Write(respd)
+	}
+}
+func _Sconfig_Srollout_RpcHandler(handler func(context.Context, *SrolloutReq) (*SrolloutResp, error)) rpc.OutsideHandler {
+	return func(ctx *rpc.Context) {
+		req := new(SrolloutReq)
+		if e := proto.Unmarshal(ctx.GetBody(), req); e != nil {
+			ctx.Abort(error1.ErrReq)
+			return
+		}
+		if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SrolloutReq"](req); s != "" {
+			log.Error("[/config.sconfig/srollout]", s)
+			ctx.Abort(error1.ErrReq)
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			ctx.Abort(e)
+			return
+		}
+		if resp == nil {
+			resp = new(SrolloutResp)
+		}
+		respd, _ := proto.Marshal(resp)
+		ctx.Write(respd)
+	}
+}
+func _Sconfig_Sdelete_RpcHandler(handler func(context.Context, *SdeleteReq) (*SdeleteResp, error)) rpc.OutsideHandler {
+	return func(ctx *rpc.Context) {
+		req := new(SdeleteReq)
+		if e := proto.Unmarshal(ctx.GetBody(), req); e != nil {
+			ctx.Abort(error1.ErrReq)
+			return
+		}
+		if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SdeleteReq"](req); s != "" {
+			log.Error("[/config.sconfig/sdelete]", s)
+			ctx.Abort(error1.ErrReq)
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			ctx.Abort(e)
+			return
+		}
+		if resp == nil {
+			resp = new(SdeleteResp)
+		}
+		respd, _ := proto.Marshal(resp)
+		ctx.Write(respd)
+	}
+}
+func _Sconfig_Srestore_RpcHandler(handler func(context.Context, *SrestoreReq) (*SrestoreResp, error)) rpc.OutsideHandler {
+	return func(ctx *rpc.Context) {
+		req := new(SrestoreReq)
+		if e := proto.Unmarshal(ctx.GetBody(), req); e != nil {
+			ctx.Abort(error1.ErrReq)
+			return
+		}
+		if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SrestoreReq"](req); s != "" {
+			log.Error("[/config.sconfig/srestore]", s)
+			ctx.Abort(error1.ErrReq)
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			ctx.Abort(e)
+			return
+		}
+		if resp == nil {
+			resp = new(SrestoreResp)
+		}
+		respd, _ := proto.Marshal(resp)
+		ctx.Write(respd)
+	}
+}
+func _Sconfig_Spurge_RpcHandler(handler func(context.Context, *SpurgeReq) (*SpurgeResp, error)) rpc.OutsideHandler {
+	return func(ctx *rpc.Context) {
+		req := new(SpurgeReq)
+		if e := proto.Unmarshal(ctx.GetBody(), req); e != nil {
+			ctx.Abort(error1.ErrReq)
+			return
+		}
+		if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SpurgeReq"](req); s != "" {
+			log.Error("[/config.sconfig/spurge]", s)
+			ctx.Abort(error1.ErrReq)
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			ctx.Abort(e)
+			return
+		}
+		if resp == nil {
+			resp = new(SpurgeResp)
+		}
+		respd, _ := proto.Marshal(resp)
+		ctx.Write(respd)
+	}
+}
+func _Sconfig_Srecycle_RpcHandler(handler func(context.Context, *SrecycleReq) (*SrecycleResp, error)) rpc.OutsideHandler {
+	return func(ctx *rpc.Context) {
+		req := new(SrecycleReq)
+		if e := proto.Unmarshal(ctx.GetBody(), req); e != nil {
+			ctx.Abort(error1.ErrReq)
+			return
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			ctx.Abort(e)
+			return
+		}
+		if resp == nil {
+			resp = new(SrecycleResp)
+		}
+		respd, _ := proto.Marshal(resp)
+		ctx.Write(respd)
+	}
+}
+func _Sconfig_Stag_RpcHandler(handler func(context.Context, *StagReq) (*StagResp, error)) rpc.OutsideHandler {
+	return func(ctx *rpc.Context) {
+		req := new(StagReq)
+		if e := proto.Unmarshal(ctx.GetBody(), req); e != nil {
+			ctx.Abort(error1.ErrReq)
+			return
+		}
+		if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".StagReq"](req); s != "" {
+			log.Error("[/config.sconfig/stag]", s)
+			ctx.Abort(error1.ErrReq)
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			ctx.Abort(e)
+			return
+		}
+		if resp == nil {
+			resp = new(StagResp)
+		}
+		respd, _ := proto.Marshal(resp)
+		ctx.Write(respd)
+	}
+}
+func _Sconfig_SretentionSet_RpcHandler(handler func(context.Context, *SretentionSetReq) (*SretentionSetResp, error)) rpc.OutsideHandler {
+	return func(ctx *rpc.Context) {
+		req := new(SretentionSetReq)
+		if e := proto.Unmarshal(ctx.GetBody(), req); e != nil {
+			ctx.Abort(error1.ErrReq)
+			return
+		}
+		if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SretentionSetReq"](req); s != "" {
+			log.Error("[/config.sconfig/sretention_set]", s)
+			ctx.Abort(error1.ErrReq)
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			ctx.Abort(e)
+			return
+		}
+		if resp == nil {
+			resp = new(SretentionSetResp)
 		}
 		respd, _ := proto.Marshal(resp)
 		ctx.Write(respd)
 	}
 }
-func _Sconfig_Srollback_RpcHandler(handler func(context.Context, *SrollbackReq) (*SrollbackResp, error)) rpc.OutsideHandler {
+func _Sconfig_SretentionGet_RpcHandler(handler func(context.Context, *SretentionGetReq) (*SretentionGetResp, error)) rpc.OutsideHandler {
 	return func(ctx *rpc.Context) {
-		req := new(SrollbackReq)
+		req := new(SretentionGetReq)
 		if e := proto.Unmarshal(ctx.GetBody(), req); e != nil {
 			ctx.Abort(error1.ErrReq)
 			return
 		}
-		if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SrollbackReq"](req); s != "" {
-			log.Error("[/config.sconfig/srollback]", s)
+		if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SretentionGetReq"](req); s != "" {
+			log.Error("[/config.sconfig/sretention_get]", s)
 			ctx.Abort(error1.ErrReq)
 		}
 		resp, e := handler(ctx, req)
@@ -338,21 +2473,21 @@ func _Sconfig_Srollback_RpcHandler(handler func(context.Context, *SrollbackReq)
 			return
 		}
 		if resp == nil {
-			resp = new(SrollbackResp)
+			resp = new(SretentionGetResp)
 		}
 		respd, _ := proto.Marshal(resp)
 		ctx.Write(respd)
 	}
 }
-func _Sconfig_Sget_RpcHandler(handler func(context.Context, *SgetReq) (*SgetResp, error)) rpc.OutsideHandler {
+func _Sconfig_SprincipalSet_RpcHandler(handler func(context.Context, *SprincipalSetReq) (*SprincipalSetResp, error)) rpc.OutsideHandler {
 	return func(ctx *rpc.Context) {
-		req := new(SgetReq)
+		req := new(SprincipalSetReq)
 		if e := proto.Unmarshal(ctx.GetBody(), req); e != nil {
 			ctx.Abort(error1.ErrReq)
 			return
 		}
-		if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SgetReq"](req); s != "" {
-			log.Error("[/config.sconfig/sget]", s)
+		if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SprincipalSetReq"](req); s != "" {
+			log.Error("[/config.sconfig/sprincipal_set]", s)
 			ctx.Abort(error1.ErrReq)
 		}
 		resp, e := handler(ctx, req)
@@ -361,40 +2496,63 @@ func _Sconfig_Sget_RpcHandler(handler func(context.Context, *SgetReq) (*SgetResp
 			return
 		}
 		if resp == nil {
-			resp = new(SgetResp)
+			resp = new(SprincipalSetResp)
 		}
 		respd, _ := proto.Marshal(resp)
 		ctx.Write(respd)
 	}
 }
-func _Sconfig_Sgroups_RpcHandler(handler func(context.Context, *SgroupsReq) (*SgroupsResp, error)) rpc.OutsideHandler {
+func _Sconfig_SprincipalDel_RpcHandler(handler func(context.Context, *SprincipalDelReq) (*SprincipalDelResp, error)) rpc.OutsideHandler {
 	return func(ctx *rpc.Context) {
-		req := new(SgroupsReq)
+		req := new(SprincipalDelReq)
 		if e := proto.Unmarshal(ctx.GetBody(), req); e != nil {
 			ctx.Abort(error1.ErrReq)
 			return
 		}
+		if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SprincipalDelReq"](req); s != "" {
+			log.Error("[/config.sconfig/sprincipal_del]", s)
+			ctx.Abort(error1.ErrReq)
+		}
 		resp, e := handler(ctx, req)
 		if e != nil {
 			ctx.Abort(e)
 			return
 		}
 		if resp == nil {
-			resp = new(SgroupsResp)
+			resp = new(SprincipalDelResp)
 		}
 		respd, _ := proto.Marshal(resp)
 		ctx.Write(respd)
 	}
 }
-func _Sconfig_Sapps_RpcHandler(handler func(context.Context, *SappsReq) (*SappsResp, error)) rpc.OutsideHandler {
+func _Sconfig_Sprincipals_RpcHandler(handler func(context.Context, *SprincipalsReq) (*SprincipalsResp, error)) rpc.OutsideHandler {
 	return func(ctx *rpc.Context) {
-		req := new(SappsReq)
+		req := new(SprincipalsReq)
 		if e := proto.Unmarshal(ctx.GetBody(), req); e != nil {
 			ctx.Abort(error1.ErrReq)
 			return
 		}
-		if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SappsReq"](req); s != "" {
-			log.Error("[/config.sconfig/sapps]", s)
+		resp, e := handler(ctx, req)
+		if e != nil {
+			ctx.Abort(e)
+			return
+		}
+		if resp == nil {
+			resp = new(SprincipalsResp)
+		}
+		respd, _ := proto.Marshal(resp)
+		ctx.Write(respd)
+	}
+}
+func _Sconfig_Sgrant_RpcHandler(handler func(context.Context, *SgrantReq) (*SgrantResp, error)) rpc.OutsideHandler {
+	return func(ctx *rpc.Context) {
+		req := new(SgrantReq)
+		if e := proto.Unmarshal(ctx.GetBody(), req); e != nil {
+			ctx.Abort(error1.ErrReq)
+			return
+		}
+		if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SgrantReq"](req); s != "" {
+			log.Error("[/config.sconfig/sgrant]", s)
 			ctx.Abort(error1.ErrReq)
 		}
 		resp, e := handler(ctx, req)
@@ -403,26 +2561,187 @@ func _Sconfig_Sapps_RpcHandler(handler func(context.Context, *SappsReq) (*SappsR
 			return
 		}
 		if resp == nil {
-			resp = new(SappsResp)
+			resp = new(SgrantResp)
+		}
+		respd, _ := proto.Marshal(resp)
+		ctx.Write(respd)
+	}
+}
+func _Sconfig_Sgrants_RpcHandler(handler func(context.Context, *SgrantsReq) (*SgrantsResp, error)) rpc.OutsideHandler {
+	return func(ctx *rpc.Context) {
+		req := new(SgrantsReq)
+		if e := proto.Unmarshal(ctx.GetBody(), req); e != nil {
+			ctx.Abort(error1.ErrReq)
+			return
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			ctx.Abort(e)
+			return
+		}
+		if resp == nil {
+			resp = new(SgrantsResp)
+		}
+		respd, _ := proto.Marshal(resp)
+		ctx.Write(respd)
+	}
+}
+func _Sconfig_StokenIssue_RpcHandler(handler func(context.Context, *StokenIssueReq) (*StokenIssueResp, error)) rpc.OutsideHandler {
+	return func(ctx *rpc.Context) {
+		req := new(StokenIssueReq)
+		if e := proto.Unmarshal(ctx.GetBody(), req); e != nil {
+			ctx.Abort(error1.ErrReq)
+			return
+		}
+		if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".StokenIssueReq"](req); s != "" {
+			log.Error("[/config.sconfig/stoken_issue]", s)
+			ctx.Abort(error1.ErrReq)
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			ctx.Abort(e)
+			return
+		}
+		if resp == nil {
+			resp = new(StokenIssueResp)
+		}
+		respd, _ := proto.Marshal(resp)
+		ctx.Write(respd)
+	}
+}
+func _Sconfig_StokenRotate_RpcHandler(handler func(context.Context, *StokenRotateReq) (*StokenRotateResp, error)) rpc.OutsideHandler {
+	return func(ctx *rpc.Context) {
+		req := new(StokenRotateReq)
+		if e := proto.Unmarshal(ctx.GetBody(), req); e != nil {
+			ctx.Abort(error1.ErrReq)
+			return
+		}
+		if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".StokenRotateReq"](req); s != "" {
+			log.Error("[/config.sconfig/stoken_rotate]", s)
+			ctx.Abort(error1.ErrReq)
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			ctx.Abort(e)
+			return
+		}
+		if resp == nil {
+			resp = new(StokenRotateResp)
+		}
+		respd, _ := proto.Marshal(resp)
+		ctx.Write(respd)
+	}
+}
+func _Sconfig_StokenRevoke_RpcHandler(handler func(context.Context, *StokenRevokeReq) (*StokenRevokeResp, error)) rpc.OutsideHandler {
+	return func(ctx *rpc.Context) {
+		req := new(StokenRevokeReq)
+		if e := proto.Unmarshal(ctx.GetBody(), req); e != nil {
+			ctx.Abort(error1.ErrReq)
+			return
+		}
+		if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".StokenRevokeReq"](req); s != "" {
+			log.Error("[/config.sconfig/stoken_revoke]", s)
+			ctx.Abort(error1.ErrReq)
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			ctx.Abort(e)
+			return
+		}
+		if resp == nil {
+			resp = new(StokenRevokeResp)
+		}
+		respd, _ := proto.Marshal(resp)
+		ctx.Write(respd)
+	}
+}
+func _Sconfig_Stokens_RpcHandler(handler func(context.Context, *StokensReq) (*StokensResp, error)) rpc.OutsideHandler {
+	return func(ctx *rpc.Context) {
+		req := new(StokensReq)
+		if e := proto.Unmarshal(ctx.GetBody(), req); e != nil {
+			ctx.Abort(error1.ErrReq)
+			return
+		}
+		if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".StokensReq"](req); s != "" {
+			log.Error("[/config.sconfig/stokens]", s)
+			ctx.Abort(error1.ErrReq)
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			ctx.Abort(e)
+			return
+		}
+		if resp == nil {
+			resp = new(StokensResp)
+		}
+		respd, _ := proto.Marshal(resp)
+		ctx.Write(respd)
+	}
+}
+func _Sconfig_SbaseSet_RpcHandler(handler func(context.Context, *SbaseSetReq) (*SbaseSetResp, error)) rpc.OutsideHandler {
+	return func(ctx *rpc.Context) {
+		req := new(SbaseSetReq)
+		if e := proto.Unmarshal(ctx.GetBody(), req); e != nil {
+			ctx.Abort(error1.ErrReq)
+			return
+		}
+		if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SbaseSetReq"](req); s != "" {
+			log.Error("[/config.sconfig/sbase_set]", s)
+			ctx.Abort(error1.ErrReq)
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			ctx.Abort(e)
+			return
+		}
+		if resp == nil {
+			resp = new(SbaseSetResp)
+		}
+		respd, _ := proto.Marshal(resp)
+		ctx.Write(respd)
+	}
+}
+func _Sconfig_SbaseGet_RpcHandler(handler func(context.Context, *SbaseGetReq) (*SbaseGetResp, error)) rpc.OutsideHandler {
+	return func(ctx *rpc.Context) {
+		req := new(SbaseGetReq)
+		if e := proto.Unmarshal(ctx.GetBody(), req); e != nil {
+			ctx.Abort(error1.ErrReq)
+			return
+		}
+		if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SbaseGetReq"](req); s != "" {
+			log.Error("[/config.sconfig/sbase_get]", s)
+			ctx.Abort(error1.ErrReq)
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			ctx.Abort(e)
+			return
+		}
+		if resp == nil {
+			resp = new(SbaseGetResp)
 		}
 		respd, _ := proto.Marshal(resp)
 		ctx.Write(respd)
 	}
 }
-func _Sconfig_Swatchaddr_RpcHandler(handler func(context.Context, *SwatchaddrReq) (*SwatchaddrResp, error)) rpc.OutsideHandler {
+func _Sconfig_Srender_RpcHandler(handler func(context.Context, *SrenderReq) (*SrenderResp, error)) rpc.OutsideHandler {
 	return func(ctx *rpc.Context) {
-		req := new(SwatchaddrReq)
+		req := new(SrenderReq)
 		if e := proto.Unmarshal(ctx.GetBody(), req); e != nil {
 			ctx.Abort(error1.ErrReq)
 			return
 		}
+		if s := _SconfigRpcCheckers["\"\\\"Config/api\\\"\".SrenderReq"](req); s != "" {
+			log.Error("[/config.sconfig/srender]", s)
+			ctx.Abort(error1.ErrReq)
+		}
 		resp, e := handler(ctx, req)
 		if e != nil {
 			ctx.Abort(e)
 			return
 		}
 		if resp == nil {
-			resp = new(SwatchaddrResp)
+			resp = new(SrenderResp)
 		}
 		respd, _ := proto.Marshal(resp)
 		ctx.Write(respd)
@@ -449,7 +2768,124 @@ func RegisterSconfigRpcServer(engine *rpc.RpcServer, svc SconfigRpcServer, allmi
 	if e := engine.RegisterHandler(_RpcPathSconfigSapps, 250000000, _Sconfig_Sapps_RpcHandler(svc.Sapps)); e != nil {
 		return e
 	}
-	if e := engine.RegisterHandler(_RpcPathSconfigSwatchaddr, 250000000, _Sconfig_Swatchaddr_RpcHandler(svc.Swatchaddr)); e != nil {
+	if e := engine.RegisterHandler(_RpcPathSconfigSwatch, 30000000000, _Sconfig_Swatch_RpcHandler(svc.Swatch)); e != nil {
+		return e
+	}
+	if e := engine.RegisterHandler(_RpcPathSconfigShistory, 250000000, _Sconfig_Shistory_RpcHandler(svc.Shistory)); e != nil {
+		return e
+	}
+	if e := engine.RegisterHandler(_RpcPathSconfigSaudit, 250000000, _Sconfig_Saudit_RpcHandler(svc.Saudit)); e != nil {
+		return e
+	}
+	if e := engine.RegisterHandler(_RpcPathSconfigSpatch, 250000000, _Sconfig_Spatch_RpcHandler(svc.Spatch)); e != nil {
+		return e
+	}
+	if e := engine.RegisterHandler(_RpcPathSconfigSschemaSet, 250000000, _Sconfig_SschemaSet_RpcHandler(svc.SschemaSet)); e != nil {
+		return e
+	}
+	if e := engine.RegisterHandler(_RpcPathSconfigSschemaGet, 250000000, _Sconfig_SschemaGet_RpcHandler(svc.SschemaGet)); e != nil {
+		return e
+	}
+	if e := engine.RegisterHandler(_RpcPathSconfigSdiff, 250000000, _Sconfig_Sdiff_RpcHandler(svc.Sdiff)); e != nil {
+		return e
+	}
+	if e := engine.RegisterHandler(_RpcPathSconfigSpublish, 250000000, _Sconfig_Spublish_RpcHandler(svc.Spublish)); e != nil {
+		return e
+	}
+	if e := engine.RegisterHandler(_RpcPathSconfigSpolicySet, 250000000, _Sconfig_SpolicySet_RpcHandler(svc.SpolicySet)); e != nil {
+		return e
+	}
+	if e := engine.RegisterHandler(_RpcPathSconfigSpolicyGet, 250000000, _Sconfig_SpolicyGet_RpcHandler(svc.SpolicyGet)); e != nil {
+		return e
+	}
+	if e := engine.RegisterHandler(_RpcPathSconfigSapprove, 250000000, _Sconfig_Sapprove_RpcHandler(svc.Sapprove)); e != nil {
+		return e
+	}
+	if e := engine.RegisterHandler(_RpcPathSconfigSreject, 250000000, _Sconfig_Sreject_RpcHandler(svc.Sreject)); e != nil {
+		return e
+	}
+	if e := engine.RegisterHandler(_RpcPathSconfigSpending, 500000000, _Sconfig_Spending_RpcHandler(svc.Spending)); e != nil {
+		return e
+	}
+	if e := engine.RegisterHandler(_RpcPathSconfigSschedule, 250000000, _Sconfig_Sschedule_RpcHandler(svc.Sschedule)); e != nil {
+		return e
+	}
+	if e := engine.RegisterHandler(_RpcPathSconfigSscheduleList, 250000000, _Sconfig_SscheduleList_RpcHandler(svc.SscheduleList)); e != nil {
+		return e
+	}
+	if e := engine.RegisterHandler(_RpcPathSconfigSscheduleCancel, 250000000, _Sconfig_SscheduleCancel_RpcHandler(svc.SscheduleCancel)); e != nil {
+		return e
+	}
+	if e := engine.RegisterHandler(_RpcPathSconfigScanary, 250000000, _Sconfig_Scanary_RpcHandler(svc.Scanary)); e != nil {
+		return e
+	}
+	if e := engine.RegisterHandler(_RpcPathSconfigScanaryPromote, 250000000, _Sconfig_ScanaryPromote_RpcHandler(svc.ScanaryPromote)); e != nil {
+		return e
+	}
+	if e := engine.RegisterHandler(_RpcPathSconfigScanaryAbort, 250000000, _Sconfig_ScanaryAbort_RpcHandler(svc.ScanaryAbort)); e != nil {
+		return e
+	}
+	if e := engine.RegisterHandler(_RpcPathSconfigSreport, 250000000, _Sconfig_Sreport_RpcHandler(svc.Sreport)); e != nil {
+		return e
+	}
+	if e := engine.RegisterHandler(_RpcPathSconfigSrollout, 250000000, _Sconfig_Srollout_RpcHandler(svc.Srollout)); e != nil {
+		return e
+	}
+	if e := engine.RegisterHandler(_RpcPathSconfigSdelete, 500000000, _Sconfig_Sdelete_RpcHandler(svc.Sdelete)); e != nil {
+		return e
+	}
+	if e := engine.RegisterHandler(_RpcPathSconfigSrestore, 500000000, _Sconfig_Srestore_RpcHandler(svc.Srestore)); e != nil {
+		return e
+	}
+	if e := engine.RegisterHandler(_RpcPathSconfigSpurge, 500000000, _Sconfig_Spurge_RpcHandler(svc.Spurge)); e != nil {
+		return e
+	}
+	if e := engine.RegisterHandler(_RpcPathSconfigSrecycle, 500000000, _Sconfig_Srecycle_RpcHandler(svc.Srecycle)); e != nil {
+		return e
+	}
+	if e := engine.RegisterHandler(_RpcPathSconfigStag, 250000000, _Sconfig_Stag_RpcHandler(svc.Stag)); e != nil {
+		return e
+	}
+	if e := engine.RegisterHandler(_RpcPathSconfigSretentionSet, 250000000, _Sconfig_SretentionSet_RpcHandler(svc.SretentionSet)); e != nil {
+		return e
+	}
+	if e := engine.RegisterHandler(_RpcPathSconfigSretentionGet, 250000000, _Sconfig_SretentionGet_RpcHandler(svc.SretentionGet)); e != nil {
+		return e
+	}
+	if e := engine.RegisterHandler(_RpcPathSconfigSprincipalSet, 250000000, _Sconfig_SprincipalSet_RpcHandler(svc.SprincipalSet)); e != nil {
+		return e
+	}
+	if e := engine.RegisterHandler(_RpcPathSconfigSprincipalDel, 250000000, _Sconfig_SprincipalDel_RpcHandler(svc.SprincipalDel)); e != nil {
+		return e
+	}
+	if e := engine.RegisterHandler(_RpcPathSconfigSprincipals, 250000000, _Sconfig_Sprincipals_RpcHandler(svc.Sprincipals)); e != nil {
+		return e
+	}
+	if e := engine.RegisterHandler(_RpcPathSconfigSgrant, 250000000, _Sconfig_Sgrant_RpcHandler(svc.Sgrant)); e != nil {
+		return e
+	}
+	if e := engine.RegisterHandler(_RpcPathSconfigSgrants, 250000000, _Sconfig_Sgrants_RpcHandler(svc.Sgrants)); e != nil {
+		return e
+	}
+	if e := engine.RegisterHandler(_RpcPathSconfigStokenIssue, 250000000, _Sconfig_StokenIssue_RpcHandler(svc.StokenIssue)); e != nil {
+		return e
+	}
+	if e := engine.RegisterHandler(_RpcPathSconfigStokenRotate, 250000000, _Sconfig_StokenRotate_RpcHandler(svc.StokenRotate)); e != nil {
+		return e
+	}
+	if e := engine.RegisterHandler(_RpcPathSconfigStokenRevoke, 250000000, _Sconfig_StokenRevoke_RpcHandler(svc.StokenRevoke)); e != nil {
+		return e
+	}
+	if e := engine.RegisterHandler(_RpcPathSconfigStokens, 250000000, _Sconfig_Stokens_RpcHandler(svc.Stokens)); e != nil {
+		return e
+	}
+	if e := engine.RegisterHandler(_RpcPathSconfigSbaseSet, 500000000, _Sconfig_SbaseSet_RpcHandler(svc.SbaseSet)); e != nil {
+		return e
+	}
+	if e := engine.RegisterHandler(_RpcPathSconfigSbaseGet, 250000000, _Sconfig_SbaseGet_RpcHandler(svc.SbaseGet)); e != nil {
+		return e
+	}
+	if e := engine.RegisterHandler(_RpcPathSconfigSrender, 250000000, _Sconfig_Srender_RpcHandler(svc.Srender)); e != nil {
 		return e
 	}
 	return nil
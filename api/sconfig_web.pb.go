@@ -25,7 +25,7 @@ import (
 var _SconfigWebCheckers map[string]func(req interface{}) string
 
 func init() {
-	_SconfigWebCheckers = make(map[string]func(req interface{}) string, 5)
+	_SconfigWebCheckers = make(map[string]func(req interface{}) string, 42)
 	_SconfigWebCheckers["\"\\\"Config/api\\\"\".SsetReq"] = func(r interface{}) string {
 		req := r.(*SsetReq)
 		if len(req.Groupname) <= 0 {
@@ -79,6 +79,367 @@ func init() {
 		}
 		return ""
 	}
+	_SconfigWebCheckers["\"\\\"Config/api\\\"\".SwatchReq"] = func(r interface{}) string {
+		req := r.(*SwatchReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: swatch_req check value str len gt failed"
+		}
+		if len(req.Appname) <= 0 {
+			return "field: appname in object: swatch_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigWebCheckers["\"\\\"Config/api\\\"\".ShistoryReq"] = func(r interface{}) string {
+		req := r.(*ShistoryReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: shistory_req check value str len gt failed"
+		}
+		if len(req.Appname) <= 0 {
+			return "field: appname in object: shistory_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigWebCheckers["\"\\\"Config/api\\\"\".SauditReq"] = func(r interface{}) string {
+		req := r.(*SauditReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: saudit_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigWebCheckers["\"\\\"Config/api\\\"\".SdiffReq"] = func(r interface{}) string {
+		req := r.(*SdiffReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: sdiff_req check value str len gt failed"
+		}
+		if len(req.Appname) <= 0 {
+			return "field: appname in object: sdiff_req check value str len gt failed"
+		}
+		if req.FromIndex <= 0 {
+			return "field: from_index in object: sdiff_req check value uint gt failed"
+		}
+		return ""
+	}
+	_SconfigWebCheckers["\"\\\"Config/api\\\"\".SpatchReq"] = func(r interface{}) string {
+		req := r.(*SpatchReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: spatch_req check value str len gt failed"
+		}
+		if len(req.Appname) <= 0 {
+			return "field: appname in object: spatch_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigWebCheckers["\"\\\"Config/api\\\"\".SschemaGetReq"] = func(r interface{}) string {
+		req := r.(*SschemaGetReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: sschema_get_req check value str len gt failed"
+		}
+		if len(req.Appname) <= 0 {
+			return "field: appname in object: sschema_get_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigWebCheckers["\"\\\"Config/api\\\"\".SschemaSetReq"] = func(r interface{}) string {
+		req := r.(*SschemaSetReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: sschema_set_req check value str len gt failed"
+		}
+		if len(req.Appname) <= 0 {
+			return "field: appname in object: sschema_set_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigWebCheckers["\"\\\"Config/api\\\"\".SpublishReq"] = func(r interface{}) string {
+		req := r.(*SpublishReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: spublish_req check value str len gt failed"
+		}
+		if len(req.Appname) <= 0 {
+			return "field: appname in object: spublish_req check value str len gt failed"
+		}
+		if req.Index <= 0 {
+			return "field: index in object: spublish_req check value uint gt failed"
+		}
+		return ""
+	}
+	_SconfigWebCheckers["\"\\\"Config/api\\\"\".SapproveReq"] = func(r interface{}) string {
+		req := r.(*SapproveReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: sapprove_req check value str len gt failed"
+		}
+		if len(req.Appname) <= 0 {
+			return "field: appname in object: sapprove_req check value str len gt failed"
+		}
+		if req.Index <= 0 {
+			return "field: index in object: sapprove_req check value uint gt failed"
+		}
+		return ""
+	}
+	_SconfigWebCheckers["\"\\\"Config/api\\\"\".SpendingReq"] = func(r interface{}) string {
+		req := r.(*SpendingReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: spending_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigWebCheckers["\"\\\"Config/api\\\"\".SpolicyGetReq"] = func(r interface{}) string {
+		req := r.(*SpolicyGetReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: spolicy_get_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigWebCheckers["\"\\\"Config/api\\\"\".SpolicySetReq"] = func(r interface{}) string {
+		req := r.(*SpolicySetReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: spolicy_set_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigWebCheckers["\"\\\"Config/api\\\"\".SrejectReq"] = func(r interface{}) string {
+		req := r.(*SrejectReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: sreject_req check value str len gt failed"
+		}
+		if len(req.Appname) <= 0 {
+			return "field: appname in object: sreject_req check value str len gt failed"
+		}
+		if req.Index <= 0 {
+			return "field: index in object: sreject_req check value uint gt failed"
+		}
+		return ""
+	}
+	_SconfigWebCheckers["\"\\\"Config/api\\\"\".SscheduleCancelReq"] = func(r interface{}) string {
+		req := r.(*SscheduleCancelReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: sschedule_cancel_req check value str len gt failed"
+		}
+		if len(req.Appname) <= 0 {
+			return "field: appname in object: sschedule_cancel_req check value str len gt failed"
+		}
+		if len(req.Id) <= 0 {
+			return "field: id in object: sschedule_cancel_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigWebCheckers["\"\\\"Config/api\\\"\".SscheduleListReq"] = func(r interface{}) string {
+		req := r.(*SscheduleListReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: sschedule_list_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigWebCheckers["\"\\\"Config/api\\\"\".SscheduleReq"] = func(r interface{}) string {
+		req := r.(*SscheduleReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: sschedule_req check value str len gt failed"
+		}
+		if len(req.Appname) <= 0 {
+			return "field: appname in object: sschedule_req check value str len gt failed"
+		}
+		if req.Index <= 0 {
+			return "field: index in object: sschedule_req check value uint gt failed"
+		}
+		if len(req.FireTime) <= 0 {
+			return "field: fire_time in object: sschedule_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigWebCheckers["\"\\\"Config/api\\\"\".ScanaryAbortReq"] = func(r interface{}) string {
+		req := r.(*ScanaryAbortReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: scanary_abort_req check value str len gt failed"
+		}
+		if len(req.Appname) <= 0 {
+			return "field: appname in object: scanary_abort_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigWebCheckers["\"\\\"Config/api\\\"\".ScanaryPromoteReq"] = func(r interface{}) string {
+		req := r.(*ScanaryPromoteReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: scanary_promote_req check value str len gt failed"
+		}
+		if len(req.Appname) <= 0 {
+			return "field: appname in object: scanary_promote_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigWebCheckers["\"\\\"Config/api\\\"\".ScanaryReq"] = func(r interface{}) string {
+		req := r.(*ScanaryReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: scanary_req check value str len gt failed"
+		}
+		if len(req.Appname) <= 0 {
+			return "field: appname in object: scanary_req check value str len gt failed"
+		}
+		if req.Index <= 0 {
+			return "field: index in object: scanary_req check value uint gt failed"
+		}
+		return ""
+	}
+	_SconfigWebCheckers["\"\\\"Config/api\\\"\".SreportReq"] = func(r interface{}) string {
+		req := r.(*SreportReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: sreport_req check value str len gt failed"
+		}
+		if len(req.Appname) <= 0 {
+			return "field: appname in object: sreport_req check value str len gt failed"
+		}
+		if len(req.Instance) <= 0 {
+			return "field: instance in object: sreport_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigWebCheckers["\"\\\"Config/api\\\"\".SrolloutReq"] = func(r interface{}) string {
+		req := r.(*SrolloutReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: srollout_req check value str len gt failed"
+		}
+		if len(req.Appname) <= 0 {
+			return "field: appname in object: srollout_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigWebCheckers["\"\\\"Config/api\\\"\".SdeleteReq"] = func(r interface{}) string {
+		req := r.(*SdeleteReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: sdelete_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigWebCheckers["\"\\\"Config/api\\\"\".SpurgeReq"] = func(r interface{}) string {
+		req := r.(*SpurgeReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: spurge_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigWebCheckers["\"\\\"Config/api\\\"\".SrestoreReq"] = func(r interface{}) string {
+		req := r.(*SrestoreReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: srestore_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigWebCheckers["\"\\\"Config/api\\\"\".SretentionGetReq"] = func(r interface{}) string {
+		req := r.(*SretentionGetReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: sretention_get_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigWebCheckers["\"\\\"Config/api\\\"\".SretentionSetReq"] = func(r interface{}) string {
+		req := r.(*SretentionSetReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: sretention_set_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigWebCheckers["\"\\\"Config/api\\\"\".StagReq"] = func(r interface{}) string {
+		req := r.(*StagReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: stag_req check value str len gt failed"
+		}
+		if len(req.Appname) <= 0 {
+			return "field: appname in object: stag_req check value str len gt failed"
+		}
+		if req.Index <= 0 {
+			return "field: index in object: stag_req check value uint gt failed"
+		}
+		return ""
+	}
+	_SconfigWebCheckers["\"\\\"Config/api\\\"\".SgrantReq"] = func(r interface{}) string {
+		req := r.(*SgrantReq)
+		if len(req.Principal) <= 0 {
+			return "field: principal in object: sgrant_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigWebCheckers["\"\\\"Config/api\\\"\".SprincipalDelReq"] = func(r interface{}) string {
+		req := r.(*SprincipalDelReq)
+		if len(req.Name) <= 0 {
+			return "field: name in object: sprincipal_del_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigWebCheckers["\"\\\"Config/api\\\"\".SprincipalSetReq"] = func(r interface{}) string {
+		req := r.(*SprincipalSetReq)
+		if len(req.Name) <= 0 {
+			return "field: name in object: sprincipal_set_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigWebCheckers["\"\\\"Config/api\\\"\".StokenIssueReq"] = func(r interface{}) string {
+		req := r.(*StokenIssueReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: stoken_issue_req check value str len gt failed"
+		}
+		if len(req.Appname) <= 0 {
+			return "field: appname in object: stoken_issue_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigWebCheckers["\"\\\"Config/api\\\"\".StokenRevokeReq"] = func(r interface{}) string {
+		req := r.(*StokenRevokeReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: stoken_revoke_req check value str len gt failed"
+		}
+		if len(req.Appname) <= 0 {
+			return "field: appname in object: stoken_revoke_req check value str len gt failed"
+		}
+		if len(req.Id) <= 0 {
+			return "field: id in object: stoken_revoke_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigWebCheckers["\"\\\"Config/api\\\"\".StokenRotateReq"] = func(r interface{}) string {
+		req := r.(*StokenRotateReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: stoken_rotate_req check value str len gt failed"
+		}
+		if len(req.Appname) <= 0 {
+			return "field: appname in object: stoken_rotate_req check value str len gt failed"
+		}
+		if len(req.Id) <= 0 {
+			return "field: id in object: stoken_rotate_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigWebCheckers["\"\\\"Config/api\\\"\".StokensReq"] = func(r interface{}) string {
+		req := r.(*StokensReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: stokens_req check value str len gt failed"
+		}
+		if len(req.Appname) <= 0 {
+			return "field: appname in object: stokens_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigWebCheckers["\"\\\"Config/api\\\"\".SbaseGetReq"] = func(r interface{}) string {
+		req := r.(*SbaseGetReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: sbase_get_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigWebCheckers["\"\\\"Config/api\\\"\".SbaseSetReq"] = func(r interface{}) string {
+		req := r.(*SbaseSetReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: sbase_set_req check value str len gt failed"
+		}
+		return ""
+	}
+	_SconfigWebCheckers["\"\\\"Config/api\\\"\".SrenderReq"] = func(r interface{}) string {
+		req := r.(*SrenderReq)
+		if len(req.Groupname) <= 0 {
+			return "field: groupname in object: srender_req check value str len gt failed"
+		}
+		if len(req.Appname) <= 0 {
+			return "field: appname in object: srender_req check value str len gt failed"
+		}
+		return ""
+	}
 }
 
 var _WebPathSconfigSinfo = "/config.sconfig/sinfo"
@@ -87,7 +448,46 @@ var _WebPathSconfigSrollback = "/config.sconfig/srollback"
 var _WebPathSconfigSget = "/config.sconfig/sget"
 var _WebPathSconfigSgroups = "/config.sconfig/sgroups"
 var _WebPathSconfigSapps = "/config.sconfig/sapps"
-var _WebPathSconfigSwatchaddr = "/config.sconfig/swatchaddr"
+var _WebPathSconfigSwatch = "/config.sconfig/swatch"
+var _WebPathSconfigShistory = "/config.sconfig/shistory"
+var _WebPathSconfigSaudit = "/config.sconfig/saudit"
+var _WebPathSconfigSpatch = "/config.sconfig/spatch"
+var _WebPathSconfigSschemaSet = "/config.sconfig/sschema_set"
+var _WebPathSconfigSschemaGet = "/config.sconfig/sschema_get"
+var _WebPathSconfigSdiff = "/config.sconfig/sdiff"
+var _WebPathSconfigSpublish = "/config.sconfig/spublish"
+var _WebPathSconfigSpolicySet = "/config.sconfig/spolicy_set"
+var _WebPathSconfigSpolicyGet = "/config.sconfig/spolicy_get"
+var _WebPathSconfigSapprove = "/config.sconfig/sapprove"
+var _WebPathSconfigSreject = "/config.sconfig/sreject"
+var _WebPathSconfigSpending = "/config.sconfig/spending"
+var _WebPathSconfigSschedule = "/config.sconfig/sschedule"
+var _WebPathSconfigSscheduleList = "/config.sconfig/sschedule_list"
+var _WebPathSconfigSscheduleCancel = "/config.sconfig/sschedule_cancel"
+var _WebPathSconfigScanary = "/config.sconfig/scanary"
+var _WebPathSconfigScanaryPromote = "/config.sconfig/scanary_promote"
+var _WebPathSconfigScanaryAbort = "/config.sconfig/scanary_abort"
+var _WebPathSconfigSreport = "/config.sconfig/sreport"
+var _WebPathSconfigSrollout = "/config.sconfig/srollout"
+var _WebPathSconfigSdelete = "/config.sconfig/sdelete"
+var _WebPathSconfigSrestore = "/config.sconfig/srestore"
+var _WebPathSconfigSpurge = "/config.sconfig/spurge"
+var _WebPathSconfigSrecycle = "/config.sconfig/srecycle"
+var _WebPathSconfigStag = "/config.sconfig/stag"
+var _WebPathSconfigSretentionSet = "/config.sconfig/sretention_set"
+var _WebPathSconfigSretentionGet = "/config.sconfig/sretention_get"
+var _WebPathSconfigSprincipalSet = "/config.sconfig/sprincipal_set"
+var _WebPathSconfigSprincipalDel = "/config.sconfig/sprincipal_del"
+var _WebPathSconfigSprincipals = "/config.sconfig/sprincipals"
+var _WebPathSconfigSgrant = "/config.sconfig/sgrant"
+var _WebPathSconfigSgrants = "/config.sconfig/sgrants"
+var _WebPathSconfigStokenIssue = "/config.sconfig/stoken_issue"
+var _WebPathSconfigStokenRotate = "/config.sconfig/stoken_rotate"
+var _WebPathSconfigStokenRevoke = "/config.sconfig/stoken_revoke"
+var _WebPathSconfigStokens = "/config.sconfig/stokens"
+var _WebPathSconfigSbaseSet = "/config.sconfig/sbase_set"
+var _WebPathSconfigSbaseGet = "/config.sconfig/sbase_get"
+var _WebPathSconfigSrender = "/config.sconfig/srender"
 
 type SconfigWebClient interface {
 	//one specific app's current info
@@ -102,8 +502,98 @@ type SconfigWebClient interface {
 	Sgroups(context.Context, *SgroupsReq, http.Header) (*SgroupsResp, error)
 	//get all apps
 	Sapps(context.Context, *SappsReq, http.Header) (*SappsResp, error)
-	//get watch addr
-	Swatchaddr(context.Context, *SwatchaddrReq, http.Header) (*SwatchaddrResp, error)
+	//watch one specific app's config
+	//this is a long poll,it returns at once when the op_num in req is different from the current
+	//otherwise it returns when the config changed or the timeout is near
+	Swatch(context.Context, *SwatchReq, http.Header) (*SwatchResp, error)
+	//get one specific app's versions,newest first
+	Shistory(context.Context, *ShistoryReq, http.Header) (*ShistoryResp, error)
+	//get the op records,newest first
+	Saudit(context.Context, *SauditReq, http.Header) (*SauditResp, error)
+	//patch one specific app's current config and create a new version
+	Spatch(context.Context, *SpatchReq, http.Header) (*SpatchResp, error)
+	//set one specific app's json schema,this will create a new schema version
+	//the config will be checked with the newest schema when set,patch and rollback
+	SschemaSet(context.Context, *SschemaSetReq, http.Header) (*SschemaSetResp, error)
+	//get one specific app's json schema
+	SschemaGet(context.Context, *SschemaGetReq, http.Header) (*SschemaGetResp, error)
+	//diff one specific app's two config versions
+	Sdiff(context.Context, *SdiffReq, http.Header) (*SdiffResp, error)
+	//make one specific app's version current,usually used to publish a draft
+	Spublish(context.Context, *SpublishReq, http.Header) (*SpublishResp, error)
+	//set one specific group's approval policy
+	//when the policy is enabled,sset and spatch in this group will always save a draft
+	//and the draft can only be published after enough reviewers approved it
+	SpolicySet(context.Context, *SpolicySetReq, http.Header) (*SpolicySetResp, error)
+	//get one specific group's approval policy
+	SpolicyGet(context.Context, *SpolicyGetReq, http.Header) (*SpolicyGetResp, error)
+	//approve one specific app's draft,the caller must be one of the group's reviewers and not the draft's author
+	Sapprove(context.Context, *SapproveReq, http.Header) (*SapproveResp, error)
+	//reject one specific app's draft,the caller must be one of the group's reviewers and not the draft's author
+	Sreject(context.Context, *SrejectReq, http.Header) (*SrejectResp, error)
+	//get the drafts waiting for approval,newest first
+	Spending(context.Context, *SpendingReq, http.Header) (*SpendingResp, error)
+	//schedule one specific app's version to become current at the given time
+	//drafts still need enough approvals when the schedule fires
+	Sschedule(context.Context, *SscheduleReq, http.Header) (*SscheduleResp, error)
+	//get the pending schedules,sorted by fire_time asc
+	SscheduleList(context.Context, *SscheduleListReq, http.Header) (*SscheduleListResp, error)
+	//cancel one pending schedule
+	SscheduleCancel(context.Context, *SscheduleCancelReq, http.Header) (*SscheduleCancelResp, error)
+	//deliver one specific app's version to part of the instances,other instances still use the current version
+	//set again will replace the former canary
+	Scanary(context.Context, *ScanaryReq, http.Header) (*ScanaryResp, error)
+	//make the canary version current for all instances
+	ScanaryPromote(context.Context, *ScanaryPromoteReq, http.Header) (*ScanaryPromoteResp, error)
+	//stop the canary,all instances will use the current version
+	ScanaryAbort(context.Context, *ScanaryAbortReq, http.Header) (*ScanaryAbortResp, error)
+	//the sdk reports the version it applied
+	Sreport(context.Context, *SreportReq, http.Header) (*SreportResp, error)
+	//get the applied version of every instance
+	Srollout(context.Context, *SrolloutReq, http.Header) (*SrolloutResp, error)
+	//move one specific app or all apps in one group into the recycle bin
+	//the sdk will get empty config after this,the data will be purged after the retention
+	Sdelete(context.Context, *SdeleteReq, http.Header) (*SdeleteResp, error)
+	//move one specific app or all deleted apps in one group out of the recycle bin
+	Srestore(context.Context, *SrestoreReq, http.Header) (*SrestoreResp, error)
+	//remove one specific app or all deleted apps in one group from the recycle bin,this can't be undone
+	Spurge(context.Context, *SpurgeReq, http.Header) (*SpurgeResp, error)
+	//get the apps in the recycle bin
+	Srecycle(context.Context, *SrecycleReq, http.Header) (*SrecycleResp, error)
+	//tag one specific app's version,the tagged version will never be pruned
+	Stag(context.Context, *StagReq, http.Header) (*StagResp, error)
+	//set one specific group's history retention
+	//the current,canary,previous,draft,tagged and scheduled versions will never be pruned
+	SretentionSet(context.Context, *SretentionSetReq, http.Header) (*SretentionSetResp, error)
+	//get one specific group's history retention
+	SretentionGet(context.Context, *SretentionGetReq, http.Header) (*SretentionGetResp, error)
+	//create a principal or reset it's token,only the global admin can do this
+	//the token is only returned here,the server only keeps it's hash
+	SprincipalSet(context.Context, *SprincipalSetReq, http.Header) (*SprincipalSetResp, error)
+	//delete a principal and all it's grants,only the global admin can do this
+	SprincipalDel(context.Context, *SprincipalDelReq, http.Header) (*SprincipalDelResp, error)
+	//get all principals,only the global admin can do this
+	Sprincipals(context.Context, *SprincipalsReq, http.Header) (*SprincipalsResp, error)
+	//give a principal one role on the scope or revoke it,the caller must be the admin of the scope
+	Sgrant(context.Context, *SgrantReq, http.Header) (*SgrantResp, error)
+	//get the grants of one principal or one group
+	Sgrants(context.Context, *SgrantsReq, http.Header) (*SgrantsResp, error)
+	//issue a new read token for one specific app,the app's sdk can read and watch it's own config with it
+	//the token is only returned here,the server only keeps it's hash
+	StokenIssue(context.Context, *StokenIssueReq, http.Header) (*StokenIssueResp, error)
+	//issue a new read token to replace the old one,the old one will expire after the grace
+	StokenRotate(context.Context, *StokenRotateReq, http.Header) (*StokenRotateResp, error)
+	//revoke one read token at once
+	StokenRevoke(context.Context, *StokenRevokeReq, http.Header) (*StokenRevokeResp, error)
+	//get one specific app's read tokens,the tokens themselves are not returned
+	Stokens(context.Context, *StokensReq, http.Header) (*StokensResp, error)
+	//set one specific group's base config,every app in the group inherits it
+	//the merged config of every app in the group will be checked,the watchers will get the new merged config
+	SbaseSet(context.Context, *SbaseSetReq, http.Header) (*SbaseSetResp, error)
+	//get one specific group's base config
+	SbaseGet(context.Context, *SbaseGetReq, http.Header) (*SbaseGetResp, error)
+	//get one specific app's effective config:the app's version merged into the group's current base config
+	Srender(context.Context, *SrenderReq, http.Header) (*SrenderResp, error)
 }
 
 type sconfigWebClient struct {
@@ -366,10 +856,14 @@ func (c *sconfigWebClient) Sapps(ctx context.Context, req *SappsReq, header http
 	}
 	return resp, nil
 }
-func (c *sconfigWebClient) Swatchaddr(ctx context.Context, req *SwatchaddrReq, header http.Header) (*SwatchaddrResp, error) {
+func (c *sconfigWebClient) Swatch(ctx context.Context, req *SwatchReq, header http.Header) (*SwatchResp, error) {
 	if req == nil {
 		return nil, error1.ErrReq
 	}
+	if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SwatchReq"](req); s != "" {
+		log.Error("[/config.sconfig/swatch]", s)
+		return nil, error1.ErrReq
+	}
 	if header == nil {
 		header = make(http.Header)
 	}
@@ -377,7 +871,42 @@ func (c *sconfigWebClient) Swatchaddr(ctx context.Context, req *SwatchaddrReq, h
 	header.Set("Accept", "application/x-protobuf")
 	query := bufpool.GetBuffer()
 	defer bufpool.PutBuffer(query)
-	r, e := c.cc.Get(ctx, 250000000, _WebPathSconfigSwatchaddr+query.String(), header, metadata.GetAllMetadata(ctx))
+	query.Append("?")
+	if len(req.Groupname) != 0 {
+		query.Append("groupname=")
+		temp, _ := json.Marshal(req.Groupname)
+		query.Append(common.Byte2str(temp))
+		query.Append("&")
+	}
+	if len(req.Appname) != 0 {
+		query.Append("appname=")
+		temp, _ := json.Marshal(req.Appname)
+		query.Append(common.Byte2str(temp))
+		query.Append("&")
+	}
+	if req.OpNum != 0 {
+		query.Append("op_num=")
+		query.Append(req.OpNum)
+		query.Append("&")
+	}
+	if len(req.Instance) != 0 {
+		query.Append("instance=")
+		temp, _ := json.Marshal(req.Instance)
+		query.Append(common.Byte2str(temp))
+		query.Append("&")
+	}
+	if len(req.Labels) != 0 {
+		query.Append("labels=")
+		temp, _ := json.Marshal(req.Labels)
+		query.Append(common.Byte2str(temp))
+		query.Append("&")
+	}
+	if req.BaseOpNum != 0 {
+		query.Append("base_op_num=")
+		query.Append(req.BaseOpNum)
+		query.Append("&")
+	}
+	r, e := c.cc.Get(ctx, 30000000000, _WebPathSconfigSwatch+query.String(), header, metadata.GetAllMetadata(ctx))
 	if e != nil {
 		return nil, error1.StdErrorToError(e)
 	}
@@ -389,36 +918,5739 @@ func (c *sconfigWebClient) Swatchaddr(ctx context.Context, req *SwatchaddrReq, h
 	if r.StatusCode/100 == 4 || r.StatusCode/100 == 5 {
 		return nil, error1.ErrorstrToError(common.Byte2str(data))
 	}
-	resp := new(SwatchaddrResp)
+	resp := new(SwatchResp)
 	if len(data) == 0 {
 		return resp, nil
 	}
 	if e := proto.Unmarshal(data, resp); e != nil {
 		return nil, error1.ErrResp
 	}
-	return resp, nil
+	return resp, nil
+}
+func (c *sconfigWebClient) Shistory(ctx context.Context, req *ShistoryReq, header http.Header) (*ShistoryResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".ShistoryReq"](req); s != "" {
+		log.Error("[/config.sconfig/shistory]", s)
+		return nil, error1.ErrReq
+	}
+	if header == nil {
+		header = make(http.Header)
+	}
+	header.Set("Content-Type", "application/x-www-form-urlencoded")
+	header.Set("Accept", "application/x-protobuf")
+	query := bufpool.GetBuffer()
+	defer bufpool.PutBuffer(query)
+	query.Append("?")
+	if len(req.Groupname) != 0 {
+		query.Append("groupname=")
+		temp, _ := json.Marshal(req.Groupname)
+		query.Append(common.Byte2str(temp))
+		query.Append("&")
+	}
+	if len(req.Appname) != 0 {
+		query.Append("appname=")
+		temp, _ := json.Marshal(req.Appname)
+		query.Append(common.Byte2str(temp))
+		query.Append("&")
+	}
+	if req.Page != 0 {
+		query.Append("page=")
+		query.Append(req.Page)
+		query.Append("&")
+	}
+	if req.Size != 0 {
+		query.Append("size=")
+		query.Append(req.Size)
+		query.Append("&")
+	}
+	r, e := c.cc.Get(ctx, 250000000, _WebPathSconfigShistory+query.String(), header, metadata.GetAllMetadata(ctx))
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	defer r.Body.Close()
+	data, e := io.ReadAll(r.Body)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	if r.StatusCode/100 == 4 || r.StatusCode/100 == 5 {
+		return nil, error1.ErrorstrToError(common.Byte2str(data))
+	}
+	resp := new(ShistoryResp)
+	if len(data) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(data, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigWebClient) Saudit(ctx context.Context, req *SauditReq, header http.Header) (*SauditResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SauditReq"](req); s != "" {
+		log.Error("[/config.sconfig/saudit]", s)
+		return nil, error1.ErrReq
+	}
+	if header == nil {
+		header = make(http.Header)
+	}
+	header.Set("Content-Type", "application/x-www-form-urlencoded")
+	header.Set("Accept", "application/x-protobuf")
+	query := bufpool.GetBuffer()
+	defer bufpool.PutBuffer(query)
+	query.Append("?")
+	if len(req.Groupname) != 0 {
+		query.Append("groupname=")
+		temp, _ := json.Marshal(req.Groupname)
+		query.Append(common.Byte2str(temp))
+		query.Append("&")
+	}
+	if len(req.Appname) != 0 {
+		query.Append("appname=")
+		temp, _ := json.Marshal(req.Appname)
+		query.Append(common.Byte2str(temp))
+		query.Append("&")
+	}
+	if req.BeginTime != 0 {
+		query.Append("begin_time=")
+		query.Append(req.BeginTime)
+		query.Append("&")
+	}
+	if req.EndTime != 0 {
+		query.Append("end_time=")
+		query.Append(req.EndTime)
+		query.Append("&")
+	}
+	if req.Page != 0 {
+		query.Append("page=")
+		query.Append(req.Page)
+		query.Append("&")
+	}
+	if req.Size != 0 {
+		query.Append("size=")
+		query.Append(req.Size)
+		query.Append("&")
+	}
+	r, e := c.cc.Get(ctx, 250000000, _WebPathSconfigSaudit+query.String(), header, metadata.GetAllMetadata(ctx))
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	defer r.Body.Close()
+	data, e := io.ReadAll(r.Body)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	if r.StatusCode/100 == 4 || r.StatusCode/100 == 5 {
+		return nil, error1.ErrorstrToError(common.Byte2str(data))
+	}
+	resp := new(SauditResp)
+	if len(data) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(data, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigWebClient) Spatch(ctx context.Context, req *SpatchReq, header http.Header) (*SpatchResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SpatchReq"](req); s != "" {
+		log.Error("[/config.sconfig/spatch]", s)
+		return nil, error1.ErrReq
+	}
+	if header == nil {
+		header = make(http.Header)
+	}
+	header.Set("Content-Type", "application/x-protobuf")
+	header.Set("Accept", "application/x-protobuf")
+	reqd, _ := proto.Marshal(req)
+	r, e := c.cc.Post(ctx, 250000000, _WebPathSconfigSpatch, header, metadata.GetAllMetadata(ctx), reqd)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	defer r.Body.Close()
+	data, e := io.ReadAll(r.Body)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	if r.StatusCode/100 == 4 || r.StatusCode/100 == 5 {
+		return nil, error1.ErrorstrToError(common.Byte2str(data))
+	}
+	resp := new(SpatchResp)
+	if len(data) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(data, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigWebClient) SschemaSet(ctx context.Context, req *SschemaSetReq, header http.Header) (*SschemaSetResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SschemaSetReq"](req); s != "" {
+		log.Error("[/config.sconfig/sschema_set]", s)
+		return nil, error1.ErrReq
+	}
+	if header == nil {
+		header = make(http.Header)
+	}
+	header.Set("Content-Type", "application/x-protobuf")
+	header.Set("Accept", "application/x-protobuf")
+	reqd, _ := proto.Marshal(req)
+	r, e := c.cc.Post(ctx, 250000000, _WebPathSconfigSschemaSet, header, metadata.GetAllMetadata(ctx), reqd)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	defer r.Body.Close()
+	data, e := io.ReadAll(r.Body)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	if r.StatusCode/100 == 4 || r.StatusCode/100 == 5 {
+		return nil, error1.ErrorstrToError(common.Byte2str(data))
+	}
+	resp := new(SschemaSetResp)
+	if len(data) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(data, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigWebClient) SschemaGet(ctx context.Context, req *SschemaGetReq, header http.Header) (*SschemaGetResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SschemaGetReq"](req); s != "" {
+		log.Error("[/config.sconfig/sschema_get]", s)
+		return nil, error1.ErrReq
+	}
+	if header == nil {
+		header = make(http.Header)
+	}
+	header.Set("Content-Type", "application/x-www-form-urlencoded")
+	header.Set("Accept", "application/x-protobuf")
+	query := bufpool.GetBuffer()
+	defer bufpool.PutBuffer(query)
+	query.Append("?")
+	if len(req.Groupname) != 0 {
+		query.Append("groupname=")
+		temp, _ := json.Marshal(req.Groupname)
+		query.Append(common.Byte2str(temp))
+		query.Append("&")
+	}
+	if len(req.Appname) != 0 {
+		query.Append("appname=")
+		temp, _ := json.Marshal(req.Appname)
+		query.Append(common.Byte2str(temp))
+		query.Append("&")
+	}
+	if req.Index != 0 {
+		query.Append("index=")
+		query.Append(req.Index)
+		query.Append("&")
+	}
+	r, e := c.cc.Get(ctx, 250000000, _WebPathSconfigSschemaGet+query.String(), header, metadata.GetAllMetadata(ctx))
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	defer r.Body.Close()
+	data, e := io.ReadAll(r.Body)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	if r.StatusCode/100 == 4 || r.StatusCode/100 == 5 {
+		return nil, error1.ErrorstrToError(common.Byte2str(data))
+	}
+	resp := new(SschemaGetResp)
+	if len(data) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(data, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigWebClient) Sdiff(ctx context.Context, req *SdiffReq, header http.Header) (*SdiffResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SdiffReq"](req); s != "" {
+		log.Error("[/config.sconfig/sdiff]", s)
+		return nil, error1.ErrReq
+	}
+	if header == nil {
+		header = make(http.Header)
+	}
+	header.Set("Content-Type", "application/x-www-form-urlencoded")
+	header.Set("Accept", "application/x-protobuf")
+	query := bufpool.GetBuffer()
+	defer bufpool.PutBuffer(query)
+	query.Append("?")
+	if len(req.Groupname) != 0 {
+		query.Append("groupname=")
+		temp, _ := json.Marshal(req.Groupname)
+		query.Append(common.Byte2str(temp))
+		query.Append("&")
+	}
+	if len(req.Appname) != 0 {
+		query.Append("appname=")
+		temp, _ := json.Marshal(req.Appname)
+		query.Append(common.Byte2str(temp))
+		query.Append("&")
+	}
+	if req.FromIndex != 0 {
+		query.Append("from_index=")
+		query.Append(req.FromIndex)
+		query.Append("&")
+	}
+	if req.ToIndex != 0 {
+		query.Append("to_index=")
+		query.Append(req.ToIndex)
+		query.Append("&")
+	}
+	r, e := c.cc.Get(ctx, 250000000, _WebPathSconfigSdiff+query.String(), header, metadata.GetAllMetadata(ctx))
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	defer r.Body.Close()
+	data, e := io.ReadAll(r.Body)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	if r.StatusCode/100 == 4 || r.StatusCode/100 == 5 {
+		return nil, error1.ErrorstrToError(common.Byte2str(data))
+	}
+	resp := new(SdiffResp)
+	if len(data) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(data, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigWebClient) Spublish(ctx context.Context, req *SpublishReq, header http.Header) (*SpublishResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SpublishReq"](req); s != "" {
+		log.Error("[/config.sconfig/spublish]", s)
+		return nil, error1.ErrReq
+	}
+	if header == nil {
+		header = make(http.Header)
+	}
+	header.Set("Content-Type", "application/x-protobuf")
+	header.Set("Accept", "application/x-protobuf")
+	reqd, _ := proto.Marshal(req)
+	r, e := c.cc.Post(ctx, 250000000, _WebPathSconfigSpublish, header, metadata.GetAllMetadata(ctx), reqd)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	defer r.Body.Close()
+	data, e := io.ReadAll(r.Body)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	if r.StatusCode/100 == 4 || r.StatusCode/100 == 5 {
+		return nil, error1.ErrorstrToError(common.Byte2str(data))
+	}
+	resp := new(SpublishResp)
+	if len(data) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(data, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigWebClient) SpolicySet(ctx context.Context, req *SpolicySetReq, header http.Header) (*SpolicySetResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SpolicySetReq"](req); s != "" {
+		log.Error("[/config.sconfig/spolicy_set]", s)
+		return nil, error1.ErrReq
+	}
+	if header == nil {
+		header = make(http.Header)
+	}
+	header.Set("Content-Type", "application/x-protobuf")
+	header.Set("Accept", "application/x-protobuf")
+	reqd, _ := proto.Marshal(req)
+	r, e := c.cc.Post(ctx, 250000000, _WebPathSconfigSpolicySet, header, metadata.GetAllMetadata(ctx), reqd)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	defer r.Body.Close()
+	data, e := io.ReadAll(r.Body)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	if r.StatusCode/100 == 4 || r.StatusCode/100 == 5 {
+		return nil, error1.ErrorstrToError(common.Byte2str(data))
+	}
+	resp := new(SpolicySetResp)
+	if len(data) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(data, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigWebClient) SpolicyGet(ctx context.Context, req *SpolicyGetReq, header http.Header) (*SpolicyGetResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SpolicyGetReq"](req); s != "" {
+		log.Error("[/config.sconfig/spolicy_get]", s)
+		return nil, error1.ErrReq
+	}
+	if header == nil {
+		header = make(http.Header)
+	}
+	header.Set("Content-Type", "application/x-www-form-urlencoded")
+	header.Set("Accept", "application/x-protobuf")
+	query := bufpool.GetBuffer()
+	defer bufpool.PutBuffer(query)
+	query.Append("?")
+	if len(req.Groupname) != 0 {
+		query.Append("groupname=")
+		temp, _ := json.Marshal(req.Groupname)
+		query.Append(common.Byte2str(temp))
+		query.Append("&")
+	}
+	r, e := c.cc.Get(ctx, 250000000, _WebPathSconfigSpolicyGet+query.String(), header, metadata.GetAllMetadata(ctx))
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	defer r.Body.Close()
+	data, e := io.ReadAll(r.Body)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	if r.StatusCode/100 == 4 || r.StatusCode/100 == 5 {
+		return nil, error1.ErrorstrToError(common.Byte2str(data))
+	}
+	resp := new(SpolicyGetResp)
+	if len(data) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(data, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigWebClient) Sapprove(ctx context.Context, req *SapproveReq, header http.Header) (*SapproveResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SapproveReq"](req); s != "" {
+		log.Error("[/config.sconfig/sapprove]", s)
+		return nil, error1.ErrReq
+	}
+	if header == nil {
+		header = make(http.Header)
+	}
+	header.Set("Content-Type", "application/x-protobuf")
+	header.Set("Accept", "application/x-protobuf")
+	reqd, _ := proto.Marshal(req)
+	r, e := c.cc.Post(ctx, 250000000, _WebPathSconfigSapprove, header, metadata.GetAllMetadata(ctx), reqd)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	defer r.Body.Close()
+	data, e := io.ReadAll(r.Body)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	if r.StatusCode/100 == 4 || r.StatusCode/100 == 5 {
+		return nil, error1.ErrorstrToError(common.Byte2str(data))
+	}
+	resp := new(SapproveResp)
+	if len(data) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(data, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigWebClient) Sreject(ctx context.Context, req *SrejectReq, header http.Header) (*SrejectResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SrejectReq"](req); s != "" {
+		log.Error("[/config.sconfig/sreject]", s)
+		return nil, error1.ErrReq
+	}
+	if header == nil {
+		header = make(http.Header)
+	}
+	header.Set("Content-Type", "application/x-protobuf")
+	header.Set("Accept", "application/x-protobuf")
+	reqd, _ := proto.Marshal(req)
+	r, e := c.cc.Post(ctx, 250000000, _WebPathSconfigSreject, header, metadata.GetAllMetadata(ctx), reqd)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	defer r.Body.Close()
+	data, e := io.ReadAll(r.Body)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	if r.StatusCode/100 == 4 || r.StatusCode/100 == 5 {
+		return nil, error1.ErrorstrToError(common.Byte2str(data))
+	}
+	resp := new(SrejectResp)
+	if len(data) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(data, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigWebClient) Spending(ctx context.Context, req *SpendingReq, header http.Header) (*SpendingResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SpendingReq"](req); s != "" {
+		log.Error("[/config.sconfig/spending]", s)
+		return nil, error1.ErrReq
+	}
+	if header == nil {
+		header = make(http.Header)
+	}
+	header.Set("Content-Type", "application/x-www-form-urlencoded")
+	header.Set("Accept", "application/x-protobuf")
+	query := bufpool.GetBuffer()
+	defer bufpool.PutBuffer(query)
+	query.Append("?")
+	if len(req.Groupname) != 0 {
+		query.Append("groupname=")
+		temp, _ := json.Marshal(req.Groupname)
+		query.Append(common.Byte2str(temp))
+		query.Append("&")
+	}
+	if len(req.Appname) != 0 {
+		query.Append("appname=")
+		temp, _ := json.Marshal(req.Appname)
+		query.Append(common.Byte2str(temp))
+		query.Append("&")
+	}
+	r, e := c.cc.Get(ctx, 500000000, _WebPathSconfigSpending+query.String(), header, metadata.GetAllMetadata(ctx))
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	defer r.Body.Close()
+	data, e := io.ReadAll(r.Body)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	if r.StatusCode/100 == 4 || r.StatusCode/100 == 5 {
+		return nil, error1.ErrorstrToError(common.Byte2str(data))
+	}
+	resp := new(SpendingResp)
+	if len(data) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(data, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigWebClient) Sschedule(ctx context.Context, req *SscheduleReq, header http.Header) (*SscheduleResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SscheduleReq"](req); s != "" {
+		log.Error("[/config.sconfig/sschedule]", s)
+		return nil, error1.ErrReq
+	}
+	if header == nil {
+		header = make(http.Header)
+	}
+	header.Set("Content-Type", "application/x-protobuf")
+	header.Set("Accept", "application/x-protobuf")
+	reqd, _ := proto.Marshal(req)
+	r, e := c.cc.Post(ctx, 250000000, _WebPathSconfigSschedule, header, metadata.GetAllMetadata(ctx), reqd)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	defer r.Body.Close()
+	data, e := io.ReadAll(r.Body)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	if r.StatusCode/100 == 4 || r.StatusCode/100 == 5 {
+		return nil, error1.ErrorstrToError(common.Byte2str(data))
+	}
+	resp := new(SscheduleResp)
+	if len(data) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(data, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigWebClient) SscheduleList(ctx context.Context, req *SscheduleListReq, header http.Header) (*SscheduleListResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SscheduleListReq"](req); s != "" {
+		log.Error("[/config.sconfig/sschedule_list]", s)
+		return nil, error1.ErrReq
+	}
+	if header == nil {
+		header = make(http.Header)
+	}
+	header.Set("Content-Type", "application/x-www-form-urlencoded")
+	header.Set("Accept", "application/x-protobuf")
+	query := bufpool.GetBuffer()
+	defer bufpool.PutBuffer(query)
+	query.Append("?")
+	if len(req.Groupname) != 0 {
+		query.Append("groupname=")
+		temp, _ := json.Marshal(req.Groupname)
+		query.Append(common.Byte2str(temp))
+		query.Append("&")
+	}
+	if len(req.Appname) != 0 {
+		query.Append("appname=")
+		temp, _ := json.Marshal(req.Appname)
+		query.Append(common.Byte2str(temp))
+		query.Append("&")
+	}
+	r, e := c.cc.Get(ctx, 250000000, _WebPathSconfigSscheduleList+query.String(), header, metadata.GetAllMetadata(ctx))
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	defer r.Body.Close()
+	data, e := io.ReadAll(r.Body)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	if r.StatusCode/100 == 4 || r.StatusCode/100 == 5 {
+		return nil, error1.ErrorstrToError(common.Byte2str(data))
+	}
+	resp := new(SscheduleListResp)
+	if len(data) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(data, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigWebClient) SscheduleCancel(ctx context.Context, req *SscheduleCancelReq, header http.Header) (*SscheduleCancelResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SscheduleCancelReq"](req); s != "" {
+		log.Error("[/config.sconfig/sschedule_cancel]", s)
+		return nil, error1.ErrReq
+	}
+	if header == nil {
+		header = make(http.Header)
+	}
+	header.Set("Content-Type", "application/x-protobuf")
+	header.Set("Accept", "application/x-protobuf")
+	reqd, _ := proto.Marshal(req)
+	r, e := c.cc.Post(ctx, 250000000, _WebPathSconfigSscheduleCancel, header, metadata.GetAllMetadata(ctx), reqd)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	defer r.Body.Close()
+	data, e := io.ReadAll(r.Body)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	if r.StatusCode/100 == 4 || r.StatusCode/100 == 5 {
+		return nil, error1.ErrorstrToError(common.Byte2str(data))
+	}
+	resp := new(SscheduleCancelResp)
+	if len(data) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(data, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigWebClient) Scanary(ctx context.Context, req *ScanaryReq, header http.Header) (*ScanaryResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".ScanaryReq"](req); s != "" {
+		log.Error("[/config.sconfig/scanary]", s)
+		return nil, error1.ErrReq
+	}
+	if header == nil {
+		header = make(http.Header)
+	}
+	header.Set("Content-Type", "application/x-protobuf")
+	header.Set("Accept", "application/x-protobuf")
+	reqd, _ := proto.Marshal(req)
+	r, e := c.cc.Post(ctx, 250000000, _WebPathSconfigScanary, header, metadata.GetAllMetadata(ctx), reqd)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	defer r.Body.Close()
+	data, e := io.ReadAll(r.Body)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	if r.StatusCode/100 == 4 || r.StatusCode/100 == 5 {
+		return nil, error1.ErrorstrToError(common.Byte2str(data))
+	}
+	resp := new(ScanaryResp)
+	if len(data) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(data, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigWebClient) ScanaryPromote(ctx context.Context, req *ScanaryPromoteReq, header http.Header) (*ScanaryPromoteResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".ScanaryPromoteReq"](req); s != "" {
+		log.Error("[/config.sconfig/scanary_promote]", s)
+		return nil, error1.ErrReq
+	}
+	if header == nil {
+		header = make(http.Header)
+	}
+	header.Set("Content-Type", "application/x-protobuf")
+	header.Set("Accept", "application/x-protobuf")
+	reqd, _ := proto.Marshal(req)
+	r, e := c.cc.Post(ctx, 250000000, _WebPathSconfigScanaryPromote, header, metadata.GetAllMetadata(ctx), reqd)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	defer r.Body.Close()
+	data, e := io.ReadAll(r.Body)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	if r.StatusCode/100 == 4 || r.StatusCode/100 == 5 {
+		return nil, error1.ErrorstrToError(common.Byte2str(data))
+	}
+	resp := new(ScanaryPromoteResp)
+	if len(data) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(data, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigWebClient) ScanaryAbort(ctx context.Context, req *ScanaryAbortReq, header http.Header) (*ScanaryAbortResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".ScanaryAbortReq"](req); s != "" {
+		log.Error("[/config.sconfig/scanary_abort]", s)
+		return nil, error1.ErrReq
+	}
+	if header == nil {
+		header = make(http.Header)
+	}
+	header.Set("Content-Type", "application/x-protobuf")
+	header.Set("Accept", "application/x-protobuf")
+	reqd, _ := proto.Marshal(req)
+	r, e := c.cc.Post(ctx, 250000000, _WebPathSconfigScanaryAbort, header, metadata.GetAllMetadata(ctx), reqd)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	defer r.Body.Close()
+	data, e := io.ReadAll(r.Body)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	if r.StatusCode/100 == 4 || r.StatusCode/100 == 5 {
+		return nil, error1.ErrorstrToError(common.Byte2str(data))
+	}
+	resp := new(ScanaryAbortResp)
+	if len(data) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(data, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigWebClient) Sreport(ctx context.Context, req *SreportReq, header http.Header) (*SreportResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SreportReq"](req); s != "" {
+		log.Error("[/config.sconfig/sreport]", s)
+		return nil, error1.ErrReq
+	}
+	if header == nil {
+		header = make(http.Header)
+	}
+	header.Set("Content-Type", "application/x-protobuf")
+	header.Set("Accept", "application/x-protobuf")
+	reqd, _ := proto.Marshal(req)
+	r, e := c.cc.Post(ctx, 250000000, _WebPathSconfigSreport, header, metadata.GetAllMetadata(ctx), reqd)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	defer r.Body.Close()
+	data, e := io.ReadAll(r.Body)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	if r.StatusCode/100 == 4 || r.StatusCode/100 == 5 {
+		return nil, error1.ErrorstrToError(common.Byte2str(data))
+	}
+	resp := new(SreportResp)
+	if len(data) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(data, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigWebClient) Srollout(ctx context.Context, req *SrolloutReq, header http.Header) (*SrolloutResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SrolloutReq"](req); s != "" {
+		log.Error("[/config.sconfig/srollout]", s)
+		return nil, error1.ErrReq
+	}
+	if header == nil {
+		header = make(http.Header)
+	}
+	header.Set("Content-Type", "application/x-www-form-urlencoded")
+	header.Set("Accept", "application/x-protobuf")
+	query := bufpool.GetBuffer()
+	defer bufpool.PutBuffer(query)
+	query.Append("?")
+	if len(req.Groupname) != 0 {
+		query.Append("groupname=")
+		temp, _ := json.Marshal(req.Groupname)
+		query.Append(common.Byte2str(temp))
+		query.Append("&")
+	}
+	if len(req.Appname) != 0 {
+		query.Append("appname=")
+		temp, _ := json.Marshal(req.Appname)
+		query.Append(common.Byte2str(temp))
+		query.Append("&")
+	}
+	r, e := c.cc.Get(ctx, 250000000, _WebPathSconfigSrollout+query.String(), header, metadata.GetAllMetadata(ctx))
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	defer r.Body.Close()
+	data, e := io.ReadAll(r.Body)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	if r.StatusCode/100 == 4 || r.StatusCode/100 == 5 {
+		return nil, error1.ErrorstrToError(common.Byte2str(data))
+	}
+	resp := new(SrolloutResp)
+	if len(data) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(data, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigWebClient) Sdelete(ctx context.Context, req *SdeleteReq, header http.Header) (*SdeleteResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SdeleteReq"](req); s != "" {
+		log.Error("[/config.sconfig/sdelete]", s)
+		return nil, error1.ErrReq
+	}
+	if header == nil {
+		header = make(http.Header)
+	}
+	header.Set("Content-Type", "application/x-protobuf")
+	header.Set("Accept", "application/x-protobuf")
+	reqd, _ := proto.Marshal(req)
+	r, e := c.cc.Post(ctx, 500000000, _WebPathSconfigSdelete, header, metadata.GetAllMetadata(ctx), reqd)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	defer r.Body.Close()
+	data, e := io.ReadAll(r.Body)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	if r.StatusCode/100 == 4 || r.StatusCode/100 == 5 {
+		return nil, error1.ErrorstrToError(common.Byte2str(data))
+	}
+	resp := new(SdeleteResp)
+	if len(data) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(data, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigWebClient) Srestore(ctx context.Context, req *SrestoreReq, header http.Header) (*SrestoreResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SrestoreReq"](req); s != "" {
+		log.Error("[/config.sconfig/srestore]", s)
+		return nil, error1.ErrReq
+	}
+	if header == nil {
+		header = make(http.Header)
+	}
+	header.Set("Content-Type", "application/x-protobuf")
+	header.Set("Accept", "application/x-protobuf")
+	reqd, _ := proto.Marshal(req)
+	r, e := c.cc.Post(ctx, 500000000, _WebPathSconfigSrestore, header, metadata.GetAllMetadata(ctx), reqd)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	defer r.Body.Close()
+	data, e := io.ReadAll(r.Body)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	if r.StatusCode/100 == 4 || r.StatusCode/100 == 5 {
+		return nil, error1.ErrorstrToError(common.Byte2str(data))
+	}
+	resp := new(SrestoreResp)
+	if len(data) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(data, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigWebClient) Spurge(ctx context.Context, req *SpurgeReq, header http.Header) (*SpurgeResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SpurgeReq"](req); s != "" {
+		log.Error("[/config.sconfig/spurge]", s)
+		return nil, error1.ErrReq
+	}
+	if header == nil {
+		header = make(http.Header)
+	}
+	header.Set("Content-Type", "application/x-protobuf")
+	header.Set("Accept", "application/x-protobuf")
+	reqd, _ := proto.Marshal(req)
+	r, e := c.cc.Post(ctx, 500000000, _WebPathSconfigSpurge, header, metadata.GetAllMetadata(ctx), reqd)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	defer r.Body.Close()
+	data, e := io.ReadAll(r.Body)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	if r.StatusCode/100 == 4 || r.StatusCode/100 == 5 {
+		return nil, error1.ErrorstrToError(common.Byte2str(data))
+	}
+	resp := new(SpurgeResp)
+	if len(data) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(data, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigWebClient) Srecycle(ctx context.Context, req *SrecycleReq, header http.Header) (*SrecycleResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if header == nil {
+		header = make(http.Header)
+	}
+	header.Set("Content-Type", "application/x-www-form-urlencoded")
+	header.Set("Accept", "application/x-protobuf")
+	query := bufpool.GetBuffer()
+	defer bufpool.PutBuffer(query)
+	query.Append("?")
+	if len(req.Groupname) != 0 {
+		query.Append("groupname=")
+		temp, _ := json.Marshal(req.Groupname)
+		query.Append(common.Byte2str(temp))
+		query.Append("&")
+	}
+	r, e := c.cc.Get(ctx, 500000000, _WebPathSconfigSrecycle+query.String(), header, metadata.GetAllMetadata(ctx))
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	defer r.Body.Close()
+	data, e := io.ReadAll(r.Body)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	if r.StatusCode/100 == 4 || r.StatusCode/100 == 5 {
+		return nil, error1.ErrorstrToError(common.Byte2str(data))
+	}
+	resp := new(SrecycleResp)
+	if len(data) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(data, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigWebClient) Stag(ctx context.Context, req *StagReq, header http.Header) (*StagResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".StagReq"](req); s != "" {
+		log.Error("[/config.sconfig/stag]", s)
+		return nil, error1.ErrReq
+	}
+	if header == nil {
+		header = make(http.Header)
+	}
+	header.Set("Content-Type", "application/x-protobuf")
+	header.Set("Accept", "application/x-protobuf")
+	reqd, _ := proto.Marshal(req)
+	r, e := c.cc.Post(ctx, 250000000, _WebPathSconfigStag, header, metadata.GetAllMetadata(ctx), reqd)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	defer r.Body.Close()
+	data, e := io.ReadAll(r.Body)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	if r.StatusCode/100 == 4 || r.StatusCode/100 == 5 {
+		return nil, error1.ErrorstrToError(common.Byte2str(data))
+	}
+	resp := new(StagResp)
+	if len(data) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(data, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigWebClient) SretentionSet(ctx context.Context, req *SretentionSetReq, header http.Header) (*SretentionSetResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SretentionSetReq"](req); s != "" {
+		log.Error("[/config.sconfig/sretention_set]", s)
+		return nil, error1.ErrReq
+	}
+	if header == nil {
+		header = make(http.Header)
+	}
+	header.Set("Content-Type", "application/x-protobuf")
+	header.Set("Accept", "application/x-protobuf")
+	reqd, _ := proto.Marshal(req)
+	r, e := c.cc.Post(ctx, 250000000, _WebPathSconfigSretentionSet, header, metadata.GetAllMetadata(ctx), reqd)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	defer r.Body.Close()
+	data, e := io.ReadAll(r.Body)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	if r.StatusCode/100 == 4 || r.StatusCode/100 == 5 {
+		return nil, error1.ErrorstrToError(common.Byte2str(data))
+	}
+	resp := new(SretentionSetResp)
+	if len(data) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(data, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigWebClient) SretentionGet(ctx context.Context, req *SretentionGetReq, header http.Header) (*SretentionGetResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SretentionGetReq"](req); s != "" {
+		log.Error("[/config.sconfig/sretention_get]", s)
+		return nil, error1.ErrReq
+	}
+	if header == nil {
+		header = make(http.Header)
+	}
+	header.Set("Content-Type", "application/x-www-form-urlencoded")
+	header.Set("Accept", "application/x-protobuf")
+	query := bufpool.GetBuffer()
+	defer bufpool.PutBuffer(query)
+	query.Append("?")
+	if len(req.Groupname) != 0 {
+		query.Append("groupname=")
+		temp, _ := json.Marshal(req.Groupname)
+		query.Append(common.Byte2str(temp))
+		query.Append("&")
+	}
+	r, e := c.cc.Get(ctx, 250000000, _WebPathSconfigSretentionGet+query.String(), header, metadata.GetAllMetadata(ctx))
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	defer r.Body.Close()
+	data, e := io.ReadAll(r.Body)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	if r.StatusCode/100 == 4 || r.StatusCode/100 == 5 {
+		return nil, error1.ErrorstrToError(common.Byte2str(data))
+	}
+	resp := new(SretentionGetResp)
+	if len(data) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(data, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigWebClient) SprincipalSet(ctx context.Context, req *SprincipalSetReq, header http.Header) (*SprincipalSetResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SprincipalSetReq"](req); s != "" {
+		log.Error("[/config.sconfig/sprincipal_set]", s)
+		return nil, error1.ErrReq
+	}
+	if header == nil {
+		header = make(http.Header)
+	}
+	header.Set("Content-Type", "application/x-protobuf")
+	header.Set("Accept", "application/x-protobuf")
+	reqd, _ := proto.Marshal(req)
+	r, e := c.cc.Post(ctx, 250000000, _WebPathSconfigSprincipalSet, header, metadata.GetAllMetadata(ctx), reqd)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	defer r.Body.Close()
+	data, e := io.ReadAll(r.Body)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	if r.StatusCode/100 == 4 || r.StatusCode/100 == 5 {
+		return nil, error1.ErrorstrToError(common.Byte2str(data))
+	}
+	resp := new(SprincipalSetResp)
+	if len(data) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(data, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigWebClient) SprincipalDel(ctx context.Context, req *SprincipalDelReq, header http.Header) (*SprincipalDelResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SprincipalDelReq"](req); s != "" {
+		log.Error("[/config.sconfig/sprincipal_del]", s)
+		return nil, error1.ErrReq
+	}
+	if header == nil {
+		header = make(http.Header)
+	}
+	header.Set("Content-Type", "application/x-protobuf")
+	header.Set("Accept", "application/x-protobuf")
+	reqd, _ := proto.Marshal(req)
+	r, e := c.cc.Post(ctx, 250000000, _WebPathSconfigSprincipalDel, header, metadata.GetAllMetadata(ctx), reqd)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	defer r.Body.Close()
+	data, e := io.ReadAll(r.Body)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	if r.StatusCode/100 == 4 || r.StatusCode/100 == 5 {
+		return nil, error1.ErrorstrToError(common.Byte2str(data))
+	}
+	resp := new(SprincipalDelResp)
+	if len(data) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(data, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigWebClient) Sprincipals(ctx context.Context, req *SprincipalsReq, header http.Header) (*SprincipalsResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if header == nil {
+		header = make(http.Header)
+	}
+	header.Set("Content-Type", "application/x-www-form-urlencoded")
+	header.Set("Accept", "application/x-protobuf")
+	query := bufpool.GetBuffer()
+	defer bufpool.PutBuffer(query)
+	r, e := c.cc.Get(ctx, 250000000, _WebPathSconfigSprincipals+query.String(), header, metadata.GetAllMetadata(ctx))
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	defer r.Body.Close()
+	data, e := io.ReadAll(r.Body)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	if r.StatusCode/100 == 4 || r.StatusCode/100 == 5 {
+		return nil, error1.ErrorstrToError(common.Byte2str(data))
+	}
+	resp := new(SprincipalsResp)
+	if len(data) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(data, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigWebClient) Sgrant(ctx context.Context, req *SgrantReq, header http.Header) (*SgrantResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SgrantReq"](req); s != "" {
+		log.Error("[/config.sconfig/sgrant]", s)
+		return nil, error1.ErrReq
+	}
+	if header == nil {
+		header = make(http.Header)
+	}
+	header.Set("Content-Type", "application/x-protobuf")
+	header.Set("Accept", "application/x-protobuf")
+	reqd, _ := proto.Marshal(req)
+	r, e := c.cc.Post(ctx, 250000000, _WebPathSconfigSgrant, header, metadata.GetAllMetadata(ctx), reqd)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	defer r.Body.Close()
+	data, e := io.ReadAll(r.Body)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	if r.StatusCode/100 == 4 || r.StatusCode/100 == 5 {
+		return nil, error1.ErrorstrToError(common.Byte2str(data))
+	}
+	resp := new(SgrantResp)
+	if len(data) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(data, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigWebClient) Sgrants(ctx context.Context, req *SgrantsReq, header http.Header) (*SgrantsResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if header == nil {
+		header = make(http.Header)
+	}
+	header.Set("Content-Type", "application/x-www-form-urlencoded")
+	header.Set("Accept", "application/x-protobuf")
+	query := bufpool.GetBuffer()
+	defer bufpool.PutBuffer(query)
+	query.Append("?")
+	if len(req.Principal) != 0 {
+		query.Append("principal=")
+		temp, _ := json.Marshal(req.Principal)
+		query.Append(common.Byte2str(temp))
+		query.Append("&")
+	}
+	if len(req.Groupname) != 0 {
+		query.Append("groupname=")
+		temp, _ := json.Marshal(req.Groupname)
+		query.Append(common.Byte2str(temp))
+		query.Append("&")
+	}
+	r, e := c.cc.Get(ctx, 250000000, _WebPathSconfigSgrants+query.String(), header, metadata.GetAllMetadata(ctx))
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	defer r.Body.Close()
+	data, e := io.ReadAll(r.Body)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	if r.StatusCode/100 == 4 || r.StatusCode/100 == 5 {
+		return nil, error1.ErrorstrToError(common.Byte2str(data))
+	}
+	resp := new(SgrantsResp)
+	if len(data) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(data, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigWebClient) StokenIssue(ctx context.Context, req *StokenIssueReq, header http.Header) (*StokenIssueResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".StokenIssueReq"](req); s != "" {
+		log.Error("[/config.sconfig/stoken_issue]", s)
+		return nil, error1.ErrReq
+	}
+	if header == nil {
+		header = make(http.Header)
+	}
+	header.Set("Content-Type", "application/x-protobuf")
+	header.Set("Accept", "application/x-protobuf")
+	reqd, _ := proto.Marshal(req)
+	r, e := c.cc.Post(ctx, 250000000, _WebPathSconfigStokenIssue, header, metadata.GetAllMetadata(ctx), reqd)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	defer r.Body.Close()
+	data, e := io.ReadAll(r.Body)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	if r.StatusCode/100 == 4 || r.StatusCode/100 == 5 {
+		return nil, error1.ErrorstrToError(common.Byte2str(data))
+	}
+	resp := new(StokenIssueResp)
+	if len(data) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(data, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigWebClient) StokenRotate(ctx context.Context, req *StokenRotateReq, header http.Header) (*StokenRotateResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".StokenRotateReq"](req); s != "" {
+		log.Error("[/config.sconfig/stoken_rotate]", s)
+		return nil, error1.ErrReq
+	}
+	if header == nil {
+		header = make(http.Header)
+	}
+	header.Set("Content-Type", "application/x-protobuf")
+	header.Set("Accept", "application/x-protobuf")
+	reqd, _ := proto.Marshal(req)
+	r, e := c.cc.Post(ctx, 250000000, _WebPathSconfigStokenRotate, header, metadata.GetAllMetadata(ctx), reqd)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	defer r.Body.Close()
+	data, e := io.ReadAll(r.Body)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	if r.StatusCode/100 == 4 || r.StatusCode/100 == 5 {
+		return nil, error1.ErrorstrToError(common.Byte2str(data))
+	}
+	resp := new(StokenRotateResp)
+	if len(data) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(data, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigWebClient) StokenRevoke(ctx context.Context, req *StokenRevokeReq, header http.Header) (*StokenRevokeResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".StokenRevokeReq"](req); s != "" {
+		log.Error("[/config.sconfig/stoken_revoke]", s)
+		return nil, error1.ErrReq
+	}
+	if header == nil {
+		header = make(http.Header)
+	}
+	header.Set("Content-Type", "application/x-protobuf")
+	header.Set("Accept", "application/x-protobuf")
+	reqd, _ := proto.Marshal(req)
+	r, e := c.cc.Post(ctx, 250000000, _WebPathSconfigStokenRevoke, header, metadata.GetAllMetadata(ctx), reqd)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	defer r.Body.Close()
+	data, e := io.ReadAll(r.Body)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	if r.StatusCode/100 == 4 || r.StatusCode/100 == 5 {
+		return nil, error1.ErrorstrToError(common.Byte2str(data))
+	}
+	resp := new(StokenRevokeResp)
+	if len(data) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(data, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigWebClient) Stokens(ctx context.Context, req *StokensReq, header http.Header) (*StokensResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".StokensReq"](req); s != "" {
+		log.Error("[/config.sconfig/stokens]", s)
+		return nil, error1.ErrReq
+	}
+	if header == nil {
+		header = make(http.Header)
+	}
+	header.Set("Content-Type", "application/x-www-form-urlencoded")
+	header.Set("Accept", "application/x-protobuf")
+	query := bufpool.GetBuffer()
+	defer bufpool.PutBuffer(query)
+	query.Append("?")
+	if len(req.Groupname) != 0 {
+		query.Append("groupname=")
+		temp, _ := json.Marshal(req.Groupname)
+		query.Append(common.Byte2str(temp))
+		query.Append("&")
+	}
+	if len(req.Appname) != 0 {
+		query.Append("appname=")
+		temp, _ := json.Marshal(req.Appname)
+		query.Append(common.Byte2str(temp))
+		query.Append("&")
+	}
+	r, e := c.cc.Get(ctx, 250000000, _WebPathSconfigStokens+query.String(), header, metadata.GetAllMetadata(ctx))
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	defer r.Body.Close()
+	data, e := io.ReadAll(r.Body)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	if r.StatusCode/100 == 4 || r.StatusCode/100 == 5 {
+		return nil, error1.ErrorstrToError(common.Byte2str(data))
+	}
+	resp := new(StokensResp)
+	if len(data) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(data, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigWebClient) SbaseSet(ctx context.Context, req *SbaseSetReq, header http.Header) (*SbaseSetResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SbaseSetReq"](req); s != "" {
+		log.Error("[/config.sconfig/sbase_set]", s)
+		return nil, error1.ErrReq
+	}
+	if header == nil {
+		header = make(http.Header)
+	}
+	header.Set("Content-Type", "application/x-protobuf")
+	header.Set("Accept", "application/x-protobuf")
+	reqd, _ := proto.Marshal(req)
+	r, e := c.cc.Post(ctx, 500000000, _WebPathSconfigSbaseSet, header, metadata.GetAllMetadata(ctx), reqd)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	defer r.Body.Close()
+	data, e := io.ReadAll(r.Body)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	if r.StatusCode/100 == 4 || r.StatusCode/100 == 5 {
+		return nil, error1.ErrorstrToError(common.Byte2str(data))
+	}
+	resp := new(SbaseSetResp)
+	if len(data) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(data, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigWebClient) SbaseGet(ctx context.Context, req *SbaseGetReq, header http.Header) (*SbaseGetResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SbaseGetReq"](req); s != "" {
+		log.Error("[/config.sconfig/sbase_get]", s)
+		return nil, error1.ErrReq
+	}
+	if header == nil {
+		header = make(http.Header)
+	}
+	header.Set("Content-Type", "application/x-www-form-urlencoded")
+	header.Set("Accept", "application/x-protobuf")
+	query := bufpool.GetBuffer()
+	defer bufpool.PutBuffer(query)
+	query.Append("?")
+	if len(req.Groupname) != 0 {
+		query.Append("groupname=")
+		temp, _ := json.Marshal(req.Groupname)
+		query.Append(common.Byte2str(temp))
+		query.Append("&")
+	}
+	r, e := c.cc.Get(ctx, 250000000, _WebPathSconfigSbaseGet+query.String(), header, metadata.GetAllMetadata(ctx))
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	defer r.Body.Close()
+	data, e := io.ReadAll(r.Body)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	if r.StatusCode/100 == 4 || r.StatusCode/100 == 5 {
+		return nil, error1.ErrorstrToError(common.Byte2str(data))
+	}
+	resp := new(SbaseGetResp)
+	if len(data) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(data, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+func (c *sconfigWebClient) Srender(ctx context.Context, req *SrenderReq, header http.Header) (*SrenderResp, error) {
+	if req == nil {
+		return nil, error1.ErrReq
+	}
+	if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SrenderReq"](req); s != "" {
+		log.Error("[/config.sconfig/srender]", s)
+		return nil, error1.ErrReq
+	}
+	if header == nil {
+		header = make(http.Header)
+	}
+	header.Set("Content-Type", "application/x-www-form-urlencoded")
+	header.Set("Accept", "application/x-protobuf")
+	query := bufpool.GetBuffer()
+	defer bufpool.PutBuffer(query)
+	query.Append("?")
+	if len(req.Groupname) != 0 {
+		query.Append("groupname=")
+		temp, _ := json.Marshal(req.Groupname)
+		query.Append(common.Byte2str(temp))
+		query.Append("&")
+	}
+	if len(req.Appname) != 0 {
+		query.Append("appname=")
+		temp, _ := json.Marshal(req.Appname)
+		query.Append(common.Byte2str(temp))
+		query.Append("&")
+	}
+	if req.Index != 0 {
+		query.Append("index=")
+		query.Append(req.Index)
+		query.Append("&")
+	}
+	r, e := c.cc.Get(ctx, 250000000, _WebPathSconfigSrender+query.String(), header, metadata.GetAllMetadata(ctx))
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	defer r.Body.Close()
+	data, e := io.ReadAll(r.Body)
+	if e != nil {
+		return nil, error1.StdErrorToError(e)
+	}
+	if r.StatusCode/100 == 4 || r.StatusCode/100 == 5 {
+		return nil, error1.ErrorstrToError(common.Byte2str(data))
+	}
+	resp := new(SrenderResp)
+	if len(data) == 0 {
+		return resp, nil
+	}
+	if e := proto.Unmarshal(data, resp); e != nil {
+		return nil, error1.ErrResp
+	}
+	return resp, nil
+}
+
+type SconfigWebServer interface {
+	//one specific app's current info
+	Sinfo(context.Context, *SinfoReq) (*SinfoResp, error)
+	//set one specific app's config
+	Sset(context.Context, *SsetReq) (*SsetResp, error)
+	//rollback one specific app's config
+	Srollback(context.Context, *SrollbackReq) (*SrollbackResp, error)
+	//get one specific app's config
+	Sget(context.Context, *SgetReq) (*SgetResp, error)
+	//get all groups
+	Sgroups(context.Context, *SgroupsReq) (*SgroupsResp, error)
+	//get all apps
+	Sapps(context.Context, *SappsReq) (*SappsResp, error)
+	//watch one specific app's config
+	//this is a long poll,it returns at once when the op_num in req is different from the current
+	//otherwise it returns when the config changed or the timeout is near
+	Swatch(context.Context, *SwatchReq) (*SwatchResp, error)
+	//get one specific app's versions,newest first
+	Shistory(context.Context, *ShistoryReq) (*ShistoryResp, error)
+	//get the op records,newest first
+	Saudit(context.Context, *SauditReq) (*SauditResp, error)
+	//patch one specific app's current config and create a new version
+	Spatch(context.Context, *SpatchReq) (*SpatchResp, error)
+	//set one specific app's json schema,this will create a new schema version
+	//the config will be checked with the newest schema when set,patch and rollback
+	SschemaSet(context.Context, *SschemaSetReq) (*SschemaSetResp, error)
+	//get one specific app's json schema
+	SschemaGet(context.Context, *SschemaGetReq) (*SschemaGetResp, error)
+	//diff one specific app's two config versions
+	Sdiff(context.Context, *SdiffReq) (*SdiffResp, error)
+	//make one specific app's version current,usually used to publish a draft
+	Spublish(context.Context, *SpublishReq) (*SpublishResp, error)
+	//set one specific group's approval policy
+	//when the policy is enabled,sset and spatch in this group will always save a draft
+	//and the draft can only be published after enough reviewers approved it
+	SpolicySet(context.Context, *SpolicySetReq) (*SpolicySetResp, error)
+	//get one specific group's approval policy
+	SpolicyGet(context.Context, *SpolicyGetReq) (*SpolicyGetResp, error)
+	//approve one specific app's draft,the caller must be one of the group's reviewers and not the draft's author
+	Sapprove(context.Context, *SapproveReq) (*SapproveResp, error)
+	//reject one specific app's draft,the caller must be one of the group's reviewers and not the draft's author
+	Sreject(context.Context, *SrejectReq) (*SrejectResp, error)
+	//get the drafts waiting for approval,newest first
+	Spending(context.Context, *SpendingReq) (*SpendingResp, error)
+	//schedule one specific app's version to become current at the given time
+	//drafts still need enough approvals when the schedule fires
+	Sschedule(context.Context, *SscheduleReq) (*SscheduleResp, error)
+	//get the pending schedules,sorted by fire_time asc
+	SscheduleList(context.Context, *SscheduleListReq) (*SscheduleListResp, error)
+	//cancel one pending schedule
+	SscheduleCancel(context.Context, *SscheduleCancelReq) (*SscheduleCancelResp, error)
+	//deliver one specific app's version to part of the instances,other instances still use the current version
+	//set again will replace the former canary
+	Scanary(context.Context, *ScanaryReq) (*ScanaryResp, error)
+	//make the canary version current for all instances
+	ScanaryPromote(context.Context, *ScanaryPromoteReq) (*ScanaryPromoteResp, error)
+	//stop the canary,all instances will use the current version
+	ScanaryAbort(context.Context, *ScanaryAbortReq) (*ScanaryAbortResp, error)
+	//the sdk reports the version it applied
+	Sreport(context.Context, *SreportReq) (*SreportResp, error)
+	//get the applied version of every instance
+	Srollout(context.Context, *SrolloutReq) (*SrolloutResp, error)
+	//move one specific app or all apps in one group into the recycle bin
+	//the sdk will get empty config after this,the data will be purged after the retention
+	Sdelete(context.Context, *SdeleteReq) (*SdeleteResp, error)
+	//move one specific app or all deleted apps in one group out of the recycle bin
+	Srestore(context.Context, *SrestoreReq) (*SrestoreResp, error)
+	//remove one specific app or all deleted apps in one group from the recycle bin,this can't be undone
+	Spurge(context.Context, *SpurgeReq) (*SpurgeResp, error)
+	//get the apps in the recycle bin
+	Srecycle(context.Context, *SrecycleReq) (*SrecycleResp, error)
+	//tag one specific app's version,the tagged version will never be pruned
+	Stag(context.Context, *StagReq) (*StagResp, error)
+	//set one specific group's history retention
+	//the current,canary,previous,draft,tagged and scheduled versions will never be pruned
+	SretentionSet(context.Context, *SretentionSetReq) (*SretentionSetResp, error)
+	//get one specific group's history retention
+	SretentionGet(context.Context, *SretentionGetReq) (*SretentionGetResp, error)
+	//create a principal or reset it's token,only the global admin can do this
+	//the token is only returned here,the server only keeps it's hash
+	SprincipalSet(context.Context, *SprincipalSetReq) (*SprincipalSetResp, error)
+	//delete a principal and all it's grants,only the global admin can do this
+	SprincipalDel(context.Context, *SprincipalDelReq) (*SprincipalDelResp, error)
+	//get all principals,only the global admin can do this
+	Sprincipals(context.Context, *SprincipalsReq) (*SprincipalsResp, error)
+	//give a principal one role on the scope or revoke it,the caller must be the admin of the scope
+	Sgrant(context.Context, *SgrantReq) (*SgrantResp, error)
+	//get the grants of one principal or one group
+	Sgrants(context.Context, *SgrantsReq) (*SgrantsResp, error)
+	//issue a new read token for one specific app,the app's sdk can read and watch it's own config with it
+	//the token is only returned here,the server only keeps it's hash
+	StokenIssue(context.Context, *StokenIssueReq) (*StokenIssueResp, error)
+	//issue a new read token to replace the old one,the old one will expire after the grace
+	StokenRotate(context.Context, *StokenRotateReq) (*StokenRotateResp, error)
+	//revoke one read token at once
+	StokenRevoke(context.Context, *StokenRevokeReq) (*StokenRevokeResp, error)
+	//get one specific app's read tokens,the tokens themselves are not returned
+	Stokens(context.Context, *StokensReq) (*StokensResp, error)
+	//set one specific group's base config,every app in the group inherits it
+	//the merged config of every app in the group will be checked,the watchers will get the new merged config
+	SbaseSet(context.Context, *SbaseSetReq) (*SbaseSetResp, error)
+	//get one specific group's base config
+	SbaseGet(context.Context, *SbaseGetReq) (*SbaseGetResp, error)
+	//get one specific app's effective config:the app's version merged into the group's current base config
+	Srender(context.Context, *SrenderReq) (*SrenderResp, error)
+}
+
+func _Sconfig_Sinfo_WebHandler(handler func(context.Context, *SinfoReq) (*SinfoResp, error)) web.OutsideHandler {
+	return func(ctx *web.Context) {
+		req := new(SinfoReq)
+		if strings.HasPrefix(ctx.GetContentType(), "application/json") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else if strings.HasPrefix(ctx.GetContentType(), "application/x-protobuf") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				if e := proto.Unmarshal(data, req); e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else {
+			if e := ctx.ParseForm(); e != nil {
+				ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+				return
+			}
+			data := bufpool.GetBuffer()
+			defer bufpool.PutBuffer(data)
+			data.Append("{")
+			data.Append("\"groupname\":")
+			if form := ctx.GetForm("groupname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"appname\":")
+			if form := ctx.GetForm("appname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append("}")
+			if data.Len() > 2 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data.Bytes(), req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		}
+		if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SinfoReq"](req); s != "" {
+			log.Error("[/config.sconfig/sinfo]", s)
+			ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+			return
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			if error1.Equal(e, error1.ErrReq) {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+			} else {
+				ctx.AbortString(http.StatusInternalServerError, e.Error())
+			}
+			return
+		}
+		if resp == nil {
+			resp = new(SinfoResp)
+		}
+		if strings.HasPrefix(ctx.GetAcceptType(), "application/x-protobuf") {
+			respd, _ := proto.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/x-protobuf")
+			ctx.Write(http.StatusOK, respd)
+		} else {
+			respd, _ := protojson.MarshalOptions{UseProtoNames: true, UseEnumNumbers: true, EmitUnpopulated: true}.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/json")
+			ctx.Write(http.StatusOK, respd)
+		}
+	}
+}
+func _Sconfig_Sset_WebHandler(handler func(context.Context, *SsetReq) (*SsetResp, error)) web.OutsideHandler {
+	return func(ctx *web.Context) {
+		req := new(SsetReq)
+		if strings.HasPrefix(ctx.GetContentType(), "application/json") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else if strings.HasPrefix(ctx.GetContentType(), "application/x-protobuf") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				if e := proto.Unmarshal(data, req); e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else {
+			if e := ctx.ParseForm(); e != nil {
+				ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+				return
+			}
+			data := bufpool.GetBuffer()
+			defer bufpool.PutBuffer(data)
+			data.Append("{")
+			data.Append("\"groupname\":")
+			if form := ctx.GetForm("groupname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"appname\":")
+			if form := ctx.GetForm("appname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"app_config\":")
+			if form := ctx.GetForm("app_config"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"source_config\":")
+			if form := ctx.GetForm("source_config"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"comment\":")
+			if form := ctx.GetForm("comment"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"expected_op_num\":")
+			if form := ctx.GetForm("expected_op_num"); len(form) == 0 {
+				data.Append("0")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"draft\":")
+			if form := ctx.GetForm("draft"); len(form) == 0 {
+				data.Append("false")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"guard\":")
+			if form := ctx.GetForm("guard"); len(form) == 0 {
+				data.Append("null")
+			} else {
+				data.Append(form)
+			}
+			data.Append("}")
+			if data.Len() > 2 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data.Bytes(), req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		}
+		if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SsetReq"](req); s != "" {
+			log.Error("[/config.sconfig/sset]", s)
+			ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+			return
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			if error1.Equal(e, error1.ErrReq) {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+			} else {
+				ctx.AbortString(http.StatusInternalServerError, e.Error())
+			}
+			return
+		}
+		if resp == nil {
+			resp = new(SsetResp)
+		}
+		if strings.HasPrefix(ctx.GetAcceptType(), "application/x-protobuf") {
+			respd, _ := proto.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/x-protobuf")
+			ctx.Write(http.StatusOK, respd)
+		} else {
+			respd, _ := protojson.MarshalOptions{UseProtoNames: true, UseEnumNumbers: true, EmitUnpopulated: true}.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/json")
+			ctx.Write(http.StatusOK, respd)
+		}
+	}
+}
+func _Sconfig_Srollback_WebHandler(handler func(context.Context, *SrollbackReq) (*SrollbackResp, error)) web.OutsideHandler {
+	return func(ctx *web.Context) {
+		req := new(SrollbackReq)
+		if strings.HasPrefix(ctx.GetContentType(), "application/json") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else if strings.HasPrefix(ctx.GetContentType(), "application/x-protobuf") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				if e := proto.Unmarshal(data, req); e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else {
+			if e := ctx.ParseForm(); e != nil {
+				ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+				return
+			}
+			data := bufpool.GetBuffer()
+			defer bufpool.PutBuffer(data)
+			data.Append("{")
+			data.Append("\"groupname\":")
+			if form := ctx.GetForm("groupname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"appname\":")
+			if form := ctx.GetForm("appname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"index\":")
+			if form := ctx.GetForm("index"); len(form) == 0 {
+				data.Append("0")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"expected_op_num\":")
+			if form := ctx.GetForm("expected_op_num"); len(form) == 0 {
+				data.Append("0")
+			} else {
+				data.Append(form)
+			}
+			data.Append("}")
+			if data.Len() > 2 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data.Bytes(), req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		}
+		if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SrollbackReq"](req); s != "" {
+			log.Error("[/config.sconfig/srollback]", s)
+			ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+			return
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			if error1.Equal(e, error1.ErrReq) {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+			} else {
+				ctx.AbortString(http.StatusInternalServerError, e.Error())
+			}
+			return
+		}
+		if resp == nil {
+			resp = new(SrollbackResp)
+		}
+		if strings.HasPrefix(ctx.GetAcceptType(), "application/x-protobuf") {
+			respd, _ := proto.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/x-protobuf")
+			ctx.Write(http.StatusOK, respd)
+		} else {
+			respd, _ := protojson.MarshalOptions{UseProtoNames: true, UseEnumNumbers: true, EmitUnpopulated: true}.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/json")
+			ctx.Write(http.StatusOK, respd)
+		}
+	}
+}
+func _Sconfig_Sget_WebHandler(handler func(context.Context, *SgetReq) (*SgetResp, error)) web.OutsideHandler {
+	return func(ctx *web.Context) {
+		req := new(SgetReq)
+		if strings.HasPrefix(ctx.GetContentType(), "application/json") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else if strings.HasPrefix(ctx.GetContentType(), "application/x-protobuf") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				if e := proto.Unmarshal(data, req); e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else {
+			if e := ctx.ParseForm(); e != nil {
+				ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+				return
+			}
+			data := bufpool.GetBuffer()
+			defer bufpool.PutBuffer(data)
+			data.Append("{")
+			data.Append("\"groupname\":")
+			if form := ctx.GetForm("groupname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"appname\":")
+			if form := ctx.GetForm("appname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"index\":")
+			if form := ctx.GetForm("index"); len(form) == 0 {
+				data.Append("0")
+			} else {
+				data.Append(form)
+			}
+			data.Append("}")
+			if data.Len() > 2 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data.Bytes(), req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		}
+		if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SgetReq"](req); s != "" {
+			log.Error("[/config.sconfig/sget]", s)
+			ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+			return
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			if error1.Equal(e, error1.ErrReq) {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+			} else {
+				ctx.AbortString(http.StatusInternalServerError, e.Error())
+			}
+			return
+		}
+		if resp == nil {
+			resp = new(SgetResp)
+		}
+		if strings.HasPrefix(ctx.GetAcceptType(), "application/x-protobuf") {
+			respd, _ := proto.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/x-protobuf")
+			ctx.Write(http.StatusOK, respd)
+		} else {
+			respd, _ := protojson.MarshalOptions{UseProtoNames: true, UseEnumNumbers: true, EmitUnpopulated: true}.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/json")
+			ctx.Write(http.StatusOK, respd)
+		}
+	}
+}
+func _Sconfig_Sgroups_WebHandler(handler func(context.Context, *SgroupsReq) (*SgroupsResp, error)) web.OutsideHandler {
+	return func(ctx *web.Context) {
+		req := new(SgroupsReq)
+		if strings.HasPrefix(ctx.GetContentType(), "application/json") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else if strings.HasPrefix(ctx.GetContentType(), "application/x-protobuf") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				if e := proto.Unmarshal(data, req); e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else {
+			if e := ctx.ParseForm(); e != nil {
+				ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+				return
+			}
+			data := bufpool.GetBuffer()
+			defer bufpool.PutBuffer(data)
+			data.Append("{")
+			data.Append("}")
+			if data.Len() > 2 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data.Bytes(), req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			if error1.Equal(e, error1.ErrReq) {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+			} else {
+				ctx.AbortString(http.StatusInternalServerError, e.Error())
+			}
+			return
+		}
+		if resp == nil {
+			resp = new(SgroupsResp)
+		}
+		if strings.HasPrefix(ctx.GetAcceptType(), "application/x-protobuf") {
+			respd, _ := proto.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/x-protobuf")
+			ctx.Write(http.StatusOK, respd)
+		} else {
+			respd, _ := protojson.MarshalOptions{UseProtoNames: true, UseEnumNumbers: true, EmitUnpopulated: true}.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/json")
+			ctx.Write(http.StatusOK, respd)
+		}
+	}
+}
+func _Sconfig_Sapps_WebHandler(handler func(context.Context, *SappsReq) (*SappsResp, error)) web.OutsideHandler {
+	return func(ctx *web.Context) {
+		req := new(SappsReq)
+		if strings.HasPrefix(ctx.GetContentType(), "application/json") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else if strings.HasPrefix(ctx.GetContentType(), "application/x-protobuf") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				if e := proto.Unmarshal(data, req); e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else {
+			if e := ctx.ParseForm(); e != nil {
+				ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+				return
+			}
+			data := bufpool.GetBuffer()
+			defer bufpool.PutBuffer(data)
+			data.Append("{")
+			data.Append("\"groupname\":")
+			if form := ctx.GetForm("groupname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append("}")
+			if data.Len() > 2 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data.Bytes(), req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		}
+		if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SappsReq"](req); s != "" {
+			log.Error("[/config.sconfig/sapps]", s)
+			ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+			return
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			if error1.Equal(e, error1.ErrReq) {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+			} else {
+				ctx.AbortString(http.StatusInternalServerError, e.Error())
+			}
+			return
+		}
+		if resp == nil {
+			resp = new(SappsResp)
+		}
+		if strings.HasPrefix(ctx.GetAcceptType(), "application/x-protobuf") {
+			respd, _ := proto.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/x-protobuf")
+			ctx.Write(http.StatusOK, respd)
+		} else {
+			respd, _ := protojson.MarshalOptions{UseProtoNames: true, UseEnumNumbers: true, EmitUnpopulated: true}.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/json")
+			ctx.Write(http.StatusOK, respd)
+		}
+	}
+}
+func _Sconfig_Swatch_WebHandler(handler func(context.Context, *SwatchReq) (*SwatchResp, error)) web.OutsideHandler {
+	return func(ctx *web.Context) {
+		req := new(SwatchReq)
+		if strings.HasPrefix(ctx.GetContentType(), "application/json") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else if strings.HasPrefix(ctx.GetContentType(), "application/x-protobuf") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				if e := proto.Unmarshal(data, req); e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else {
+			if e := ctx.ParseForm(); e != nil {
+				ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+				return
+			}
+			data := bufpool.GetBuffer()
+			defer bufpool.PutBuffer(data)
+			data.Append("{")
+			data.Append("\"groupname\":")
+			if form := ctx.GetForm("groupname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"appname\":")
+			if form := ctx.GetForm("appname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"op_num\":")
+			if form := ctx.GetForm("op_num"); len(form) == 0 {
+				data.Append("0")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"instance\":")
+			if form := ctx.GetForm("instance"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"labels\":")
+			if form := ctx.GetForm("labels"); len(form) == 0 {
+				data.Append("null")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"base_op_num\":")
+			if form := ctx.GetForm("base_op_num"); len(form) == 0 {
+				data.Append("0")
+			} else {
+				data.Append(form)
+			}
+			data.Append("}")
+			if data.Len() > 2 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data.Bytes(), req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		}
+		if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SwatchReq"](req); s != "" {
+			log.Error("[/config.sconfig/swatch]", s)
+			ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+			return
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			if error1.Equal(e, error1.ErrReq) {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+			} else {
+				ctx.AbortString(http.StatusInternalServerError, e.Error())
+			}
+			return
+		}
+		if resp == nil {
+			resp = new(SwatchResp)
+		}
+		if strings.HasPrefix(ctx.GetAcceptType(), "application/x-protobuf") {
+			respd, _ := proto.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/x-protobuf")
+			ctx.Write(http.StatusOK, respd)
+		} else {
+			respd, _ := protojson.MarshalOptions{UseProtoNames: true, UseEnumNumbers: true, EmitUnpopulated: true}.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/json")
+			ctx.Write(http.StatusOK, respd)
+		}
+	}
+}
+func _Sconfig_Shistory_WebHandler(handler func(context.Context, *ShistoryReq) (*ShistoryResp, error)) web.OutsideHandler {
+	return func(ctx *web.Context) {
+		req := new(ShistoryReq)
+		if strings.HasPrefix(ctx.GetContentType(), "application/json") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else if strings.HasPrefix(ctx.GetContentType(), "application/x-protobuf") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				if e := proto.Unmarshal(data, req); e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else {
+			if e := ctx.ParseForm(); e != nil {
+				ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+				return
+			}
+			data := bufpool.GetBuffer()
+			defer bufpool.PutBuffer(data)
+			data.Append("{")
+			data.Append("\"groupname\":")
+			if form := ctx.GetForm("groupname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"appname\":")
+			if form := ctx.GetForm("appname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"page\":")
+			if form := ctx.GetForm("page"); len(form) == 0 {
+				data.Append("0")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"size\":")
+			if form := ctx.GetForm("size"); len(form) == 0 {
+				data.Append("0")
+			} else {
+				data.Append(form)
+			}
+			data.Append("}")
+			if data.Len() > 2 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data.Bytes(), req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		}
+		if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".ShistoryReq"](req); s != "" {
+			log.Error("[/config.sconfig/shistory]", s)
+			ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+			return
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			if error1.Equal(e, error1.ErrReq) {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+			} else {
+				ctx.AbortString(http.StatusInternalServerError, e.Error())
+			}
+			return
+		}
+		if resp == nil {
+			resp = new(ShistoryResp)
+		}
+		if strings.HasPrefix(ctx.GetAcceptType(), "application/x-protobuf") {
+			respd, _ := proto.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/x-protobuf")
+			ctx.Write(http.StatusOK, respd)
+		} else {
+			respd, _ := protojson.MarshalOptions{UseProtoNames: true, UseEnumNumbers: true, EmitUnpopulated: true}.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/json")
+			ctx.Write(http.StatusOK, respd)
+		}
+	}
+}
+func _Sconfig_Saudit_WebHandler(handler func(context.Context, *SauditReq) (*SauditResp, error)) web.OutsideHandler {
+	return func(ctx *web.Context) {
+		req := new(SauditReq)
+		if strings.HasPrefix(ctx.GetContentType(), "application/json") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else if strings.HasPrefix(ctx.GetContentType(), "application/x-protobuf") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				if e := proto.Unmarshal(data, req); e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else {
+			if e := ctx.ParseForm(); e != nil {
+				ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+				return
+			}
+			data := bufpool.GetBuffer()
+			defer bufpool.PutBuffer(data)
+			data.Append("{")
+			data.Append("\"groupname\":")
+			if form := ctx.GetForm("groupname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"appname\":")
+			if form := ctx.GetForm("appname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"begin_time\":")
+			if form := ctx.GetForm("begin_time"); len(form) == 0 {
+				data.Append("0")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"end_time\":")
+			if form := ctx.GetForm("end_time"); len(form) == 0 {
+				data.Append("0")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"page\":")
+			if form := ctx.GetForm("page"); len(form) == 0 {
+				data.Append("0")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"size\":")
+			if form := ctx.GetForm("size"); len(form) == 0 {
+				data.Append("0")
+			} else {
+				data.Append(form)
+			}
+			data.Append("}")
+			if data.Len() > 2 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data.Bytes(), req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		}
+		if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SauditReq"](req); s != "" {
+			log.Error("[/config.sconfig/saudit]", s)
+			ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+			return
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			if error1.Equal(e, error1.ErrReq) {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+			} else {
+				ctx.AbortString(http.StatusInternalServerError, e.Error())
+			}
+			return
+		}
+		if resp == nil {
+			resp = new(SauditResp)
+		}
+		if strings.HasPrefix(ctx.GetAcceptType(), "application/x-protobuf") {
+			respd, _ := proto.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/x-protobuf")
+			ctx.Write(http.StatusOK, respd)
+		} else {
+			respd, _ := protojson.MarshalOptions{UseProtoNames: true, UseEnumNumbers: true, EmitUnpopulated: true}.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/json")
+			ctx.Write(http.StatusOK, respd)
+		}
+	}
+}
+func _Sconfig_Spatch_WebHandler(handler func(context.Context, *SpatchReq) (*SpatchResp, error)) web.OutsideHandler {
+	return func(ctx *web.Context) {
+		req := new(SpatchReq)
+		if strings.HasPrefix(ctx.GetContentType(), "application/json") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else if strings.HasPrefix(ctx.GetContentType(), "application/x-protobuf") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				if e := proto.Unmarshal(data, req); e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else {
+			if e := ctx.ParseForm(); e != nil {
+				ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+				return
+			}
+			data := bufpool.GetBuffer()
+			defer bufpool.PutBuffer(data)
+			data.Append("{")
+			data.Append("\"groupname\":")
+			if form := ctx.GetForm("groupname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"appname\":")
+			if form := ctx.GetForm("appname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"patch_type\":")
+			if form := ctx.GetForm("patch_type"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"app_config_patch\":")
+			if form := ctx.GetForm("app_config_patch"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"source_config_patch\":")
+			if form := ctx.GetForm("source_config_patch"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"comment\":")
+			if form := ctx.GetForm("comment"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"expected_op_num\":")
+			if form := ctx.GetForm("expected_op_num"); len(form) == 0 {
+				data.Append("0")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"draft\":")
+			if form := ctx.GetForm("draft"); len(form) == 0 {
+				data.Append("false")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"guard\":")
+			if form := ctx.GetForm("guard"); len(form) == 0 {
+				data.Append("null")
+			} else {
+				data.Append(form)
+			}
+			data.Append("}")
+			if data.Len() > 2 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data.Bytes(), req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		}
+		if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SpatchReq"](req); s != "" {
+			log.Error("[/config.sconfig/spatch]", s)
+			ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+			return
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			if error1.Equal(e, error1.ErrReq) {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+			} else {
+				ctx.AbortString(http.StatusInternalServerError, e.Error())
+			}
+			return
+		}
+		if resp == nil {
+			resp = new(SpatchResp)
+		}
+		if strings.HasPrefix(ctx.GetAcceptType(), "application/x-protobuf") {
+			respd, _ := proto.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/x-protobuf")
+			ctx.Write(http.StatusOK, respd)
+		} else {
+			respd, _ := protojson.MarshalOptions{UseProtoNames: true, UseEnumNumbers: true, EmitUnpopulated: true}.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/json")
+			ctx.Write(http.StatusOK, respd)
+		}
+	}
+}
+func _Sconfig_SschemaSet_WebHandler(handler func(context.Context, *SschemaSetReq) (*SschemaSetResp, error)) web.OutsideHandler {
+	return func(ctx *web.Context) {
+		req := new(SschemaSetReq)
+		if strings.HasPrefix(ctx.GetContentType(), "application/json") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else if strings.HasPrefix(ctx.GetContentType(), "application/x-protobuf") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				if e := proto.Unmarshal(data, req); e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else {
+			if e := ctx.ParseForm(); e != nil {
+				ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+				return
+			}
+			data := bufpool.GetBuffer()
+			defer bufpool.PutBuffer(data)
+			data.Append("{")
+			data.Append("\"groupname\":")
+			if form := ctx.GetForm("groupname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"appname\":")
+			if form := ctx.GetForm("appname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"app_schema\":")
+			if form := ctx.GetForm("app_schema"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"source_schema\":")
+			if form := ctx.GetForm("source_schema"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append("}")
+			if data.Len() > 2 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data.Bytes(), req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		}
+		if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SschemaSetReq"](req); s != "" {
+			log.Error("[/config.sconfig/sschema_set]", s)
+			ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+			return
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			if error1.Equal(e, error1.ErrReq) {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+			} else {
+				ctx.AbortString(http.StatusInternalServerError, e.Error())
+			}
+			return
+		}
+		if resp == nil {
+			resp = new(SschemaSetResp)
+		}
+		if strings.HasPrefix(ctx.GetAcceptType(), "application/x-protobuf") {
+			respd, _ := proto.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/x-protobuf")
+			ctx.Write(http.StatusOK, respd)
+		} else {
+			respd, _ := protojson.MarshalOptions{UseProtoNames: true, UseEnumNumbers: true, EmitUnpopulated: true}.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/json")
+			ctx.Write(http.StatusOK, respd)
+		}
+	}
+}
+func _Sconfig_SschemaGet_WebHandler(handler func(context.Context, *SschemaGetReq) (*SschemaGetResp, error)) web.OutsideHandler {
+	return func(ctx *web.Context) {
+		req := new(SschemaGetReq)
+		if strings.HasPrefix(ctx.GetContentType(), "application/json") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else if strings.HasPrefix(ctx.GetContentType(), "application/x-protobuf") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				if e := proto.Unmarshal(data, req); e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else {
+			if e := ctx.ParseForm(); e != nil {
+				ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+				return
+			}
+			data := bufpool.GetBuffer()
+			defer bufpool.PutBuffer(data)
+			data.Append("{")
+			data.Append("\"groupname\":")
+			if form := ctx.GetForm("groupname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"appname\":")
+			if form := ctx.GetForm("appname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"index\":")
+			if form := ctx.GetForm("index"); len(form) == 0 {
+				data.Append("0")
+			} else {
+				data.Append(form)
+			}
+			data.Append("}")
+			if data.Len() > 2 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data.Bytes(), req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		}
+		if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SschemaGetReq"](req); s != "" {
+			log.Error("[/config.sconfig/sschema_get]", s)
+			ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+			return
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			if error1.Equal(e, error1.ErrReq) {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+			} else {
+				ctx.AbortString(http.StatusInternalServerError, e.Error())
+			}
+			return
+		}
+		if resp == nil {
+			resp = new(SschemaGetResp)
+		}
+		if strings.HasPrefix(ctx.GetAcceptType(), "application/x-protobuf") {
+			respd, _ := proto.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/x-protobuf")
+			ctx.Write(http.StatusOK, respd)
+		} else {
+			respd, _ := protojson.MarshalOptions{UseProtoNames: true, UseEnumNumbers: true, EmitUnpopulated: true}.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/json")
+			ctx.Write(http.StatusOK, respd)
+		}
+	}
+}
+func _Sconfig_Sdiff_WebHandler(handler func(context.Context, *SdiffReq) (*SdiffResp, error)) web.OutsideHandler {
+	return func(ctx *web.Context) {
+		req := new(SdiffReq)
+		if strings.HasPrefix(ctx.GetContentType(), "application/json") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else if strings.HasPrefix(ctx.GetContentType(), "application/x-protobuf") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				if e := proto.Unmarshal(data, req); e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else {
+			if e := ctx.ParseForm(); e != nil {
+				ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+				return
+			}
+			data := bufpool.GetBuffer()
+			defer bufpool.PutBuffer(data)
+			data.Append("{")
+			data.Append("\"groupname\":")
+			if form := ctx.GetForm("groupname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"appname\":")
+			if form := ctx.GetForm("appname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"from_index\":")
+			if form := ctx.GetForm("from_index"); len(form) == 0 {
+				data.Append("0")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"to_index\":")
+			if form := ctx.GetForm("to_index"); len(form) == 0 {
+				data.Append("0")
+			} else {
+				data.Append(form)
+			}
+			data.Append("}")
+			if data.Len() > 2 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data.Bytes(), req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		}
+		if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SdiffReq"](req); s != "" {
+			log.Error("[/config.sconfig/sdiff]", s)
+			ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+			return
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			if error1.Equal(e, error1.ErrReq) {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+			} else {
+				ctx.AbortString(http.StatusInternalServerError, e.Error())
+			}
+			return
+		}
+		if resp == nil {
+			resp = new(SdiffResp)
+		}
+		if strings.HasPrefix(ctx.GetAcceptType(), "application/x-protobuf") {
+			respd, _ := proto.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/x-protobuf")
+			ctx.Write(http.StatusOK, respd)
+		} else {
+			respd, _ := protojson.MarshalOptions{UseProtoNames: true, UseEnumNumbers: true, EmitUnpopulated: true}.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/json")
+			ctx.Write(http.StatusOK, respd)
+		}
+	}
+}
+func _Sconfig_Spublish_WebHandler(handler func(context.Context, *SpublishReq) (*SpublishResp, error)) web.OutsideHandler {
+	return func(ctx *web.Context) {
+		req := new(SpublishReq)
+		if strings.HasPrefix(ctx.GetContentType(), "application/json") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else if strings.HasPrefix(ctx.GetContentType(), "application/x-protobuf") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				if e := proto.Unmarshal(data, req); e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else {
+			if e := ctx.ParseForm(); e != nil {
+				ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+				return
+			}
+			data := bufpool.GetBuffer()
+			defer bufpool.PutBuffer(data)
+			data.Append("{")
+			data.Append("\"groupname\":")
+			if form := ctx.GetForm("groupname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"appname\":")
+			if form := ctx.GetForm("appname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"index\":")
+			if form := ctx.GetForm("index"); len(form) == 0 {
+				data.Append("0")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"expected_op_num\":")
+			if form := ctx.GetForm("expected_op_num"); len(form) == 0 {
+				data.Append("0")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"guard\":")
+			if form := ctx.GetForm("guard"); len(form) == 0 {
+				data.Append("null")
+			} else {
+				data.Append(form)
+			}
+			data.Append("}")
+			if data.Len() > 2 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data.Bytes(), req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		}
+		if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SpublishReq"](req); s != "" {
+			log.Error("[/config.sconfig/spublish]", s)
+			ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+			return
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			if error1.Equal(e, error1.ErrReq) {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+			} else {
+				ctx.AbortString(http.StatusInternalServerError, e.Error())
+			}
+			return
+		}
+		if resp == nil {
+			resp = new(SpublishResp)
+		}
+		if strings.HasPrefix(ctx.GetAcceptType(), "application/x-protobuf") {
+			respd, _ := proto.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/x-protobuf")
+			ctx.Write(http.StatusOK, respd)
+		} else {
+			respd, _ := protojson.MarshalOptions{UseProtoNames: true, UseEnumNumbers: true, EmitUnpopulated: true}.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/json")
+			ctx.Write(http.StatusOK, respd)
+		}
+	}
+}
+func _Sconfig_SpolicySet_WebHandler(handler func(context.Context, *SpolicySetReq) (*SpolicySetResp, error)) web.OutsideHandler {
+	return func(ctx *web.Context) {
+		req := new(SpolicySetReq)
+		if strings.HasPrefix(ctx.GetContentType(), "application/json") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else if strings.HasPrefix(ctx.GetContentType(), "application/x-protobuf") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				if e := proto.Unmarshal(data, req); e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else {
+			if e := ctx.ParseForm(); e != nil {
+				ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+				return
+			}
+			data := bufpool.GetBuffer()
+			defer bufpool.PutBuffer(data)
+			data.Append("{")
+			data.Append("\"groupname\":")
+			if form := ctx.GetForm("groupname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"reviewers\":")
+			if form := ctx.GetForm("reviewers"); len(form) == 0 {
+				data.Append("null")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"approvals\":")
+			if form := ctx.GetForm("approvals"); len(form) == 0 {
+				data.Append("0")
+			} else {
+				data.Append(form)
+			}
+			data.Append("}")
+			if data.Len() > 2 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data.Bytes(), req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		}
+		if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SpolicySetReq"](req); s != "" {
+			log.Error("[/config.sconfig/spolicy_set]", s)
+			ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+			return
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			if error1.Equal(e, error1.ErrReq) {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+			} else {
+				ctx.AbortString(http.StatusInternalServerError, e.Error())
+			}
+			return
+		}
+		if resp == nil {
+			resp = new(SpolicySetResp)
+		}
+		if strings.HasPrefix(ctx.GetAcceptType(), "application/x-protobuf") {
+			respd, _ := proto.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/x-protobuf")
+			ctx.Write(http.StatusOK, respd)
+		} else {
+			respd, _ := protojson.MarshalOptions{UseProtoNames: true, UseEnumNumbers: true, EmitUnpopulated: true}.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/json")
+			ctx.Write(http.StatusOK, respd)
+		}
+	}
+}
+func _Sconfig_SpolicyGet_WebHandler(handler func(context.Context, *SpolicyGetReq) (*SpolicyGetResp, error)) web.OutsideHandler {
+	return func(ctx *web.Context) {
+		req := new(SpolicyGetReq)
+		if strings.HasPrefix(ctx.GetContentType(), "application/json") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else if strings.HasPrefix(ctx.GetContentType(), "application/x-protobuf") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				if e := proto.Unmarshal(data, req); e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else {
+			if e := ctx.ParseForm(); e != nil {
+				ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+				return
+			}
+			data := bufpool.GetBuffer()
+			defer bufpool.PutBuffer(data)
+			data.Append("{")
+			data.Append("\"groupname\":")
+			if form := ctx.GetForm("groupname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append("}")
+			if data.Len() > 2 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data.Bytes(), req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		}
+		if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SpolicyGetReq"](req); s != "" {
+			log.Error("[/config.sconfig/spolicy_get]", s)
+			ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+			return
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			if error1.Equal(e, error1.ErrReq) {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+			} else {
+				ctx.AbortString(http.StatusInternalServerError, e.Error())
+			}
+			return
+		}
+		if resp == nil {
+			resp = new(SpolicyGetResp)
+		}
+		if strings.HasPrefix(ctx.GetAcceptType(), "application/x-protobuf") {
+			respd, _ := proto.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/x-protobuf")
+			ctx.Write(http.StatusOK, respd)
+		} else {
+			respd, _ := protojson.MarshalOptions{UseProtoNames: true, UseEnumNumbers: true, EmitUnpopulated: true}.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/json")
+			ctx.Write(http.StatusOK, respd)
+		}
+	}
+}
+func _Sconfig_Sapprove_WebHandler(handler func(context.Context, *SapproveReq) (*SapproveResp, error)) web.OutsideHandler {
+	return func(ctx *web.Context) {
+		req := new(SapproveReq)
+		if strings.HasPrefix(ctx.GetContentType(), "application/json") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else if strings.HasPrefix(ctx.GetContentType(), "application/x-protobuf") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				if e := proto.Unmarshal(data, req); e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else {
+			if e := ctx.ParseForm(); e != nil {
+				ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+				return
+			}
+			data := bufpool.GetBuffer()
+			defer bufpool.PutBuffer(data)
+			data.Append("{")
+			data.Append("\"groupname\":")
+			if form := ctx.GetForm("groupname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"appname\":")
+			if form := ctx.GetForm("appname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"index\":")
+			if form := ctx.GetForm("index"); len(form) == 0 {
+				data.Append("0")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"comment\":")
+			if form := ctx.GetForm("comment"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append("}")
+			if data.Len() > 2 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data.Bytes(), req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		}
+		if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SapproveReq"](req); s != "" {
+			log.Error("[/config.sconfig/sapprove]", s)
+			ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+			return
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			if error1.Equal(e, error1.ErrReq) {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+			} else {
+				ctx.AbortString(http.StatusInternalServerError, e.Error())
+			}
+			return
+		}
+		if resp == nil {
+			resp = new(SapproveResp)
+		}
+		if strings.HasPrefix(ctx.GetAcceptType(), "application/x-protobuf") {
+			respd, _ := proto.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/x-protobuf")
+			ctx.Write(http.StatusOK, respd)
+		} else {
+			respd, _ := protojson.MarshalOptions{UseProtoNames: true, UseEnumNumbers: true, EmitUnpopulated: true}.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/json")
+			ctx.Write(http.StatusOK, respd)
+		}
+	}
+}
+func _Sconfig_Sreject_WebHandler(handler func(context.Context, *SrejectReq) (*SrejectResp, error)) web.OutsideHandler {
+	return func(ctx *web.Context) {
+		req := new(SrejectReq)
+		if strings.HasPrefix(ctx.GetContentType(), "application/json") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else if strings.HasPrefix(ctx.GetContentType(), "application/x-protobuf") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				if e := proto.Unmarshal(data, req); e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else {
+			if e := ctx.ParseForm(); e != nil {
+				ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+				return
+			}
+			data := bufpool.GetBuffer()
+			defer bufpool.PutBuffer(data)
+			data.Append("{")
+			data.Append("\"groupname\":")
+			if form := ctx.GetForm("groupname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"appname\":")
+			if form := ctx.GetForm("appname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"index\":")
+			if form := ctx.GetForm("index"); len(form) == 0 {
+				data.Append("0")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"comment\":")
+			if form := ctx.GetForm("comment"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append("}")
+			if data.Len() > 2 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data.Bytes(), req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		}
+		if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SrejectReq"](req); s != "" {
+			log.Error("[/config.sconfig/sreject]", s)
+			ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+			return
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			if error1.Equal(e, error1.ErrReq) {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+			} else {
+				ctx.AbortString(http.StatusInternalServerError, e.Error())
+			}
+			return
+		}
+		if resp == nil {
+			resp = new(SrejectResp)
+		}
+		if strings.HasPrefix(ctx.GetAcceptType(), "application/x-protobuf") {
+			respd, _ := proto.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/x-protobuf")
+			ctx.Write(http.StatusOK, respd)
+		} else {
+			respd, _ := protojson.MarshalOptions{UseProtoNames: true, UseEnumNumbers: true, EmitUnpopulated: true}.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/json")
+			ctx.Write(http.StatusOK, respd)
+		}
+	}
+}
+func _Sconfig_Spending_WebHandler(handler func(context.Context, *SpendingReq) (*SpendingResp, error)) web.OutsideHandler {
+	return func(ctx *web.Context) {
+		req := new(SpendingReq)
+		if strings.HasPrefix(ctx.GetContentType(), "application/json") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else if strings.HasPrefix(ctx.GetContentType(), "application/x-protobuf") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				if e := proto.Unmarshal(data, req); e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else {
+			if e := ctx.ParseForm(); e != nil {
+				ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+				return
+			}
+			data := bufpool.GetBuffer()
+			defer bufpool.PutBuffer(data)
+			data.Append("{")
+			data.Append("\"groupname\":")
+			if form := ctx.GetForm("groupname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"appname\":")
+			if form := ctx.GetForm("appname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append("}")
+			if data.Len() > 2 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data.Bytes(), req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		}
+		if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SpendingReq"](req); s != "" {
+			log.Error("[/config.sconfig/spending]", s)
+			ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+			return
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			if error1.Equal(e, error1.ErrReq) {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+			} else {
+				ctx.AbortString(http.StatusInternalServerError, e.Error())
+			}
+			return
+		}
+		if resp == nil {
+			resp = new(SpendingResp)
+		}
+		if strings.HasPrefix(ctx.GetAcceptType(), "application/x-protobuf") {
+			respd, _ := proto.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/x-protobuf")
+			ctx.Write(http.StatusOK, respd)
+		} else {
+			respd, _ := protojson.MarshalOptions{UseProtoNames: true, UseEnumNumbers: true, EmitUnpopulated: true}.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/json")
+			ctx.Write(http.StatusOK, respd)
+		}
+	}
+}
+func _Sconfig_Sschedule_WebHandler(handler func(context.Context, *SscheduleReq) (*SscheduleResp, error)) web.OutsideHandler {
+	return func(ctx *web.Context) {
+		req := new(SscheduleReq)
+		if strings.HasPrefix(ctx.GetContentType(), "application/json") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else if strings.HasPrefix(ctx.GetContentType(), "application/x-protobuf") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				if e := proto.Unmarshal(data, req); e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else {
+			if e := ctx.ParseForm(); e != nil {
+				ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+				return
+			}
+			data := bufpool.GetBuffer()
+			defer bufpool.PutBuffer(data)
+			data.Append("{")
+			data.Append("\"groupname\":")
+			if form := ctx.GetForm("groupname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"appname\":")
+			if form := ctx.GetForm("appname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"index\":")
+			if form := ctx.GetForm("index"); len(form) == 0 {
+				data.Append("0")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"fire_time\":")
+			if form := ctx.GetForm("fire_time"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append("}")
+			if data.Len() > 2 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data.Bytes(), req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		}
+		if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SscheduleReq"](req); s != "" {
+			log.Error("[/config.sconfig/sschedule]", s)
+			ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+			return
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			if error1.Equal(e, error1.ErrReq) {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+			} else {
+				ctx.AbortString(http.StatusInternalServerError, e.Error())
+			}
+			return
+		}
+		if resp == nil {
+			resp = new(SscheduleResp)
+		}
+		if strings.HasPrefix(ctx.GetAcceptType(), "application/x-protobuf") {
+			respd, _ := proto.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/x-protobuf")
+			ctx.Write(http.StatusOK, respd)
+		} else {
+			respd, _ := protojson.MarshalOptions{UseProtoNames: true, UseEnumNumbers: true, EmitUnpopulated: true}.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/json")
+			ctx.Write(http.StatusOK, respd)
+		}
+	}
+}
+func _Sconfig_SscheduleList_WebHandler(handler func(context.Context, *SscheduleListReq) (*SscheduleListResp, error)) web.OutsideHandler {
+	return func(ctx *web.Context) {
+		req := new(SscheduleListReq)
+		if strings.HasPrefix(ctx.GetContentType(), "application/json") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else if strings.HasPrefix(ctx.GetContentType(), "application/x-protobuf") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				if e := proto.Unmarshal(data, req); e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else {
+			if e := ctx.ParseForm(); e != nil {
+				ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+				return
+			}
+			data := bufpool.GetBuffer()
+			defer bufpool.PutBuffer(data)
+			data.Append("{")
+			data.Append("\"groupname\":")
+			if form := ctx.GetForm("groupname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"appname\":")
+			if form := ctx.GetForm("appname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append("}")
+			if data.Len() > 2 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data.Bytes(), req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		}
+		if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SscheduleListReq"](req); s != "" {
+			log.Error("[/config.sconfig/sschedule_list]", s)
+			ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+			return
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			if error1.Equal(e, error1.ErrReq) {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+			} else {
+				ctx.AbortString(http.StatusInternalServerError, e.Error())
+			}
+			return
+		}
+		if resp == nil {
+			resp = new(SscheduleListResp)
+		}
+		if strings.HasPrefix(ctx.GetAcceptType(), "application/x-protobuf") {
+			respd, _ := proto.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/x-protobuf")
+			ctx.Write(http.StatusOK, respd)
+		} else {
+			respd, _ := protojson.MarshalOptions{UseProtoNames: true, UseEnumNumbers: true, EmitUnpopulated: true}.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/json")
+			ctx.Write(http.StatusOK, respd)
+		}
+	}
+}
+func _Sconfig_SscheduleCancel_WebHandler(handler func(context.Context, *SscheduleCancelReq) (*SscheduleCancelResp, error)) web.OutsideHandler {
+	return func(ctx *web.Context) {
+		req := new(SscheduleCancelReq)
+		if strings.HasPrefix(ctx.GetContentType(), "application/json") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else if strings.HasPrefix(ctx.GetContentType(), "application/x-protobuf") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				if e := proto.Unmarshal(data, req); e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else {
+			if e := ctx.ParseForm(); e != nil {
+				ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+				return
+			}
+			data := bufpool.GetBuffer()
+			defer bufpool.PutBuffer(data)
+			data.Append("{")
+			data.Append("\"groupname\":")
+			if form := ctx.GetForm("groupname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"appname\":")
+			if form := ctx.GetForm("appname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"id\":")
+			if form := ctx.GetForm("id"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append("}")
+			if data.Len() > 2 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data.Bytes(), req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		}
+		if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SscheduleCancelReq"](req); s != "" {
+			log.Error("[/config.sconfig/sschedule_cancel]", s)
+			ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+			return
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			if error1.Equal(e, error1.ErrReq) {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+			} else {
+				ctx.AbortString(http.StatusInternalServerError, e.Error())
+			}
+			return
+		}
+		if resp == nil {
+			resp = new(SscheduleCancelResp)
+		}
+		if strings.HasPrefix(ctx.GetAcceptType(), "application/x-protobuf") {
+			respd, _ := proto.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/x-protobuf")
+			ctx.Write(http.StatusOK, respd)
+		} else {
+			respd, _ := protojson.MarshalOptions{UseProtoNames: true, UseEnumNumbers: true, EmitUnpopulated: true}.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/json")
+			ctx.Write(http.StatusOK, respd)
+		}
+	}
+}
+func _Sconfig_Scanary_WebHandler(handler func(context.Context, *ScanaryReq) (*ScanaryResp, error)) web.OutsideHandler {
+	return func(ctx *web.Context) {
+		req := new(ScanaryReq)
+		if strings.HasPrefix(ctx.GetContentType(), "application/json") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else if strings.HasPrefix(ctx.GetContentType(), "application/x-protobuf") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				if e := proto.Unmarshal(data, req); e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else {
+			if e := ctx.ParseForm(); e != nil {
+				ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+				return
+			}
+			data := bufpool.GetBuffer()
+			defer bufpool.PutBuffer(data)
+			data.Append("{")
+			data.Append("\"groupname\":")
+			if form := ctx.GetForm("groupname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"appname\":")
+			if form := ctx.GetForm("appname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"index\":")
+			if form := ctx.GetForm("index"); len(form) == 0 {
+				data.Append("0")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"percent\":")
+			if form := ctx.GetForm("percent"); len(form) == 0 {
+				data.Append("0")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"hostnames\":")
+			if form := ctx.GetForm("hostnames"); len(form) == 0 {
+				data.Append("null")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"labels\":")
+			if form := ctx.GetForm("labels"); len(form) == 0 {
+				data.Append("null")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"expected_op_num\":")
+			if form := ctx.GetForm("expected_op_num"); len(form) == 0 {
+				data.Append("0")
+			} else {
+				data.Append(form)
+			}
+			data.Append("}")
+			if data.Len() > 2 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data.Bytes(), req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		}
+		if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".ScanaryReq"](req); s != "" {
+			log.Error("[/config.sconfig/scanary]", s)
+			ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+			return
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			if error1.Equal(e, error1.ErrReq) {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+			} else {
+				ctx.AbortString(http.StatusInternalServerError, e.Error())
+			}
+			return
+		}
+		if resp == nil {
+			resp = new(ScanaryResp)
+		}
+		if strings.HasPrefix(ctx.GetAcceptType(), "application/x-protobuf") {
+			respd, _ := proto.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/x-protobuf")
+			ctx.Write(http.StatusOK, respd)
+		} else {
+			respd, _ := protojson.MarshalOptions{UseProtoNames: true, UseEnumNumbers: true, EmitUnpopulated: true}.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/json")
+			ctx.Write(http.StatusOK, respd)
+		}
+	}
+}
+func _Sconfig_ScanaryPromote_WebHandler(handler func(context.Context, *ScanaryPromoteReq) (*ScanaryPromoteResp, error)) web.OutsideHandler {
+	return func(ctx *web.Context) {
+		req := new(ScanaryPromoteReq)
+		if strings.HasPrefix(ctx.GetContentType(), "application/json") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else if strings.HasPrefix(ctx.GetContentType(), "application/x-protobuf") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				if e := proto.Unmarshal(data, req); e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else {
+			if e := ctx.ParseForm(); e != nil {
+				ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+				return
+			}
+			data := bufpool.GetBuffer()
+			defer bufpool.PutBuffer(data)
+			data.Append("{")
+			data.Append("\"groupname\":")
+			if form := ctx.GetForm("groupname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"appname\":")
+			if form := ctx.GetForm("appname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"expected_op_num\":")
+			if form := ctx.GetForm("expected_op_num"); len(form) == 0 {
+				data.Append("0")
+			} else {
+				data.Append(form)
+			}
+			data.Append("}")
+			if data.Len() > 2 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data.Bytes(), req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		}
+		if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".ScanaryPromoteReq"](req); s != "" {
+			log.Error("[/config.sconfig/scanary_promote]", s)
+			ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+			return
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			if error1.Equal(e, error1.ErrReq) {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+			} else {
+				ctx.AbortString(http.StatusInternalServerError, e.Error())
+			}
+			return
+		}
+		if resp == nil {
+			resp = new(ScanaryPromoteResp)
+		}
+		if strings.HasPrefix(ctx.GetAcceptType(), "application/x-protobuf") {
+			respd, _ := proto.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/x-protobuf")
+			ctx.Write(http.StatusOK, respd)
+		} else {
+			respd, _ := protojson.MarshalOptions{UseProtoNames: true, UseEnumNumbers: true, EmitUnpopulated: true}.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/json")
+			ctx.Write(http.StatusOK, respd)
+		}
+	}
+}
+func _Sconfig_ScanaryAbort_WebHandler(handler func(context.Context, *ScanaryAbortReq) (*ScanaryAbortResp, error)) web.OutsideHandler {
+	return func(ctx *web.Context) {
+		req := new(ScanaryAbortReq)
+		if strings.HasPrefix(ctx.GetContentType(), "application/json") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else if strings.HasPrefix(ctx.GetContentType(), "application/x-protobuf") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				if e := proto.Unmarshal(data, req); e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else {
+			if e := ctx.ParseForm(); e != nil {
+				ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+				return
+			}
+			data := bufpool.GetBuffer()
+			defer bufpool.PutBuffer(data)
+			data.Append("{")
+			data.Append("\"groupname\":")
+			if form := ctx.GetForm("groupname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"appname\":")
+			if form := ctx.GetForm("appname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"expected_op_num\":")
+			if form := ctx.GetForm("expected_op_num"); len(form) == 0 {
+				data.Append("0")
+			} else {
+				data.Append(form)
+			}
+			data.Append("}")
+			if data.Len() > 2 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data.Bytes(), req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		}
+		if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".ScanaryAbortReq"](req); s != "" {
+			log.Error("[/config.sconfig/scanary_abort]", s)
+			ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+			return
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			if error1.Equal(e, error1.ErrReq) {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+			} else {
+				ctx.AbortString(http.StatusInternalServerError, e.Error())
+			}
+			return
+		}
+		if resp == nil {
+			resp = new(ScanaryAbortResp)
+		}
+		if strings.HasPrefix(ctx.GetAcceptType(), "application/x-protobuf") {
+			respd, _ := proto.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/x-protobuf")
+			ctx.Write(http.StatusOK, respd)
+		} else {
+			respd, _ := protojson.MarshalOptions{UseProtoNames: true, UseEnumNumbers: true, EmitUnpopulated: true}.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/json")
+			ctx.Write(http.StatusOK, respd)
+		}
+	}
+}
+func _Sconfig_Sreport_WebHandler(handler func(context.Context, *SreportReq) (*SreportResp, error)) web.OutsideHandler {
+	return func(ctx *web.Context) {
+		req := new(SreportReq)
+		if strings.HasPrefix(ctx.GetContentType(), "application/json") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else if strings.HasPrefix(ctx.GetContentType(), "application/x-protobuf") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				if e := proto.Unmarshal(data, req); e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else {
+			if e := ctx.ParseForm(); e != nil {
+				ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+				return
+			}
+			data := bufpool.GetBuffer()
+			defer bufpool.PutBuffer(data)
+			data.Append("{")
+			data.Append("\"groupname\":")
+			if form := ctx.GetForm("groupname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"appname\":")
+			if form := ctx.GetForm("appname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"instance\":")
+			if form := ctx.GetForm("instance"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"op_num\":")
+			if form := ctx.GetForm("op_num"); len(form) == 0 {
+				data.Append("0")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"index\":")
+			if form := ctx.GetForm("index"); len(form) == 0 {
+				data.Append("0")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"canary\":")
+			if form := ctx.GetForm("canary"); len(form) == 0 {
+				data.Append("false")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"error\":")
+			if form := ctx.GetForm("error"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"health\":")
+			if form := ctx.GetForm("health"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append("}")
+			if data.Len() > 2 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data.Bytes(), req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		}
+		if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SreportReq"](req); s != "" {
+			log.Error("[/config.sconfig/sreport]", s)
+			ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+			return
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			if error1.Equal(e, error1.ErrReq) {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+			} else {
+				ctx.AbortString(http.StatusInternalServerError, e.Error())
+			}
+			return
+		}
+		if resp == nil {
+			resp = new(SreportResp)
+		}
+		if strings.HasPrefix(ctx.GetAcceptType(), "application/x-protobuf") {
+			respd, _ := proto.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/x-protobuf")
+			ctx.Write(http.StatusOK, respd)
+		} else {
+			respd, _ := protojson.MarshalOptions{UseProtoNames: true, UseEnumNumbers: true, EmitUnpopulated: true}.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/json")
+			ctx.Write(http.StatusOK, respd)
+		}
+	}
+}
+func _Sconfig_Srollout_WebHandler(handler func(context.Context, *SrolloutReq) (*SrolloutResp, error)) web.OutsideHandler {
+	return func(ctx *web.Context) {
+		req := new(SrolloutReq)
+		if strings.HasPrefix(ctx.GetContentType(), "application/json") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else if strings.HasPrefix(ctx.GetContentType(), "application/x-protobuf") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				if e := proto.Unmarshal(data, req); e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else {
+			if e := ctx.ParseForm(); e != nil {
+				ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+				return
+			}
+			data := bufpool.GetBuffer()
+			defer bufpool.PutBuffer(data)
+			data.Append("{")
+			data.Append("\"groupname\":")
+			if form := ctx.GetForm("groupname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"appname\":")
+			if form := ctx.GetForm("appname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append("}")
+			if data.Len() > 2 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data.Bytes(), req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		}
+		if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SrolloutReq"](req); s != "" {
+			log.Error("[/config.sconfig/srollout]", s)
+			ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+			return
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			if error1.Equal(e, error1.ErrReq) {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+			} else {
+				ctx.AbortString(http.StatusInternalServerError, e.Error())
+			}
+			return
+		}
+		if resp == nil {
+			resp = new(SrolloutResp)
+		}
+		if strings.HasPrefix(ctx.GetAcceptType(), "application/x-protobuf") {
+			respd, _ := proto.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/x-protobuf")
+			ctx.Write(http.StatusOK, respd)
+		} else {
+			respd, _ := protojson.MarshalOptions{UseProtoNames: true, UseEnumNumbers: true, EmitUnpopulated: true}.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/json")
+			ctx.Write(http.StatusOK, respd)
+		}
+	}
+}
+func _Sconfig_Sdelete_WebHandler(handler func(context.Context, *SdeleteReq) (*SdeleteResp, error)) web.OutsideHandler {
+	return func(ctx *web.Context) {
+		req := new(SdeleteReq)
+		if strings.HasPrefix(ctx.GetContentType(), "application/json") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else if strings.HasPrefix(ctx.GetContentType(), "application/x-protobuf") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				if e := proto.Unmarshal(data, req); e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else {
+			if e := ctx.ParseForm(); e != nil {
+				ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+				return
+			}
+			data := bufpool.GetBuffer()
+			defer bufpool.PutBuffer(data)
+			data.Append("{")
+			data.Append("\"groupname\":")
+			if form := ctx.GetForm("groupname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"appname\":")
+			if form := ctx.GetForm("appname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append("}")
+			if data.Len() > 2 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data.Bytes(), req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		}
+		if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SdeleteReq"](req); s != "" {
+			log.Error("[/config.sconfig/sdelete]", s)
+			ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+			return
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			if error1.Equal(e, error1.ErrReq) {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+			} else {
+				ctx.AbortString(http.StatusInternalServerError, e.Error())
+			}
+			return
+		}
+		if resp == nil {
+			resp = new(SdeleteResp)
+		}
+		if strings.HasPrefix(ctx.GetAcceptType(), "application/x-protobuf") {
+			respd, _ := proto.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/x-protobuf")
+			ctx.Write(http.StatusOK, respd)
+		} else {
+			respd, _ := protojson.MarshalOptions{UseProtoNames: true, UseEnumNumbers: true, EmitUnpopulated: true}.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/json")
+			ctx.Write(http.StatusOK, respd)
+		}
+	}
+}
+func _Sconfig_Srestore_WebHandler(handler func(context.Context, *SrestoreReq) (*SrestoreResp, error)) web.OutsideHandler {
+	return func(ctx *web.Context) {
+		req := new(SrestoreReq)
+		if strings.HasPrefix(ctx.GetContentType(), "application/json") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else if strings.HasPrefix(ctx.GetContentType(), "application/x-protobuf") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				if e := proto.Unmarshal(data, req); e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else {
+			if e := ctx.ParseForm(); e != nil {
+				ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+				return
+			}
+			data := bufpool.GetBuffer()
+			defer bufpool.PutBuffer(data)
+			data.Append("{")
+			data.Append("\"groupname\":")
+			if form := ctx.GetForm("groupname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"appname\":")
+			if form := ctx.GetForm("appname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append("}")
+			if data.Len() > 2 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data.Bytes(), req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		}
+		if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SrestoreReq"](req); s != "" {
+			log.Error("[/config.sconfig/srestore]", s)
+			ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+			return
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			if error1.Equal(e, error1.ErrReq) {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+			} else {
+				ctx.AbortString(http.StatusInternalServerError, e.Error())
+			}
+			return
+		}
+		if resp == nil {
+			resp = new(SrestoreResp)
+		}
+		if strings.HasPrefix(ctx.GetAcceptType(), "application/x-protobuf") {
+			respd, _ := proto.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/x-protobuf")
+			ctx.Write(http.StatusOK, respd)
+		} else {
+			respd, _ := protojson.MarshalOptions{UseProtoNames: true, UseEnumNumbers: true, EmitUnpopulated: true}.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/json")
+			ctx.Write(http.StatusOK, respd)
+		}
+	}
+}
+func _Sconfig_Spurge_WebHandler(handler func(context.Context, *SpurgeReq) (*SpurgeResp, error)) web.OutsideHandler {
+	return func(ctx *web.Context) {
+		req := new(SpurgeReq)
+		if strings.HasPrefix(ctx.GetContentType(), "application/json") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else if strings.HasPrefix(ctx.GetContentType(), "application/x-protobuf") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				if e := proto.Unmarshal(data, req); e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else {
+			if e := ctx.ParseForm(); e != nil {
+				ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+				return
+			}
+			data := bufpool.GetBuffer()
+			defer bufpool.PutBuffer(data)
+			data.Append("{")
+			data.Append("\"groupname\":")
+			if form := ctx.GetForm("groupname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"appname\":")
+			if form := ctx.GetForm("appname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append("}")
+			if data.Len() > 2 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data.Bytes(), req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		}
+		if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SpurgeReq"](req); s != "" {
+			log.Error("[/config.sconfig/spurge]", s)
+			ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+			return
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			if error1.Equal(e, error1.ErrReq) {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+			} else {
+				ctx.AbortString(http.StatusInternalServerError, e.Error())
+			}
+			return
+		}
+		if resp == nil {
+			resp = new(SpurgeResp)
+		}
+		if strings.HasPrefix(ctx.GetAcceptType(), "application/x-protobuf") {
+			respd, _ := proto.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/x-protobuf")
+			ctx.Write(http.StatusOK, respd)
+		} else {
+			respd, _ := protojson.MarshalOptions{UseProtoNames: true, UseEnumNumbers: true, EmitUnpopulated: true}.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/json")
+			ctx.Write(http.StatusOK, respd)
+		}
+	}
+}
+func _Sconfig_Srecycle_WebHandler(handler func(context.Context, *SrecycleReq) (*SrecycleResp, error)) web.OutsideHandler {
+	return func(ctx *web.Context) {
+		req := new(SrecycleReq)
+		if strings.HasPrefix(ctx.GetContentType(), "application/json") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else if strings.HasPrefix(ctx.GetContentType(), "application/x-protobuf") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				if e := proto.Unmarshal(data, req); e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else {
+			if e := ctx.ParseForm(); e != nil {
+				ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+				return
+			}
+			data := bufpool.GetBuffer()
+			defer bufpool.PutBuffer(data)
+			data.Append("{")
+			data.Append("\"groupname\":")
+			if form := ctx.GetForm("groupname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append("}")
+			if data.Len() > 2 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data.Bytes(), req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			if error1.Equal(e, error1.ErrReq) {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+			} else {
+				ctx.AbortString(http.StatusInternalServerError, e.Error())
+			}
+			return
+		}
+		if resp == nil {
+			resp = new(SrecycleResp)
+		}
+		if strings.HasPrefix(ctx.GetAcceptType(), "application/x-protobuf") {
+			respd, _ := proto.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/x-protobuf")
+			ctx.Write(http.StatusOK, respd)
+		} else {
+			respd, _ := protojson.MarshalOptions{UseProtoNames: true, UseEnumNumbers: true, EmitUnpopulated: true}.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/json")
+			ctx.Write(http.StatusOK, respd)
+		}
+	}
+}
+func _Sconfig_Stag_WebHandler(handler func(context.Context, *StagReq) (*StagResp, error)) web.OutsideHandler {
+	return func(ctx *web.Context) {
+		req := new(StagReq)
+		if strings.HasPrefix(ctx.GetContentType(), "application/json") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else if strings.HasPrefix(ctx.GetContentType(), "application/x-protobuf") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				if e := proto.Unmarshal(data, req); e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else {
+			if e := ctx.ParseForm(); e != nil {
+				ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+				return
+			}
+			data := bufpool.GetBuffer()
+			defer bufpool.PutBuffer(data)
+			data.Append("{")
+			data.Append("\"groupname\":")
+			if form := ctx.GetForm("groupname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"appname\":")
+			if form := ctx.GetForm("appname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"index\":")
+			if form := ctx.GetForm("index"); len(form) == 0 {
+				data.Append("0")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"tag\":")
+			if form := ctx.GetForm("tag"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append("}")
+			if data.Len() > 2 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data.Bytes(), req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		}
+		if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".StagReq"](req); s != "" {
+			log.Error("[/config.sconfig/stag]", s)
+			ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+			return
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			if error1.Equal(e, error1.ErrReq) {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+			} else {
+				ctx.AbortString(http.StatusInternalServerError, e.Error())
+			}
+			return
+		}
+		if resp == nil {
+			resp = new(StagResp)
+		}
+		if strings.HasPrefix(ctx.GetAcceptType(), "application/x-protobuf") {
+			respd, _ := proto.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/x-protobuf")
+			ctx.Write(http.StatusOK, respd)
+		} else {
+			respd, _ := protojson.MarshalOptions{UseProtoNames: true, UseEnumNumbers: true, EmitUnpopulated: true}.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/json")
+			ctx.Write(http.StatusOK, respd)
+		}
+	}
+}
+func _Sconfig_SretentionSet_WebHandler(handler func(context.Context, *SretentionSetReq) (*SretentionSetResp, error)) web.OutsideHandler {
+	return func(ctx *web.Context) {
+		req := new(SretentionSetReq)
+		if strings.HasPrefix(ctx.GetContentType(), "application/json") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else if strings.HasPrefix(ctx.GetContentType(), "application/x-protobuf") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				if e := proto.Unmarshal(data, req); e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else {
+			if e := ctx.ParseForm(); e != nil {
+				ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+				return
+			}
+			data := bufpool.GetBuffer()
+			defer bufpool.PutBuffer(data)
+			data.Append("{")
+			data.Append("\"groupname\":")
+			if form := ctx.GetForm("groupname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"keep_num\":")
+			if form := ctx.GetForm("keep_num"); len(form) == 0 {
+				data.Append("0")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"keep_time\":")
+			if form := ctx.GetForm("keep_time"); len(form) == 0 {
+				data.Append("0")
+			} else {
+				data.Append(form)
+			}
+			data.Append("}")
+			if data.Len() > 2 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data.Bytes(), req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		}
+		if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SretentionSetReq"](req); s != "" {
+			log.Error("[/config.sconfig/sretention_set]", s)
+			ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+			return
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			if error1.Equal(e, error1.ErrReq) {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+			} else {
+				ctx.AbortString(http.StatusInternalServerError, e.Error())
+			}
+			return
+		}
+		if resp == nil {
+			resp = new(SretentionSetResp)
+		}
+		if strings.HasPrefix(ctx.GetAcceptType(), "application/x-protobuf") {
+			respd, _ := proto.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/x-protobuf")
+			ctx.Write(http.StatusOK, respd)
+		} else {
+			respd, _ := protojson.MarshalOptions{UseProtoNames: true, UseEnumNumbers: true, EmitUnpopulated: true}.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/json")
+			ctx.Write(http.StatusOK, respd)
+		}
+	}
+}
+func _Sconfig_SretentionGet_WebHandler(handler func(context.Context, *SretentionGetReq) (*SretentionGetResp, error)) web.OutsideHandler {
+	return func(ctx *web.Context) {
+		req := new(SretentionGetReq)
+		if strings.HasPrefix(ctx.GetContentType(), "application/json") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else if strings.HasPrefix(ctx.GetContentType(), "application/x-protobuf") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				if e := proto.Unmarshal(data, req); e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else {
+			if e := ctx.ParseForm(); e != nil {
+				ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+				return
+			}
+			data := bufpool.GetBuffer()
+			defer bufpool.PutBuffer(data)
+			data.Append("{")
+			data.Append("\"groupname\":")
+			if form := ctx.GetForm("groupname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append("}")
+			if data.Len() > 2 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data.Bytes(), req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		}
+		if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SretentionGetReq"](req); s != "" {
+			log.Error("[/config.sconfig/sretention_get]", s)
+			ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+			return
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			if error1.Equal(e, error1.ErrReq) {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+			} else {
+				ctx.AbortString(http.StatusInternalServerError, e.Error())
+			}
+			return
+		}
+		if resp == nil {
+			resp = new(SretentionGetResp)
+		}
+		if strings.HasPrefix(ctx.GetAcceptType(), "application/x-protobuf") {
+			respd, _ := proto.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/x-protobuf")
+			ctx.Write(http.StatusOK, respd)
+		} else {
+			respd, _ := protojson.MarshalOptions{UseProtoNames: true, UseEnumNumbers: true, EmitUnpopulated: true}.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/json")
+			ctx.Write(http.StatusOK, respd)
+		}
+	}
+}
+func _Sconfig_SprincipalSet_WebHandler(handler func(context.Context, *SprincipalSetReq) (*SprincipalSetResp, error)) web.OutsideHandler {
+	return func(ctx *web.Context) {
+		req := new(SprincipalSetReq)
+		if strings.HasPrefix(ctx.GetContentType(), "application/json") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else if strings.HasPrefix(ctx.GetContentType(), "application/x-protobuf") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				if e := proto.Unmarshal(data, req); e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else {
+			if e := ctx.ParseForm(); e != nil {
+				ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+				return
+			}
+			data := bufpool.GetBuffer()
+			defer bufpool.PutBuffer(data)
+			data.Append("{")
+			data.Append("\"name\":")
+			if form := ctx.GetForm("name"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append("}")
+			if data.Len() > 2 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data.Bytes(), req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		}
+		if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SprincipalSetReq"](req); s != "" {
+			log.Error("[/config.sconfig/sprincipal_set]", s)
+			ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+			return
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			if error1.Equal(e, error1.ErrReq) {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+			} else {
+				ctx.AbortString(http.StatusInternalServerError, e.Error())
+			}
+			return
+		}
+		if resp == nil {
+			resp = new(SprincipalSetResp)
+		}
+		if strings.HasPrefix(ctx.GetAcceptType(), "application/x-protobuf") {
+			respd, _ := proto.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/x-protobuf")
+			ctx.Write(http.StatusOK, respd)
+		} else {
+			respd, _ := protojson.MarshalOptions{UseProtoNames: true, UseEnumNumbers: true, EmitUnpopulated: true}.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/json")
+			ctx.Write(http.StatusOK, respd)
+		}
+	}
+}
+func _Sconfig_SprincipalDel_WebHandler(handler func(context.Context, *SprincipalDelReq) (*SprincipalDelResp, error)) web.OutsideHandler {
+	return func(ctx *web.Context) {
+		req := new(SprincipalDelReq)
+		if strings.HasPrefix(ctx.GetContentType(), "application/json") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else if strings.HasPrefix(ctx.GetContentType(), "application/x-protobuf") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				if e := proto.Unmarshal(data, req); e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else {
+			if e := ctx.ParseForm(); e != nil {
+				ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+				return
+			}
+			data := bufpool.GetBuffer()
+			defer bufpool.PutBuffer(data)
+			data.Append("{")
+			data.Append("\"name\":")
+			if form := ctx.GetForm("name"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append("}")
+			if data.Len() > 2 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data.Bytes(), req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		}
+		if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SprincipalDelReq"](req); s != "" {
+			log.Error("[/config.sconfig/sprincipal_del]", s)
+			ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+			return
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			if error1.Equal(e, error1.ErrReq) {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+			} else {
+				ctx.AbortString(http.StatusInternalServerError, e.Error())
+			}
+			return
+		}
+		if resp == nil {
+			resp = new(SprincipalDelResp)
+		}
+		if strings.HasPrefix(ctx.GetAcceptType(), "application/x-protobuf") {
+			respd, _ := proto.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/x-protobuf")
+			ctx.Write(http.StatusOK, respd)
+		} else {
+			respd, _ := protojson.MarshalOptions{UseProtoNames: true, UseEnumNumbers: true, EmitUnpopulated: true}.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/json")
+			ctx.Write(http.StatusOK, respd)
+		}
+	}
+}
+func _Sconfig_Sprincipals_WebHandler(handler func(context.Context, *SprincipalsReq) (*SprincipalsResp, error)) web.OutsideHandler {
+	return func(ctx *web.Context) {
+		req := new(SprincipalsReq)
+		if strings.HasPrefix(ctx.GetContentType(), "application/json") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else if strings.HasPrefix(ctx.GetContentType(), "application/x-protobuf") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				if e := proto.Unmarshal(data, req); e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else {
+			if e := ctx.ParseForm(); e != nil {
+				ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+				return
+			}
+			data := bufpool.GetBuffer()
+			defer bufpool.PutBuffer(data)
+			data.Append("{")
+			data.Append("}")
+			if data.Len() > 2 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data.Bytes(), req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			if error1.Equal(e, error1.ErrReq) {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+			} else {
+				ctx.AbortString(http.StatusInternalServerError, e.Error())
+			}
+			return
+		}
+		if resp == nil {
+			resp = new(SprincipalsResp)
+		}
+		if strings.HasPrefix(ctx.GetAcceptType(), "application/x-protobuf") {
+			respd, _ := proto.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/x-protobuf")
+			ctx.Write(http.StatusOK, respd)
+		} else {
+			respd, _ := protojson.MarshalOptions{UseProtoNames: true, UseEnumNumbers: true, EmitUnpopulated: true}.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/json")
+			ctx.Write(http.StatusOK, respd)
+		}
+	}
+}
+func _Sconfig_Sgrant_WebHandler(handler func(context.Context, *SgrantReq) (*SgrantResp, error)) web.OutsideHandler {
+	return func(ctx *web.Context) {
+		req := new(SgrantReq)
+		if strings.HasPrefix(ctx.GetContentType(), "application/json") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else if strings.HasPrefix(ctx.GetContentType(), "application/x-protobuf") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				if e := proto.Unmarshal(data, req); e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else {
+			if e := ctx.ParseForm(); e != nil {
+				ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+				return
+			}
+			data := bufpool.GetBuffer()
+			defer bufpool.PutBuffer(data)
+			data.Append("{")
+			data.Append("\"principal\":")
+			if form := ctx.GetForm("principal"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"groupname\":")
+			if form := ctx.GetForm("groupname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"appname\":")
+			if form := ctx.GetForm("appname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"role\":")
+			if form := ctx.GetForm("role"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"reveal\":")
+			if form := ctx.GetForm("reveal"); len(form) == 0 {
+				data.Append("false")
+			} else {
+				data.Append(form)
+			}
+			data.Append("}")
+			if data.Len() > 2 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data.Bytes(), req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		}
+		if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SgrantReq"](req); s != "" {
+			log.Error("[/config.sconfig/sgrant]", s)
+			ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+			return
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			if error1.Equal(e, error1.ErrReq) {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+			} else {
+				ctx.AbortString(http.StatusInternalServerError, e.Error())
+			}
+			return
+		}
+		if resp == nil {
+			resp = new(SgrantResp)
+		}
+		if strings.HasPrefix(ctx.GetAcceptType(), "application/x-protobuf") {
+			respd, _ := proto.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/x-protobuf")
+			ctx.Write(http.StatusOK, respd)
+		} else {
+			respd, _ := protojson.MarshalOptions{UseProtoNames: true, UseEnumNumbers: true, EmitUnpopulated: true}.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/json")
+			ctx.Write(http.StatusOK, respd)
+		}
+	}
+}
+func _Sconfig_Sgrants_WebHandler(handler func(context.Context, *SgrantsReq) (*SgrantsResp, error)) web.OutsideHandler {
+	return func(ctx *web.Context) {
+		req := new(SgrantsReq)
+		if strings.HasPrefix(ctx.GetContentType(), "application/json") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else if strings.HasPrefix(ctx.GetContentType(), "application/x-protobuf") {
+			data, e := ctx.GetBody()
+			if e != nil {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+				return
+			}
+			if len(data) > 0 {
+				if e := proto.Unmarshal(data, req); e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		} else {
+			if e := ctx.ParseForm(); e != nil {
+				ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+				return
+			}
+			data := bufpool.GetBuffer()
+			defer bufpool.PutBuffer(data)
+			data.Append("{")
+			data.Append("\"principal\":")
+			if form := ctx.GetForm("principal"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"groupname\":")
+			if form := ctx.GetForm("groupname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append("}")
+			if data.Len() > 2 {
+				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data.Bytes(), req)
+				if e != nil {
+					ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+					return
+				}
+			}
+		}
+		resp, e := handler(ctx, req)
+		if e != nil {
+			if error1.Equal(e, error1.ErrReq) {
+				ctx.AbortString(http.StatusBadRequest, e.Error())
+			} else {
+				ctx.AbortString(http.StatusInternalServerError, e.Error())
+			}
+			return
+		}
+		if resp == nil {
+			resp = new(SgrantsResp)
+		}
+		if strings.HasPrefix(ctx.GetAcceptType(), "application/x-protobuf") {
+			respd, _ := proto.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/x-protobuf")
+			ctx.Write(http.StatusOK, respd)
+		} else {
+			respd, _ := protojson.MarshalOptions{UseProtoNames: true, UseEnumNumbers: true, EmitUnpopulated: true}.Marshal(resp)
+			ctx.SetHeader("Content-Type", "application/json")
+			ctx.Write(http.StatusOK, respd)
+		}
+	}
 }
-
-type SconfigWebServer interface {
-	//one specific app's current info
-	Sinfo(context.Context, *SinfoReq) (*SinfoResp, error)
-	//set one specific app's config
-	Sset(context.Context, *SsetReq) (*SsetResp, error)
-	//rollback one specific app's config
-	Srollback(context.Context, *SrollbackReq) (*SrollbackResp, error)
-	//get one specific app's config
-	Sget(context.Context, *SgetReq) (*SgetResp, error)
-	//get all groups
-	Sgroups(context.Context, *SgroupsReq) (*SgroupsResp, error)
-	//get all apps
-	Sapps(context.Context, *SappsReq) (*SappsResp, error)
-	//get watch addr
-	Swatchaddr(context.Context, *SwatchaddrReq) (*SwatchaddrResp, error)
-}
-
-func _Sconfig_Sinfo_WebHandler(handler func(context.Context, *SinfoReq) (*SinfoResp, error)) web.OutsideHandler {
+func _Sconfig_StokenIssue_WebHandler(handler func(context.Context, *StokenIssueReq) (*StokenIssueResp, error)) web.OutsideHandler {
 	return func(ctx *web.Context) {
-		req := new(SinfoReq)
+		req := new(StokenIssueReq)
 		if strings.HasPrefix(ctx.GetContentType(), "application/json") {
 			data, e := ctx.GetBody()
 			if e != nil {
@@ -473,6 +6705,24 @@ func _Sconfig_Sinfo_WebHandler(handler func(context.Context, *SinfoReq) (*SinfoR
 			} else {
 				data.Append(form)
 			}
+			data.Append(",")
+			data.Append("\"comment\":")
+			if form := ctx.GetForm("comment"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"expire\":")
+			if form := ctx.GetForm("expire"); len(form) == 0 {
+				data.Append("0")
+			} else {
+				data.Append(form)
+			}
 			data.Append("}")
 			if data.Len() > 2 {
 				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data.Bytes(), req)
@@ -482,8 +6732,8 @@ func _Sconfig_Sinfo_WebHandler(handler func(context.Context, *SinfoReq) (*SinfoR
 				}
 			}
 		}
-		if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SinfoReq"](req); s != "" {
-			log.Error("[/config.sconfig/sinfo]", s)
+		if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".StokenIssueReq"](req); s != "" {
+			log.Error("[/config.sconfig/stoken_issue]", s)
 			ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
 			return
 		}
@@ -497,7 +6747,7 @@ func _Sconfig_Sinfo_WebHandler(handler func(context.Context, *SinfoReq) (*SinfoR
 			return
 		}
 		if resp == nil {
-			resp = new(SinfoResp)
+			resp = new(StokenIssueResp)
 		}
 		if strings.HasPrefix(ctx.GetAcceptType(), "application/x-protobuf") {
 			respd, _ := proto.Marshal(resp)
@@ -510,9 +6760,9 @@ func _Sconfig_Sinfo_WebHandler(handler func(context.Context, *SinfoReq) (*SinfoR
 		}
 	}
 }
-func _Sconfig_Sset_WebHandler(handler func(context.Context, *SsetReq) (*SsetResp, error)) web.OutsideHandler {
+func _Sconfig_StokenRotate_WebHandler(handler func(context.Context, *StokenRotateReq) (*StokenRotateResp, error)) web.OutsideHandler {
 	return func(ctx *web.Context) {
-		req := new(SsetReq)
+		req := new(StokenRotateReq)
 		if strings.HasPrefix(ctx.GetContentType(), "application/json") {
 			data, e := ctx.GetBody()
 			if e != nil {
@@ -568,8 +6818,8 @@ func _Sconfig_Sset_WebHandler(handler func(context.Context, *SsetReq) (*SsetResp
 				data.Append(form)
 			}
 			data.Append(",")
-			data.Append("\"app_config\":")
-			if form := ctx.GetForm("app_config"); len(form) == 0 {
+			data.Append("\"id\":")
+			if form := ctx.GetForm("id"); len(form) == 0 {
 				data.Append("\"\"")
 			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
 				data.Append("\"")
@@ -579,8 +6829,15 @@ func _Sconfig_Sset_WebHandler(handler func(context.Context, *SsetReq) (*SsetResp
 				data.Append(form)
 			}
 			data.Append(",")
-			data.Append("\"source_config\":")
-			if form := ctx.GetForm("source_config"); len(form) == 0 {
+			data.Append("\"grace\":")
+			if form := ctx.GetForm("grace"); len(form) == 0 {
+				data.Append("0")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"comment\":")
+			if form := ctx.GetForm("comment"); len(form) == 0 {
 				data.Append("\"\"")
 			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
 				data.Append("\"")
@@ -598,8 +6855,8 @@ func _Sconfig_Sset_WebHandler(handler func(context.Context, *SsetReq) (*SsetResp
 				}
 			}
 		}
-		if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SsetReq"](req); s != "" {
-			log.Error("[/config.sconfig/sset]", s)
+		if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".StokenRotateReq"](req); s != "" {
+			log.Error("[/config.sconfig/stoken_rotate]", s)
 			ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
 			return
 		}
@@ -613,7 +6870,7 @@ func _Sconfig_Sset_WebHandler(handler func(context.Context, *SsetReq) (*SsetResp
 			return
 		}
 		if resp == nil {
-			resp = new(SsetResp)
+			resp = new(StokenRotateResp)
 		}
 		if strings.HasPrefix(ctx.GetAcceptType(), "application/x-protobuf") {
 			respd, _ := proto.Marshal(resp)
@@ -626,9 +6883,9 @@ func _Sconfig_Sset_WebHandler(handler func(context.Context, *SsetReq) (*SsetResp
 		}
 	}
 }
-func _Sconfig_Srollback_WebHandler(handler func(context.Context, *SrollbackReq) (*SrollbackResp, error)) web.OutsideHandler {
+func _Sconfig_StokenRevoke_WebHandler(handler func(context.Context, *StokenRevokeReq) (*StokenRevokeResp, error)) web.OutsideHandler {
 	return func(ctx *web.Context) {
-		req := new(SrollbackReq)
+		req := new(StokenRevokeReq)
 		if strings.HasPrefix(ctx.GetContentType(), "application/json") {
 			data, e := ctx.GetBody()
 			if e != nil {
@@ -684,9 +6941,13 @@ func _Sconfig_Srollback_WebHandler(handler func(context.Context, *SrollbackReq)
 				data.Append(form)
 			}
 			data.Append(",")
-			data.Append("\"index\":")
-			if form := ctx.GetForm("index"); len(form) == 0 {
-				data.Append("0")
+			data.Append("\"id\":")
+			if form := ctx.GetForm("id"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
 			} else {
 				data.Append(form)
 			}
@@ -699,8 +6960,8 @@ func _Sconfig_Srollback_WebHandler(handler func(context.Context, *SrollbackReq)
 				}
 			}
 		}
-		if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SrollbackReq"](req); s != "" {
-			log.Error("[/config.sconfig/srollback]", s)
+		if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".StokenRevokeReq"](req); s != "" {
+			log.Error("[/config.sconfig/stoken_revoke]", s)
 			ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
 			return
 		}
@@ -714,7 +6975,7 @@ func _Sconfig_Srollback_WebHandler(handler func(context.Context, *SrollbackReq)
 			return
 		}
 		if resp == nil {
-			resp = new(SrollbackResp)
+			resp = new(StokenRevokeResp)
 		}
 		if strings.HasPrefix(ctx.GetAcceptType(), "application/x-protobuf") {
 			respd, _ := proto.Marshal(resp)
@@ -727,9 +6988,9 @@ func _Sconfig_Srollback_WebHandler(handler func(context.Context, *SrollbackReq)
 		}
 	}
 }
-func _Sconfig_Sget_WebHandler(handler func(context.Context, *SgetReq) (*SgetResp, error)) web.OutsideHandler {
+func _Sconfig_Stokens_WebHandler(handler func(context.Context, *StokensReq) (*StokensResp, error)) web.OutsideHandler {
 	return func(ctx *web.Context) {
-		req := new(SgetReq)
+		req := new(StokensReq)
 		if strings.HasPrefix(ctx.GetContentType(), "application/json") {
 			data, e := ctx.GetBody()
 			if e != nil {
@@ -784,13 +7045,6 @@ func _Sconfig_Sget_WebHandler(handler func(context.Context, *SgetReq) (*SgetResp
 			} else {
 				data.Append(form)
 			}
-			data.Append(",")
-			data.Append("\"index\":")
-			if form := ctx.GetForm("index"); len(form) == 0 {
-				data.Append("0")
-			} else {
-				data.Append(form)
-			}
 			data.Append("}")
 			if data.Len() > 2 {
 				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data.Bytes(), req)
@@ -800,8 +7054,8 @@ func _Sconfig_Sget_WebHandler(handler func(context.Context, *SgetReq) (*SgetResp
 				}
 			}
 		}
-		if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SgetReq"](req); s != "" {
-			log.Error("[/config.sconfig/sget]", s)
+		if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".StokensReq"](req); s != "" {
+			log.Error("[/config.sconfig/stokens]", s)
 			ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
 			return
 		}
@@ -815,7 +7069,7 @@ func _Sconfig_Sget_WebHandler(handler func(context.Context, *SgetReq) (*SgetResp
 			return
 		}
 		if resp == nil {
-			resp = new(SgetResp)
+			resp = new(StokensResp)
 		}
 		if strings.HasPrefix(ctx.GetAcceptType(), "application/x-protobuf") {
 			respd, _ := proto.Marshal(resp)
@@ -828,9 +7082,9 @@ func _Sconfig_Sget_WebHandler(handler func(context.Context, *SgetReq) (*SgetResp
 		}
 	}
 }
-func _Sconfig_Sgroups_WebHandler(handler func(context.Context, *SgroupsReq) (*SgroupsResp, error)) web.OutsideHandler {
+func _Sconfig_SbaseSet_WebHandler(handler func(context.Context, *SbaseSetReq) (*SbaseSetResp, error)) web.OutsideHandler {
 	return func(ctx *web.Context) {
-		req := new(SgroupsReq)
+		req := new(SbaseSetReq)
 		if strings.HasPrefix(ctx.GetContentType(), "application/json") {
 			data, e := ctx.GetBody()
 			if e != nil {
@@ -864,6 +7118,56 @@ func _Sconfig_Sgroups_WebHandler(handler func(context.Context, *SgroupsReq) (*Sg
 			data := bufpool.GetBuffer()
 			defer bufpool.PutBuffer(data)
 			data.Append("{")
+			data.Append("\"groupname\":")
+			if form := ctx.GetForm("groupname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"app_config\":")
+			if form := ctx.GetForm("app_config"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"source_config\":")
+			if form := ctx.GetForm("source_config"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"comment\":")
+			if form := ctx.GetForm("comment"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"expected_op_num\":")
+			if form := ctx.GetForm("expected_op_num"); len(form) == 0 {
+				data.Append("0")
+			} else {
+				data.Append(form)
+			}
 			data.Append("}")
 			if data.Len() > 2 {
 				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data.Bytes(), req)
@@ -873,6 +7177,11 @@ func _Sconfig_Sgroups_WebHandler(handler func(context.Context, *SgroupsReq) (*Sg
 				}
 			}
 		}
+		if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SbaseSetReq"](req); s != "" {
+			log.Error("[/config.sconfig/sbase_set]", s)
+			ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+			return
+		}
 		resp, e := handler(ctx, req)
 		if e != nil {
 			if error1.Equal(e, error1.ErrReq) {
@@ -883,7 +7192,7 @@ func _Sconfig_Sgroups_WebHandler(handler func(context.Context, *SgroupsReq) (*Sg
 			return
 		}
 		if resp == nil {
-			resp = new(SgroupsResp)
+			resp = new(SbaseSetResp)
 		}
 		if strings.HasPrefix(ctx.GetAcceptType(), "application/x-protobuf") {
 			respd, _ := proto.Marshal(resp)
@@ -896,9 +7205,9 @@ func _Sconfig_Sgroups_WebHandler(handler func(context.Context, *SgroupsReq) (*Sg
 		}
 	}
 }
-func _Sconfig_Sapps_WebHandler(handler func(context.Context, *SappsReq) (*SappsResp, error)) web.OutsideHandler {
+func _Sconfig_SbaseGet_WebHandler(handler func(context.Context, *SbaseGetReq) (*SbaseGetResp, error)) web.OutsideHandler {
 	return func(ctx *web.Context) {
-		req := new(SappsReq)
+		req := new(SbaseGetReq)
 		if strings.HasPrefix(ctx.GetContentType(), "application/json") {
 			data, e := ctx.GetBody()
 			if e != nil {
@@ -951,8 +7260,8 @@ func _Sconfig_Sapps_WebHandler(handler func(context.Context, *SappsReq) (*SappsR
 				}
 			}
 		}
-		if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SappsReq"](req); s != "" {
-			log.Error("[/config.sconfig/sapps]", s)
+		if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SbaseGetReq"](req); s != "" {
+			log.Error("[/config.sconfig/sbase_get]", s)
 			ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
 			return
 		}
@@ -966,7 +7275,7 @@ func _Sconfig_Sapps_WebHandler(handler func(context.Context, *SappsReq) (*SappsR
 			return
 		}
 		if resp == nil {
-			resp = new(SappsResp)
+			resp = new(SbaseGetResp)
 		}
 		if strings.HasPrefix(ctx.GetAcceptType(), "application/x-protobuf") {
 			respd, _ := proto.Marshal(resp)
@@ -979,9 +7288,9 @@ func _Sconfig_Sapps_WebHandler(handler func(context.Context, *SappsReq) (*SappsR
 		}
 	}
 }
-func _Sconfig_Swatchaddr_WebHandler(handler func(context.Context, *SwatchaddrReq) (*SwatchaddrResp, error)) web.OutsideHandler {
+func _Sconfig_Srender_WebHandler(handler func(context.Context, *SrenderReq) (*SrenderResp, error)) web.OutsideHandler {
 	return func(ctx *web.Context) {
-		req := new(SwatchaddrReq)
+		req := new(SrenderReq)
 		if strings.HasPrefix(ctx.GetContentType(), "application/json") {
 			data, e := ctx.GetBody()
 			if e != nil {
@@ -1015,6 +7324,34 @@ func _Sconfig_Swatchaddr_WebHandler(handler func(context.Context, *SwatchaddrReq
 			data := bufpool.GetBuffer()
 			defer bufpool.PutBuffer(data)
 			data.Append("{")
+			data.Append("\"groupname\":")
+			if form := ctx.GetForm("groupname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"appname\":")
+			if form := ctx.GetForm("appname"); len(form) == 0 {
+				data.Append("\"\"")
+			} else if len(form) < 2 || form[0] != '"' || form[len(form)-1] != '"' {
+				data.Append("\"")
+				data.Append(form)
+				data.Append("\"")
+			} else {
+				data.Append(form)
+			}
+			data.Append(",")
+			data.Append("\"index\":")
+			if form := ctx.GetForm("index"); len(form) == 0 {
+				data.Append("0")
+			} else {
+				data.Append(form)
+			}
 			data.Append("}")
 			if data.Len() > 2 {
 				e := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data.Bytes(), req)
@@ -1024,6 +7361,11 @@ func _Sconfig_Swatchaddr_WebHandler(handler func(context.Context, *SwatchaddrReq
 				}
 			}
 		}
+		if s := _SconfigWebCheckers["\"\\\"Config/api\\\"\".SrenderReq"](req); s != "" {
+			log.Error("[/config.sconfig/srender]", s)
+			ctx.AbortString(http.StatusBadRequest, error1.ErrReq.String())
+			return
+		}
 		resp, e := handler(ctx, req)
 		if e != nil {
 			if error1.Equal(e, error1.ErrReq) {
@@ -1034,7 +7376,7 @@ func _Sconfig_Swatchaddr_WebHandler(handler func(context.Context, *SwatchaddrReq
 			return
 		}
 		if resp == nil {
-			resp = new(SwatchaddrResp)
+			resp = new(SrenderResp)
 		}
 		if strings.HasPrefix(ctx.GetAcceptType(), "application/x-protobuf") {
 			respd, _ := proto.Marshal(resp)
@@ -1068,7 +7410,124 @@ func RegisterSconfigWebServer(engine *web.WebServer, svc SconfigWebServer, allmi
 	if e := engine.Get(_WebPathSconfigSapps, 250000000, _Sconfig_Sapps_WebHandler(svc.Sapps)); e != nil {
 		return e
 	}
-	if e := engine.Get(_WebPathSconfigSwatchaddr, 250000000, _Sconfig_Swatchaddr_WebHandler(svc.Swatchaddr)); e != nil {
+	if e := engine.Get(_WebPathSconfigSwatch, 30000000000, _Sconfig_Swatch_WebHandler(svc.Swatch)); e != nil {
+		return e
+	}
+	if e := engine.Get(_WebPathSconfigShistory, 250000000, _Sconfig_Shistory_WebHandler(svc.Shistory)); e != nil {
+		return e
+	}
+	if e := engine.Get(_WebPathSconfigSaudit, 250000000, _Sconfig_Saudit_WebHandler(svc.Saudit)); e != nil {
+		return e
+	}
+	if e := engine.Post(_WebPathSconfigSpatch, 250000000, _Sconfig_Spatch_WebHandler(svc.Spatch)); e != nil {
+		return e
+	}
+	if e := engine.Post(_WebPathSconfigSschemaSet, 250000000, _Sconfig_SschemaSet_WebHandler(svc.SschemaSet)); e != nil {
+		return e
+	}
+	if e := engine.Get(_WebPathSconfigSschemaGet, 250000000, _Sconfig_SschemaGet_WebHandler(svc.SschemaGet)); e != nil {
+		return e
+	}
+	if e := engine.Get(_WebPathSconfigSdiff, 250000000, _Sconfig_Sdiff_WebHandler(svc.Sdiff)); e != nil {
+		return e
+	}
+	if e := engine.Post(_WebPathSconfigSpublish, 250000000, _Sconfig_Spublish_WebHandler(svc.Spublish)); e != nil {
+		return e
+	}
+	if e := engine.Post(_WebPathSconfigSpolicySet, 250000000, _Sconfig_SpolicySet_WebHandler(svc.SpolicySet)); e != nil {
+		return e
+	}
+	if e := engine.Get(_WebPathSconfigSpolicyGet, 250000000, _Sconfig_SpolicyGet_WebHandler(svc.SpolicyGet)); e != nil {
+		return e
+	}
+	if e := engine.Post(_WebPathSconfigSapprove, 250000000, _Sconfig_Sapprove_WebHandler(svc.Sapprove)); e != nil {
+		return e
+	}
+	if e := engine.Post(_WebPathSconfigSreject, 250000000, _Sconfig_Sreject_WebHandler(svc.Sreject)); e != nil {
+		return e
+	}
+	if e := engine.Get(_WebPathSconfigSpending, 500000000, _Sconfig_Spending_WebHandler(svc.Spending)); e != nil {
+		return e
+	}
+	if e := engine.Post(_WebPathSconfigSschedule, 250000000, _Sconfig_Sschedule_WebHandler(svc.Sschedule)); e != nil {
+		return e
+	}
+	if e := engine.Get(_WebPathSconfigSscheduleList, 250000000, _Sconfig_SscheduleList_WebHandler(svc.SscheduleList)); e != nil {
+		return e
+	}
+	if e := engine.Post(_WebPathSconfigSscheduleCancel, 250000000, _Sconfig_SscheduleCancel_WebHandler(svc.SscheduleCancel)); e != nil {
+		return e
+	}
+	if e := engine.Post(_WebPathSconfigScanary, 250000000, _Sconfig_Scanary_WebHandler(svc.Scanary)); e != nil {
+		return e
+	}
+	if e := engine.Post(_WebPathSconfigScanaryPromote, 250000000, _Sconfig_ScanaryPromote_WebHandler(svc.ScanaryPromote)); e != nil {
+		return e
+	}
+	if e := engine.Post(_WebPathSconfigScanaryAbort, 250000000, _Sconfig_ScanaryAbort_WebHandler(svc.ScanaryAbort)); e != nil {
+		return e
+	}
+	if e := engine.Post(_WebPathSconfigSreport, 250000000, _Sconfig_Sreport_WebHandler(svc.Sreport)); e != nil {
+		return e
+	}
+	if e := engine.Get(_WebPathSconfigSrollout, 250000000, _Sconfig_Srollout_WebHandler(svc.Srollout)); e != nil {
+		return e
+	}
+	if e := engine.Post(_WebPathSconfigSdelete, 500000000, _Sconfig_Sdelete_WebHandler(svc.Sdelete)); e != nil {
+		return e
+	}
+	if e := engine.Post(_WebPathSconfigSrestore, 500000000, _Sconfig_Srestore_WebHandler(svc.Srestore)); e != nil {
+		return e
+	}
+	if e := engine.Post(_WebPathSconfigSpurge, 500000000, _Sconfig_Spurge_WebHandler(svc.Spurge)); e != nil {
+		return e
+	}
+	if e := engine.Get(_WebPathSconfigSrecycle, 500000000, _Sconfig_Srecycle_WebHandler(svc.Srecycle)); e != nil {
+		return e
+	}
+	if e := engine.Post(_WebPathSconfigStag, 250000000, _Sconfig_Stag_WebHandler(svc.Stag)); e != nil {
+		return e
+	}
+	if e := engine.Post(_WebPathSconfigSretentionSet, 250000000, _Sconfig_SretentionSet_WebHandler(svc.SretentionSet)); e != nil {
+		return e
+	}
+	if e := engine.Get(_WebPathSconfigSretentionGet, 250000000, _Sconfig_SretentionGet_WebHandler(svc.SretentionGet)); e != nil {
+		return e
+	}
+	if e := engine.Post(_WebPathSconfigSprincipalSet, 250000000, _Sconfig_SprincipalSet_WebHandler(svc.SprincipalSet)); e != nil {
+		return e
+	}
+	if e := engine.Post(_WebPathSconfigSprincipalDel, 250000000, _Sconfig_SprincipalDel_WebHandler(svc.SprincipalDel)); e != nil {
+		return e
+	}
+	if e := engine.Get(_WebPathSconfigSprincipals, 250000000, _Sconfig_Sprincipals_WebHandler(svc.Sprincipals)); e != nil {
+		return e
+	}
+	if e := engine.Post(_WebPathSconfigSgrant, 250000000, _Sconfig_Sgrant_WebHandler(svc.Sgrant)); e != nil {
+		return e
+	}
+	if e := engine.Get(_WebPathSconfigSgrants, 250000000, _Sconfig_Sgrants_WebHandler(svc.Sgrants)); e != nil {
+		return e
+	}
+	if e := engine.Post(_WebPathSconfigStokenIssue, 250000000, _Sconfig_StokenIssue_WebHandler(svc.StokenIssue)); e != nil {
+		return e
+	}
+	if e := engine.Post(_WebPathSconfigStokenRotate, 250000000, _Sconfig_StokenRotate_WebHandler(svc.StokenRotate)); e != nil {
+		return e
+	}
+	if e := engine.Post(_WebPathSconfigStokenRevoke, 250000000, _Sconfig_StokenRevoke_WebHandler(svc.StokenRevoke)); e != nil {
+		return e
+	}
+	if e := engine.Get(_WebPathSconfigStokens, 250000000, _Sconfig_Stokens_WebHandler(svc.Stokens)); e != nil {
+		return e
+	}
+	if e := engine.Post(_WebPathSconfigSbaseSet, 500000000, _Sconfig_SbaseSet_WebHandler(svc.SbaseSet)); e != nil {
+		return e
+	}
+	if e := engine.Get(_WebPathSconfigSbaseGet, 250000000, _Sconfig_SbaseGet_WebHandler(svc.SbaseGet)); e != nil {
+		return e
+	}
+	if e := engine.Get(_WebPathSconfigSrender, 250000000, _Sconfig_Srender_WebHandler(svc.Srender)); e != nil {
 		return e
 	}
 	return nil
@@ -6,13 +6,14 @@ import (
 	"path/filepath"
 
 	"github.com/chenjie199234/Corelib/log"
+	ctime "github.com/chenjie199234/Corelib/util/time"
 	"github.com/fsnotify/fsnotify"
 )
 
 //AppConfig can hot update
 //this is the config used for this app
 type AppConfig struct {
-	//add your config here
+	RecycleRetention ctime.Duration `json:"recycle_retention"` //the deleted app will be purged after this time,default 168h(7 days)
 }
 
 //AC -
@@ -15,6 +15,10 @@ type EnvConfig struct {
 	ConfigType        *int
 	RunEnv            *string
 	DeployEnv         *string
+	SconfigLocalDir   *string
+	RootToken         *string
+	SecretKeyFile     *string
+	RedactKeys        *string
 }
 
 //EC -
@@ -75,4 +79,22 @@ func initenv() {
 	} else {
 		log.Warning("[config.initenv] missing DEPLOY_ENV")
 	}
+	//this is only used for local develop,so don't warn when it is missing
+	if str, ok := os.LookupEnv("SCONFIG_LOCAL_DIR"); ok && str != "<SCONFIG_LOCAL_DIR>" && str != "" {
+		EC.SconfigLocalDir = &str
+	}
+	//rbac is disabled when this is missing,so don't break the old deployments
+	if str, ok := os.LookupEnv("ROOT_TOKEN"); ok && str != "<ROOT_TOKEN>" && str != "" {
+		EC.RootToken = &str
+	} else {
+		log.Warning("[config.initenv] missing ROOT_TOKEN,rbac is disabled")
+	}
+	//secrets in the configs can't be used when this is missing,most configs don't have secrets,so don't warn
+	if str, ok := os.LookupEnv("SECRET_KEY_FILE"); ok && str != "<SECRET_KEY_FILE>" && str != "" {
+		EC.SecretKeyFile = &str
+	}
+	//the default patterns are used when this is missing
+	if str, ok := os.LookupEnv("REDACT_KEYS"); ok && str != "<REDACT_KEYS>" && str != "" {
+		EC.RedactKeys = &str
+	}
 }
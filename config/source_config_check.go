@@ -0,0 +1,86 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"strconv"
+)
+
+//CheckSourceConfig check the source config with the sourceConfig's struct
+//this is used by the config server to check the submitted source config before it is delivered to the app
+//because a wrong source config will make the app exit when start
+//return the field level errors,empty means pass
+func CheckSourceConfig(data []byte) []string {
+	sections := make(map[string]json.RawMessage)
+	if e := json.Unmarshal(data, &sections); e != nil {
+		return []string{"/: " + e.Error()}
+	}
+	c := &sourceConfig{}
+	fields := map[string]interface{}{
+		"rpc_server": &c.RpcServer,
+		"rpc_client": &c.RpcClient,
+		"web_server": &c.WebServer,
+		"web_client": &c.WebClient,
+		"mongo":      &c.Mongo,
+		"sql":        &c.Sql,
+		"redis":      &c.Redis,
+		"kafka_pub":  &c.KafkaPub,
+		"kafka_sub":  &c.KafkaSub,
+	}
+	result := make([]string, 0)
+	for k, section := range sections {
+		field, ok := fields[k]
+		if !ok {
+			result = append(result, "/"+k+": unknown key")
+			continue
+		}
+		//unparsable ctime.Duration and unknown sub keys will fail here
+		decoder := json.NewDecoder(bytes.NewReader(section))
+		decoder.DisallowUnknownFields()
+		if e := decoder.Decode(field); e != nil {
+			result = append(result, "/"+k+": "+e.Error())
+		}
+	}
+	for k, mongoc := range c.Mongo {
+		if k != "example_mongo" && (mongoc == nil || len(mongoc.Addrs) == 0) {
+			result = append(result, "/mongo/"+k+"/addrs: missing")
+		}
+	}
+	for k, sqlc := range c.Sql {
+		if k != "example_sql" && (sqlc == nil || sqlc.Addr == "") {
+			result = append(result, "/sql/"+k+"/addr: missing")
+		}
+	}
+	for k, redisc := range c.Redis {
+		if k != "example_redis" && (redisc == nil || redisc.Addr == "") {
+			result = append(result, "/redis/"+k+"/addr: missing")
+		}
+	}
+	for topic, pubc := range c.KafkaPub {
+		if topic != "example_topic" && (pubc == nil || pubc.Addr == "") {
+			result = append(result, "/kafka_pub/"+topic+"/addr: missing")
+		}
+	}
+	for topic, subc := range c.KafkaSub {
+		if topic == "example_topic" {
+			continue
+		}
+		if subc == nil {
+			result = append(result, "/kafka_sub/"+topic+": missing")
+			continue
+		}
+		if subc.Addr == "" {
+			result = append(result, "/kafka_sub/"+topic+"/addr: missing")
+		}
+		if subc.GroupName == "" {
+			result = append(result, "/kafka_sub/"+topic+"/group_name: missing")
+		}
+		//0 means default -2
+		if subc.StartOffset != 0 && subc.StartOffset != -1 && subc.StartOffset != -2 {
+			result = append(result, "/kafka_sub/"+topic+"/start_offset: "+strconv.FormatInt(subc.StartOffset, 10)+" is not one of 0,-1,-2")
+		}
+	}
+	sort.Strings(result)
+	return result
+}
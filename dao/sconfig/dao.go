@@ -1,26 +1,388 @@
 package sconfig
 
 import (
+	"context"
 	csql "database/sql"
+	"errors"
 
 	credis "github.com/chenjie199234/Corelib/redis"
 	cmongo "go.mongodb.org/mongo-driver/mongo"
 )
 
-//Dao this is a data operation layer to operate status service's data
-type Dao struct {
-	sql   *csql.DB
-	redis *credis.Pool
-	mongo *cmongo.Client
+//ErrNotExist is returned by all Storage implementations when the group,app or index doesn't exist
+var ErrNotExist = errors.New("[sconfig.dao] not exist")
+
+//ErrOpNumConflict is returned by all Storage implementations when the expect op_num is different from the current
+var ErrOpNumConflict = errors.New("[sconfig.dao] op_num conflict")
+
+//ErrDeleted is returned by all Storage implementations when create a new version on a deleted app
+var ErrDeleted = errors.New("[sconfig.dao] deleted")
+
+//Summary is the app's status
+//summary's index is 0
+type Summary struct {
+	Index     uint64  `bson:"index"`
+	CurIndex  uint64  `bson:"cur_index"`
+	MaxIndex  uint64  `bson:"max_index"`
+	OpNum     uint64  `bson:"op_num"`
+	PrevIndex uint64  `bson:"prev_index"` //the cur_index before the last op
+	Canary    *Canary `bson:"canary"`     //nil means no canary
+	Dtime     uint64  `bson:"dtime"`      //unix timestamp,second,not 0 means the app is in the recycle bin
+}
+
+//Canary deliver the version to part of the instances,other instances still use the cur_index
+//the instance matches any of the rules will get the canary version
+type Canary struct {
+	Index     uint64   `bson:"index"`
+	Percent   uint32   `bson:"percent"` //0-100
+	Hostnames []string `bson:"hostnames"`
+	Labels    []string `bson:"labels"`
+	Ctime     uint64   `bson:"ctime"` //unix timestamp,second
+	Author    string   `bson:"author"`
+}
+
+//Config is one version of the app's config
+//config's index start from 1
+type Config struct {
+	Index        uint64 `bson:"index"`
+	AppConfig    string `bson:"app_config"`
+	SourceConfig string `bson:"source_config"`
+	Ctime        uint64 `bson:"ctime"` //unix timestamp,second
+	Author       string `bson:"author"`
+	Comment      string `bson:"comment"`
+	Draft        bool   `bson:"draft"` //true means this version has never been current
+	Tag          string `bson:"tag"`   //empty means no tag
+}
+
+//Schema is one version of the app's json schema
+//schema's index start from 1
+//empty schema means no check
+type Schema struct {
+	Index        uint64 `bson:"index"`
+	AppSchema    string `bson:"app_schema"`
+	SourceSchema string `bson:"source_schema"`
+	Ctime        uint64 `bson:"ctime"` //unix timestamp,second
+	Author       string `bson:"author"`
+}
+
+//Audit is one op's record
+type Audit struct {
+	Groupname string `bson:"groupname"`
+	Appname   string `bson:"appname"`
+	Op        string `bson:"op"`
+	Caller    string `bson:"caller"`
+	IP        string `bson:"ip"`
+	FromIndex uint64 `bson:"from_index"`
+	ToIndex   uint64 `bson:"to_index"`
+	OpNum     uint64 `bson:"op_num"`
+	Ctime     uint64 `bson:"ctime"`  //unix timestamp,second
+	Detail    string `bson:"detail"` //the reason of the op
+}
+
+//Policy is the group's approval policy
+//when Approvals is not 0,every change in this group will be saved as a draft
+//and it can only be published after Approvals reviewers in Reviewers approved it
+type Policy struct {
+	Groupname string   `bson:"groupname"`
+	Reviewers []string `bson:"reviewers"`
+	Approvals uint32   `bson:"approvals"`
+	Ctime     uint64   `bson:"ctime"` //unix timestamp,second
+	Author    string   `bson:"author"`
+}
+
+//Approval is one reviewer's decision on one draft version
+//one reviewer only has one decision on one version,the later one will replace the former one
+type Approval struct {
+	Groupname string `bson:"groupname"`
+	Appname   string `bson:"appname"`
+	Index     uint64 `bson:"index"`
+	Reviewer  string `bson:"reviewer"`
+	Approved  bool   `bson:"approved"` //false means rejected
+	Comment   string `bson:"comment"`
+	Ctime     uint64 `bson:"ctime"` //unix timestamp,second
+}
+
+//schedule's status
+const (
+	SchedulePending  = "pending"
+	ScheduleFiring   = "firing" //claimed by the scheduler,the publish is in progress
+	ScheduleFired    = "fired"
+	ScheduleCanceled = "canceled"
+	ScheduleFailed   = "failed"
+)
+
+//Schedule will make the version current at the fire time
+type Schedule struct {
+	ID        string `bson:"_id"`
+	Groupname string `bson:"groupname"`
+	Appname   string `bson:"appname"`
+	Index     uint64 `bson:"index"`
+	FireTime  uint64 `bson:"fire_time"` //unix timestamp,second
+	Status    string `bson:"status"`
+	Result    string `bson:"result"` //the failed reason
+	Ctime     uint64 `bson:"ctime"`  //unix timestamp,second
+	Author    string `bson:"author"`
+}
+
+//Report is the version applied by one instance
+//one instance only has one report,the later one will replace the former one
+type Report struct {
+	Groupname string `bson:"groupname"`
+	Appname   string `bson:"appname"`
+	Instance  string `bson:"instance"`
+	OpNum     uint64 `bson:"op_num"`
+	Index     uint64 `bson:"index"`
+	Canary    bool   `bson:"canary"`
+	Error     string `bson:"error"`  //empty means success
+	Health    string `bson:"health"` //the app defined health error,empty means healthy
+	Utime     uint64 `bson:"utime"`  //unix timestamp,second
+}
+
+//Guard will rollback the app to the PrevIndex when too many instances failed on the OpNum
+//each app only has one guard,the later one will replace the former one
+type Guard struct {
+	Groupname   string `bson:"groupname"`
+	Appname     string `bson:"appname"`
+	OpNum       uint64 `bson:"op_num"` //the op_num this guard protects
+	Index       uint64 `bson:"index"`
+	PrevIndex   uint64 `bson:"prev_index"`
+	FailPercent uint32 `bson:"fail_percent"`
+	MinReports  uint32 `bson:"min_reports"`
+	Window      uint64 `bson:"window"` //seconds
+	Ctime       uint64 `bson:"ctime"`  //unix timestamp,second
+	Author      string `bson:"author"`
+}
+
+//Retention is the group's history retention policy
+//the version will be pruned when it's neither in the newest KeepNum versions nor younger than KeepTime
+//0 means not use that limit,both 0 means never prune
+type Retention struct {
+	Groupname string `bson:"groupname"`
+	KeepNum   uint32 `bson:"keep_num"`
+	KeepTime  uint64 `bson:"keep_time"` //seconds
+	Ctime     uint64 `bson:"ctime"`     //unix timestamp,second
+	Author    string `bson:"author"`
+}
+
+//Base is the group's base config,every app in the group inherits it
+//the app's config is deep merged into the base,the app's value overrides the base's,null deletes the base's key
+type Base struct {
+	Groupname    string `bson:"_id"`
+	AppConfig    string `bson:"app_config"`
+	SourceConfig string `bson:"source_config"`
+	OpNum        uint64 `bson:"op_num"` //+1 every time the base is changed
+	Ctime        uint64 `bson:"ctime"`  //unix timestamp,second
+	Author       string `bson:"author"`
+	Comment      string `bson:"comment"`
+}
+
+//Principal is one caller who can access the sconfig api with it's token
+type Principal struct {
+	Name   string `bson:"_id"`
+	Token  string `bson:"token"` //sha256 hex of the token's secret
+	Ctime  uint64 `bson:"ctime"` //unix timestamp,second
+	Author string `bson:"author"`
+}
+
+//Grant gives the principal one role on the scope
+//empty groupname means all groups,empty appname means all apps in the group
+//each principal only has one role on the same scope
+//reveal lets the principal see the redacted values on the scope
+type Grant struct {
+	Principal string `bson:"principal"`
+	Groupname string `bson:"groupname"`
+	Appname   string `bson:"appname"`
+	Role      string `bson:"role"`
+	Reveal    bool   `bson:"reveal"`
+	Ctime     uint64 `bson:"ctime"` //unix timestamp,second
+	Author    string `bson:"author"`
+}
+
+//ReadToken lets the app's sdk read the app's config without a principal
+//each app can have many tokens,so the old one can still be used for a while after rotation
+type ReadToken struct {
+	ID        string `bson:"_id"`
+	Groupname string `bson:"groupname"`
+	Appname   string `bson:"appname"`
+	Token     string `bson:"token"`  //sha256 hex of the token's secret
+	Expire    uint64 `bson:"expire"` //unix timestamp,second,0 means never expire
+	Comment   string `bson:"comment"`
+	Ctime     uint64 `bson:"ctime"` //unix timestamp,second
+	Author    string `bson:"author"`
+}
+
+//Recycle is one deleted app in the recycle bin
+type Recycle struct {
+	Groupname string `bson:"groupname"`
+	Appname   string `bson:"appname"`
+	Dtime     uint64 `bson:"dtime"` //unix timestamp,second
+}
+
+//Storage is the data operation interface of sconfig service
+//every db supported by sconfig service should implement this
+type Storage interface {
+	//prepare the storage,e.g. create the indexes,it is called once before the storage is used
+	Init(ctx context.Context) error
+	//return ErrNotExist when the app doesn't exist,is deleted or it only has drafts
+	GetInfo(ctx context.Context, groupname, appname string) (*Summary, *Config, error)
+	//return ErrNotExist when the index doesn't exist
+	GetConfig(ctx context.Context, groupname, appname string, index uint64) (*Config, error)
+	//create a new version and make it current,return the summary after this op
+	//config's index will be ignored,the new index will be set into it
+	//if config is a draft,only the max_index will be changed,the new version's index is the max_index in the returned summary
+	//if config is not a draft,the canary will be cleared
+	//if expectopnum is not 0,return ErrOpNumConflict when it's different from the current op_num
+	//return ErrDeleted when the app is deleted
+	SetConfig(ctx context.Context, groupname, appname string, config *Config, expectopnum uint64) (*Summary, error)
+	//create a new version from the current version and make it current,return the summary after this op
+	//if the config returned by update is a draft,only the max_index will be changed,otherwise the canary will be cleared
+	//read current and write new are in the same transaction
+	//update's param is the current config,nil means the app doesn't exist or it only has drafts
+	//update's error will be returned directly
+	//if expectopnum is not 0,return ErrOpNumConflict when it's different from the current op_num
+	//return ErrDeleted when the app is deleted
+	UpdateConfig(ctx context.Context, groupname, appname string, update func(*Config) (*Config, error), expectopnum uint64) (*Summary, error)
+	//return the summary after this op,the canary will be cleared
+	//return ErrNotExist when the index doesn't exist or the app is deleted
+	//if expectopnum is not 0,return ErrOpNumConflict when it's different from the current op_num
+	RollbackConfig(ctx context.Context, groupname, appname string, index, expectopnum uint64) (*Summary, error)
+	//same as RollbackConfig,and the index's draft flag will be cleared
+	PublishConfig(ctx context.Context, groupname, appname string, index, expectopnum uint64) (*Summary, error)
+	//set or clear(nil) the canary,the op_num will be increased,return the summary after this op
+	//return ErrNotExist when the app or the canary's index doesn't exist or the app is deleted
+	//if expectopnum is not 0,return ErrOpNumConflict when it's different from the current op_num
+	SetCanary(ctx context.Context, groupname, appname string, canary *Canary, expectopnum uint64) (*Summary, error)
+	//return the versions sorted by index desc and the total versions count
+	GetHistory(ctx context.Context, groupname, appname string, skip, limit uint64) ([]*Config, uint64, error)
+	//return all drafts sorted by index desc
+	GetDrafts(ctx context.Context, groupname, appname string) ([]*Config, error)
+	//return all versions sorted by index desc,app_config and source_config are not returned
+	GetVersions(ctx context.Context, groupname, appname string) ([]*Config, error)
+	//empty tag means remove the tag
+	//return ErrNotExist when the index doesn't exist
+	SetTag(ctx context.Context, groupname, appname string, index uint64, tag string) error
+	//delete the versions,the current and the canary's version will be skipped
+	//return the deleted indexes
+	DelConfigs(ctx context.Context, groupname, appname string, indexes []uint64) ([]uint64, error)
+	//the group which only has deleted apps will not be returned
+	GetGroups(ctx context.Context) ([]string, error)
+	//deleted apps will not be returned
+	GetApps(ctx context.Context, groupname string) ([]string, error)
+	//move the app into the recycle bin,all data is kept,the op_num will be increased and the canary will be cleared
+	//return the summary after this op
+	//return ErrNotExist when the app doesn't exist or it's already deleted
+	DelApp(ctx context.Context, groupname, appname string, dtime uint64) (*Summary, error)
+	//move the app out of the recycle bin,the op_num will be increased,return the summary after this op
+	//return ErrNotExist when the app is not deleted
+	RestoreApp(ctx context.Context, groupname, appname string) (*Summary, error)
+	//remove all data of the deleted app,include it's schemas,approvals,schedules,reports,guard and read tokens,audits are kept
+	//if dtime is not 0,the app will only be purged when it's dtime is dtime
+	//return ErrNotExist when the app is not deleted
+	PurgeApp(ctx context.Context, groupname, appname string, dtime uint64) error
+	//groupname can be empty,then all groups' deleted apps will be returned
+	//return the deleted apps sorted by dtime asc
+	GetRecycle(ctx context.Context, groupname string) ([]*Recycle, error)
+	//create a new version of schema,schema's index will be ignored,the new index will be set into it
+	SetSchema(ctx context.Context, groupname, appname string, schema *Schema) error
+	//index 0 means the newest
+	//return ErrNotExist when the schema doesn't exist
+	GetSchema(ctx context.Context, groupname, appname string, index uint64) (*Schema, error)
+	//policy's groupname will be used as the key
+	SetPolicy(ctx context.Context, policy *Policy) error
+	//return ErrNotExist when the policy doesn't exist
+	GetPolicy(ctx context.Context, groupname string) (*Policy, error)
+	//retention's groupname will be used as the key
+	SetRetention(ctx context.Context, retention *Retention) error
+	//return ErrNotExist when the retention doesn't exist
+	GetRetention(ctx context.Context, groupname string) (*Retention, error)
+	//return all groups' retentions
+	GetRetentions(ctx context.Context) ([]*Retention, error)
+	//base's groupname will be used as the key,the new op_num will be set into it
+	//expectopnum 0 means don't check the op_num,otherwise return ErrOpNumConflict when it doesn't match
+	SetBase(ctx context.Context, base *Base, expectopnum uint64) error
+	//return ErrNotExist when the base doesn't exist
+	GetBase(ctx context.Context, groupname string) (*Base, error)
+	//replace the reviewer's former decision on the same version
+	SetApproval(ctx context.Context, approval *Approval) error
+	//return all reviewers' decisions on this version
+	GetApprovals(ctx context.Context, groupname, appname string, index uint64) ([]*Approval, error)
+	//schedule's id will be ignored,the new id will be set into it
+	AddSchedule(ctx context.Context, schedule *Schedule) error
+	//return the pending and firing schedules sorted by fire_time asc
+	//appname can be empty,then all apps in this group will be returned
+	GetSchedules(ctx context.Context, groupname, appname string) ([]*Schedule, error)
+	//return all groups' pending and firing schedules whose fire_time is not after the firetime
+	GetDueSchedules(ctx context.Context, firetime uint64) ([]*Schedule, error)
+	//change the schedule's status only when it's current status is from,return the schedule after this op
+	//this is atomic,so only one caller can change the status from the same status
+	//return ErrNotExist when the schedule doesn't exist or it's status is not from
+	UpdateSchedule(ctx context.Context, groupname, appname, id, from, to, result string) (*Schedule, error)
+	//replace the instance's former report
+	SetReport(ctx context.Context, report *Report) error
+	//return all instances' reports sorted by instance
+	GetReports(ctx context.Context, groupname, appname string) ([]*Report, error)
+	//replace the app's former guard
+	SetGuard(ctx context.Context, guard *Guard) error
+	//return ErrNotExist when the guard doesn't exist
+	GetGuard(ctx context.Context, groupname, appname string) (*Guard, error)
+	//return all apps' guards
+	GetGuards(ctx context.Context) ([]*Guard, error)
+	//only delete the guard when it's op_num is the opnum
+	DelGuard(ctx context.Context, groupname, appname string, opnum uint64) error
+	//create the principal or replace it's token
+	SetPrincipal(ctx context.Context, principal *Principal) error
+	//return ErrNotExist when the principal doesn't exist
+	GetPrincipal(ctx context.Context, name string) (*Principal, error)
+	//the principal's grants will also be deleted
+	//return ErrNotExist when the principal doesn't exist
+	DelPrincipal(ctx context.Context, name string) error
+	//return all principals sorted by name
+	GetPrincipals(ctx context.Context) ([]*Principal, error)
+	//replace the principal's former role on the same scope
+	SetGrant(ctx context.Context, grant *Grant) error
+	//return ErrNotExist when the grant doesn't exist
+	DelGrant(ctx context.Context, principal, groupname, appname string) error
+	//principal and groupname can be empty,then that filter is not used
+	//return the grants sorted by principal,groupname and appname
+	GetGrants(ctx context.Context, principal, groupname string) ([]*Grant, error)
+	//token's id will be ignored,the new id will be set into it
+	AddReadToken(ctx context.Context, token *ReadToken) error
+	//return ErrNotExist when the token doesn't exist
+	GetReadToken(ctx context.Context, id string) (*ReadToken, error)
+	//return the app's tokens sorted by ctime asc
+	GetReadTokens(ctx context.Context, groupname, appname string) ([]*ReadToken, error)
+	//change the token's expire time,0 means never expire
+	//return ErrNotExist when the token doesn't exist
+	ExpireReadToken(ctx context.Context, groupname, appname, id string, expire uint64) error
+	//return ErrNotExist when the token doesn't exist
+	DelReadToken(ctx context.Context, groupname, appname, id string) error
+	//acquire or renew the lease,it will be expired after ttl seconds if it is not renewed
+	//return true when the owner holds the lease after this call
+	Lease(ctx context.Context, name, owner string, ttl uint64) (bool, error)
+	//audit log is append only
+	AddAudit(ctx context.Context, audit *Audit) error
+	//appname can be empty,then all apps in this group will be returned
+	//begin and end are unix timestamp(second),0 means no limit
+	//return the audits sorted by ctime desc and the total count
+	GetAudits(ctx context.Context, groupname, appname string, begin, end, skip, limit uint64) ([]*Audit, uint64, error)
+	//this is a block call until ctx is canceled or error happened
+	//update will be called at once with the current summary,config and canary config,and every time they changed
+	//empty Summary and Config will be passed to update when the app doesn't exist or is deleted
+	//canary config is nil when there is no canary
+	Watch(ctx context.Context, groupname, appname string, update func(summary *Summary, config *Config, canary *Config)) error
+	//this is a block call until ctx is canceled or error happened
+	//update will be called at once with the current base,and every time it changed
+	//empty Base will be passed to update when the base doesn't exist
+	WatchBase(ctx context.Context, groupname string, update func(base *Base)) error
 }
 
 //NewDao Dao is only a data operation layer
 //don't write business logic in this package
 //business logic should be written in service package
-func NewDao(sql *csql.DB, redis *credis.Pool, mongo *cmongo.Client) *Dao {
-	return &Dao{
-		sql:   sql,
-		redis: redis,
-		mongo: mongo,
+//mongo is preferred,sql will be used when mongo is nil
+func NewDao(sql *csql.DB, redis *credis.Pool, mongo *cmongo.Client) Storage {
+	if mongo != nil {
+		return &mongoDao{mongo: mongo}
 	}
+	return &sqlDao{sql: sql}
 }
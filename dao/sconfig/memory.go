@@ -0,0 +1,1018 @@
+package sconfig
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+//memoryDao keeps all data in memory,all data will be lost when the process exit
+//this is used for local develop and unit test
+type memoryDao struct {
+	sync.Mutex
+	groups     map[string]map[string]*memoryApp
+	schemas    map[string][]*Schema   //key groupname.appname
+	policies   map[string]*Policy     //key groupname
+	approvals  map[string][]*Approval //key groupname.appname
+	schedules  []*Schedule
+	reports    map[string]map[string]*Report //key groupname.appname,sub key instance
+	guards     map[string]*Guard             //key groupname.appname
+	retentions map[string]*Retention         //key groupname
+	bases      map[string]*memoryBase        //key groupname
+	leases     map[string]*memoryLease       //key lease name
+	principals map[string]*Principal         //key name
+	grants     []*Grant
+	tokens     map[string]*ReadToken //key id
+	audits     []*Audit
+}
+
+type memoryBase struct {
+	//nil means the base doesn't exist,it only has watchers
+	base *Base
+	//closed and replaced every time the base changed
+	notice chan struct{}
+}
+
+type memoryLease struct {
+	owner  string
+	expire uint64
+}
+
+type memoryApp struct {
+	//nil means this app doesn't exist,it only has watchers
+	summary *Summary
+	configs map[uint64]*Config
+	//closed and replaced every time the summary changed
+	notice chan struct{}
+}
+
+//NewMemoryDao create a Storage which keeps all data in memory
+//if path is not empty,the data will be seeded from the path's sub dirs
+//the seed dir's struct should be: path/<groupname>/<appname>/AppConfig.json and SourceConfig.json
+//AppConfig.json and SourceConfig.json can be missing,"{}" will be used
+func NewMemoryDao(path string) (Storage, error) {
+	d := &memoryDao{
+		groups:     make(map[string]map[string]*memoryApp),
+		schemas:    make(map[string][]*Schema),
+		policies:   make(map[string]*Policy),
+		approvals:  make(map[string][]*Approval),
+		reports:    make(map[string]map[string]*Report),
+		guards:     make(map[string]*Guard),
+		retentions: make(map[string]*Retention),
+		bases:      make(map[string]*memoryBase),
+		leases:     make(map[string]*memoryLease),
+		principals: make(map[string]*Principal),
+		tokens:     make(map[string]*ReadToken),
+	}
+	if path == "" {
+		return d, nil
+	}
+	groups, e := os.ReadDir(path)
+	if e != nil {
+		return nil, e
+	}
+	for _, group := range groups {
+		if !group.IsDir() {
+			continue
+		}
+		apps, e := os.ReadDir(filepath.Join(path, group.Name()))
+		if e != nil {
+			return nil, e
+		}
+		for _, app := range apps {
+			if !app.IsDir() {
+				continue
+			}
+			appconfig, e := readSeedFile(filepath.Join(path, group.Name(), app.Name(), "AppConfig.json"))
+			if e != nil {
+				return nil, e
+			}
+			sourceconfig, e := readSeedFile(filepath.Join(path, group.Name(), app.Name(), "SourceConfig.json"))
+			if e != nil {
+				return nil, e
+			}
+			if _, e = d.SetConfig(context.Background(), group.Name(), app.Name(), &Config{
+				AppConfig:    appconfig,
+				SourceConfig: sourceconfig,
+				Ctime:        uint64(time.Now().Unix()),
+				Author:       "seed",
+				Comment:      "seed from " + path,
+			}, 0); e != nil {
+				return nil, e
+			}
+		}
+	}
+	return d, nil
+}
+
+func readSeedFile(file string) (string, error) {
+	data, e := os.ReadFile(file)
+	if e != nil {
+		if os.IsNotExist(e) {
+			return "{}", nil
+		}
+		return "", e
+	}
+	if !json.Valid(data) {
+		return "", errors.New("[sconfig.dao] seed file: " + file + " format error")
+	}
+	return string(data), nil
+}
+
+//must be called with lock
+func (d *memoryDao) getApp(groupname, appname string, create bool) *memoryApp {
+	group, ok := d.groups[groupname]
+	if !ok {
+		if !create {
+			return nil
+		}
+		group = make(map[string]*memoryApp)
+		d.groups[groupname] = group
+	}
+	app, ok := group[appname]
+	if !ok && create {
+		app = &memoryApp{configs: make(map[uint64]*Config), notice: make(chan struct{})}
+		group[appname] = app
+	}
+	return app
+}
+
+//must be called with lock
+func (app *memoryApp) changed() {
+	close(app.notice)
+	app.notice = make(chan struct{})
+}
+
+//must be called with lock
+func (app *memoryApp) set(config *Config) *Summary {
+	if app.summary == nil {
+		app.summary = &Summary{}
+	}
+	app.summary.MaxIndex++
+	config.Index = app.summary.MaxIndex
+	tmp := *config
+	app.configs[tmp.Index] = &tmp
+	//draft is not live,nothing changed for the watchers
+	if !config.Draft {
+		app.summary.PrevIndex = app.summary.CurIndex
+		app.summary.CurIndex = app.summary.MaxIndex
+		app.summary.OpNum++
+		app.summary.Canary = nil
+		app.changed()
+	}
+	summary := *app.summary
+	return &summary
+}
+
+func (d *memoryDao) Init(ctx context.Context) error {
+	return nil
+}
+
+func (d *memoryDao) GetInfo(ctx context.Context, groupname, appname string) (*Summary, *Config, error) {
+	d.Lock()
+	defer d.Unlock()
+	app := d.getApp(groupname, appname, false)
+	if app == nil || app.summary == nil || app.summary.CurIndex == 0 || app.summary.Dtime != 0 {
+		return nil, nil, ErrNotExist
+	}
+	summary := *app.summary
+	config := *app.configs[summary.CurIndex]
+	return &summary, &config, nil
+}
+
+func (d *memoryDao) GetConfig(ctx context.Context, groupname, appname string, index uint64) (*Config, error) {
+	d.Lock()
+	defer d.Unlock()
+	app := d.getApp(groupname, appname, false)
+	if app == nil {
+		return nil, ErrNotExist
+	}
+	config, ok := app.configs[index]
+	if !ok {
+		return nil, ErrNotExist
+	}
+	tmp := *config
+	return &tmp, nil
+}
+
+func (d *memoryDao) SetConfig(ctx context.Context, groupname, appname string, config *Config, expectopnum uint64) (*Summary, error) {
+	d.Lock()
+	defer d.Unlock()
+	app := d.getApp(groupname, appname, true)
+	if expectopnum != 0 && (app.summary == nil || app.summary.OpNum != expectopnum) {
+		return nil, ErrOpNumConflict
+	}
+	if app.summary != nil && app.summary.Dtime != 0 {
+		return nil, ErrDeleted
+	}
+	return app.set(config), nil
+}
+
+func (d *memoryDao) UpdateConfig(ctx context.Context, groupname, appname string, update func(*Config) (*Config, error), expectopnum uint64) (*Summary, error) {
+	d.Lock()
+	defer d.Unlock()
+	app := d.getApp(groupname, appname, true)
+	if expectopnum != 0 && (app.summary == nil || app.summary.OpNum != expectopnum) {
+		return nil, ErrOpNumConflict
+	}
+	if app.summary != nil && app.summary.Dtime != 0 {
+		return nil, ErrDeleted
+	}
+	var current *Config
+	if app.summary != nil && app.summary.CurIndex != 0 {
+		tmp := *app.configs[app.summary.CurIndex]
+		current = &tmp
+	}
+	config, e := update(current)
+	if e != nil {
+		return nil, e
+	}
+	return app.set(config), nil
+}
+
+func (d *memoryDao) RollbackConfig(ctx context.Context, groupname, appname string, index, expectopnum uint64) (*Summary, error) {
+	d.Lock()
+	defer d.Unlock()
+	return d.rollback(groupname, appname, index, expectopnum, false)
+}
+
+func (d *memoryDao) PublishConfig(ctx context.Context, groupname, appname string, index, expectopnum uint64) (*Summary, error) {
+	d.Lock()
+	defer d.Unlock()
+	return d.rollback(groupname, appname, index, expectopnum, true)
+}
+
+//must be called with lock
+func (d *memoryDao) rollback(groupname, appname string, index, expectopnum uint64, publish bool) (*Summary, error) {
+	app := d.getApp(groupname, appname, false)
+	if app == nil || app.summary == nil || app.summary.Dtime != 0 {
+		return nil, ErrNotExist
+	}
+	config, ok := app.configs[index]
+	if !ok {
+		return nil, ErrNotExist
+	}
+	if expectopnum != 0 && app.summary.OpNum != expectopnum {
+		return nil, ErrOpNumConflict
+	}
+	if publish {
+		config.Draft = false
+	}
+	app.summary.PrevIndex = app.summary.CurIndex
+	app.summary.CurIndex = index
+	app.summary.OpNum++
+	app.summary.Canary = nil
+	app.changed()
+	summary := *app.summary
+	return &summary, nil
+}
+
+func (d *memoryDao) SetCanary(ctx context.Context, groupname, appname string, canary *Canary, expectopnum uint64) (*Summary, error) {
+	d.Lock()
+	defer d.Unlock()
+	app := d.getApp(groupname, appname, false)
+	if app == nil || app.summary == nil || app.summary.CurIndex == 0 || app.summary.Dtime != 0 {
+		return nil, ErrNotExist
+	}
+	if canary != nil {
+		if _, ok := app.configs[canary.Index]; !ok {
+			return nil, ErrNotExist
+		}
+	}
+	if expectopnum != 0 && app.summary.OpNum != expectopnum {
+		return nil, ErrOpNumConflict
+	}
+	//the summary is copied when it's returned,so the canary must be replaced instead of modified
+	if canary != nil {
+		tmp := *canary
+		canary = &tmp
+	}
+	app.summary.Canary = canary
+	app.summary.OpNum++
+	app.changed()
+	summary := *app.summary
+	return &summary, nil
+}
+
+func (d *memoryDao) GetHistory(ctx context.Context, groupname, appname string, skip, limit uint64) ([]*Config, uint64, error) {
+	d.Lock()
+	defer d.Unlock()
+	app := d.getApp(groupname, appname, false)
+	if app == nil {
+		return []*Config{}, 0, nil
+	}
+	indexes := make([]uint64, 0, len(app.configs))
+	for index := range app.configs {
+		indexes = append(indexes, index)
+	}
+	sort.Slice(indexes, func(i, j int) bool { return indexes[i] > indexes[j] })
+	result := make([]*Config, 0, limit)
+	for i := skip; i < uint64(len(indexes)) && i < skip+limit; i++ {
+		tmp := *app.configs[indexes[i]]
+		result = append(result, &tmp)
+	}
+	return result, uint64(len(indexes)), nil
+}
+
+func (d *memoryDao) GetDrafts(ctx context.Context, groupname, appname string) ([]*Config, error) {
+	d.Lock()
+	defer d.Unlock()
+	result := make([]*Config, 0)
+	app := d.getApp(groupname, appname, false)
+	if app == nil {
+		return result, nil
+	}
+	for _, config := range app.configs {
+		if config.Draft {
+			tmp := *config
+			result = append(result, &tmp)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Index > result[j].Index })
+	return result, nil
+}
+
+func (d *memoryDao) GetVersions(ctx context.Context, groupname, appname string) ([]*Config, error) {
+	d.Lock()
+	defer d.Unlock()
+	result := make([]*Config, 0)
+	app := d.getApp(groupname, appname, false)
+	if app == nil {
+		return result, nil
+	}
+	for _, config := range app.configs {
+		tmp := *config
+		tmp.AppConfig = ""
+		tmp.SourceConfig = ""
+		result = append(result, &tmp)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Index > result[j].Index })
+	return result, nil
+}
+
+func (d *memoryDao) SetTag(ctx context.Context, groupname, appname string, index uint64, tag string) error {
+	d.Lock()
+	defer d.Unlock()
+	app := d.getApp(groupname, appname, false)
+	if app == nil {
+		return ErrNotExist
+	}
+	config, ok := app.configs[index]
+	if !ok {
+		return ErrNotExist
+	}
+	config.Tag = tag
+	return nil
+}
+
+func (d *memoryDao) DelConfigs(ctx context.Context, groupname, appname string, indexes []uint64) ([]uint64, error) {
+	d.Lock()
+	defer d.Unlock()
+	result := make([]uint64, 0, len(indexes))
+	app := d.getApp(groupname, appname, false)
+	if app == nil || app.summary == nil {
+		return result, nil
+	}
+	for _, index := range indexes {
+		if index == app.summary.CurIndex || (app.summary.Canary != nil && index == app.summary.Canary.Index) {
+			continue
+		}
+		if _, ok := app.configs[index]; ok {
+			delete(app.configs, index)
+			result = append(result, index)
+		}
+	}
+	return result, nil
+}
+
+func (d *memoryDao) GetGroups(ctx context.Context) ([]string, error) {
+	d.Lock()
+	defer d.Unlock()
+	result := make([]string, 0, len(d.groups))
+	for groupname, group := range d.groups {
+		for _, app := range group {
+			if app.summary != nil && app.summary.Dtime == 0 {
+				result = append(result, groupname)
+				break
+			}
+		}
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+func (d *memoryDao) GetApps(ctx context.Context, groupname string) ([]string, error) {
+	d.Lock()
+	defer d.Unlock()
+	result := make([]string, 0)
+	for appname, app := range d.groups[groupname] {
+		if app.summary != nil && app.summary.Dtime == 0 {
+			result = append(result, appname)
+		}
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+func (d *memoryDao) DelApp(ctx context.Context, groupname, appname string, dtime uint64) (*Summary, error) {
+	d.Lock()
+	defer d.Unlock()
+	app := d.getApp(groupname, appname, false)
+	if app == nil || app.summary == nil || app.summary.Dtime != 0 {
+		return nil, ErrNotExist
+	}
+	app.summary.Dtime = dtime
+	app.summary.OpNum++
+	app.summary.Canary = nil
+	app.changed()
+	summary := *app.summary
+	return &summary, nil
+}
+
+func (d *memoryDao) RestoreApp(ctx context.Context, groupname, appname string) (*Summary, error) {
+	d.Lock()
+	defer d.Unlock()
+	app := d.getApp(groupname, appname, false)
+	if app == nil || app.summary == nil || app.summary.Dtime == 0 {
+		return nil, ErrNotExist
+	}
+	app.summary.Dtime = 0
+	app.summary.OpNum++
+	app.changed()
+	summary := *app.summary
+	return &summary, nil
+}
+
+func (d *memoryDao) PurgeApp(ctx context.Context, groupname, appname string, dtime uint64) error {
+	d.Lock()
+	defer d.Unlock()
+	app := d.getApp(groupname, appname, false)
+	if app == nil || app.summary == nil || app.summary.Dtime == 0 || (dtime != 0 && app.summary.Dtime != dtime) {
+		return ErrNotExist
+	}
+	//keep the app,it may has watchers
+	app.summary = nil
+	app.configs = make(map[uint64]*Config)
+	app.changed()
+	key := groupname + "." + appname
+	delete(d.schemas, key)
+	delete(d.approvals, key)
+	delete(d.reports, key)
+	delete(d.guards, key)
+	schedules := make([]*Schedule, 0, len(d.schedules))
+	for _, schedule := range d.schedules {
+		if schedule.Groupname != groupname || schedule.Appname != appname {
+			schedules = append(schedules, schedule)
+		}
+	}
+	d.schedules = schedules
+	for id, token := range d.tokens {
+		if token.Groupname == groupname && token.Appname == appname {
+			delete(d.tokens, id)
+		}
+	}
+	return nil
+}
+
+func (d *memoryDao) GetRecycle(ctx context.Context, groupname string) ([]*Recycle, error) {
+	d.Lock()
+	defer d.Unlock()
+	result := make([]*Recycle, 0)
+	for g, group := range d.groups {
+		if groupname != "" && g != groupname {
+			continue
+		}
+		for a, app := range group {
+			if app.summary != nil && app.summary.Dtime != 0 {
+				result = append(result, &Recycle{Groupname: g, Appname: a, Dtime: app.summary.Dtime})
+			}
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Dtime != result[j].Dtime {
+			return result[i].Dtime < result[j].Dtime
+		}
+		if result[i].Groupname != result[j].Groupname {
+			return result[i].Groupname < result[j].Groupname
+		}
+		return result[i].Appname < result[j].Appname
+	})
+	return result, nil
+}
+
+func (d *memoryDao) SetSchema(ctx context.Context, groupname, appname string, schema *Schema) error {
+	d.Lock()
+	defer d.Unlock()
+	schemas := d.schemas[groupname+"."+appname]
+	schema.Index = uint64(len(schemas)) + 1
+	tmp := *schema
+	d.schemas[groupname+"."+appname] = append(schemas, &tmp)
+	return nil
+}
+
+func (d *memoryDao) GetSchema(ctx context.Context, groupname, appname string, index uint64) (*Schema, error) {
+	d.Lock()
+	defer d.Unlock()
+	schemas := d.schemas[groupname+"."+appname]
+	if index == 0 {
+		index = uint64(len(schemas))
+	}
+	if index == 0 || index > uint64(len(schemas)) {
+		return nil, ErrNotExist
+	}
+	tmp := *schemas[index-1]
+	return &tmp, nil
+}
+
+func (d *memoryDao) SetPolicy(ctx context.Context, policy *Policy) error {
+	d.Lock()
+	defer d.Unlock()
+	tmp := *policy
+	tmp.Reviewers = append([]string(nil), policy.Reviewers...)
+	d.policies[policy.Groupname] = &tmp
+	return nil
+}
+
+func (d *memoryDao) GetPolicy(ctx context.Context, groupname string) (*Policy, error) {
+	d.Lock()
+	defer d.Unlock()
+	policy, ok := d.policies[groupname]
+	if !ok {
+		return nil, ErrNotExist
+	}
+	tmp := *policy
+	tmp.Reviewers = append([]string(nil), policy.Reviewers...)
+	return &tmp, nil
+}
+
+func (d *memoryDao) SetRetention(ctx context.Context, retention *Retention) error {
+	d.Lock()
+	defer d.Unlock()
+	tmp := *retention
+	d.retentions[retention.Groupname] = &tmp
+	return nil
+}
+
+func (d *memoryDao) GetRetention(ctx context.Context, groupname string) (*Retention, error) {
+	d.Lock()
+	defer d.Unlock()
+	retention, ok := d.retentions[groupname]
+	if !ok {
+		return nil, ErrNotExist
+	}
+	tmp := *retention
+	return &tmp, nil
+}
+
+func (d *memoryDao) GetRetentions(ctx context.Context) ([]*Retention, error) {
+	d.Lock()
+	defer d.Unlock()
+	result := make([]*Retention, 0, len(d.retentions))
+	for _, retention := range d.retentions {
+		tmp := *retention
+		result = append(result, &tmp)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Groupname < result[j].Groupname })
+	return result, nil
+}
+
+//must be called with lock
+func (d *memoryDao) getBase(groupname string) *memoryBase {
+	base, ok := d.bases[groupname]
+	if !ok {
+		base = &memoryBase{notice: make(chan struct{})}
+		d.bases[groupname] = base
+	}
+	return base
+}
+
+func (d *memoryDao) SetBase(ctx context.Context, base *Base, expectopnum uint64) error {
+	d.Lock()
+	defer d.Unlock()
+	b := d.getBase(base.Groupname)
+	var opnum uint64
+	if b.base != nil {
+		opnum = b.base.OpNum
+	}
+	if expectopnum != 0 && opnum != expectopnum {
+		return ErrOpNumConflict
+	}
+	base.OpNum = opnum + 1
+	tmp := *base
+	b.base = &tmp
+	close(b.notice)
+	b.notice = make(chan struct{})
+	return nil
+}
+
+func (d *memoryDao) GetBase(ctx context.Context, groupname string) (*Base, error) {
+	d.Lock()
+	defer d.Unlock()
+	b, ok := d.bases[groupname]
+	if !ok || b.base == nil {
+		return nil, ErrNotExist
+	}
+	tmp := *b.base
+	return &tmp, nil
+}
+
+func (d *memoryDao) SetApproval(ctx context.Context, approval *Approval) error {
+	d.Lock()
+	defer d.Unlock()
+	key := approval.Groupname + "." + approval.Appname
+	tmp := *approval
+	for i, v := range d.approvals[key] {
+		if v.Index == approval.Index && v.Reviewer == approval.Reviewer {
+			d.approvals[key][i] = &tmp
+			return nil
+		}
+	}
+	d.approvals[key] = append(d.approvals[key], &tmp)
+	return nil
+}
+
+func (d *memoryDao) GetApprovals(ctx context.Context, groupname, appname string, index uint64) ([]*Approval, error) {
+	d.Lock()
+	defer d.Unlock()
+	result := make([]*Approval, 0)
+	for _, v := range d.approvals[groupname+"."+appname] {
+		if v.Index == index {
+			tmp := *v
+			result = append(result, &tmp)
+		}
+	}
+	return result, nil
+}
+
+func (d *memoryDao) AddSchedule(ctx context.Context, schedule *Schedule) error {
+	d.Lock()
+	defer d.Unlock()
+	schedule.ID = primitive.NewObjectID().Hex()
+	tmp := *schedule
+	d.schedules = append(d.schedules, &tmp)
+	return nil
+}
+
+func (d *memoryDao) GetSchedules(ctx context.Context, groupname, appname string) ([]*Schedule, error) {
+	d.Lock()
+	defer d.Unlock()
+	result := make([]*Schedule, 0)
+	for _, schedule := range d.schedules {
+		if (schedule.Status == SchedulePending || schedule.Status == ScheduleFiring) && schedule.Groupname == groupname && (appname == "" || schedule.Appname == appname) {
+			tmp := *schedule
+			result = append(result, &tmp)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool { return result[i].FireTime < result[j].FireTime })
+	return result, nil
+}
+
+func (d *memoryDao) GetDueSchedules(ctx context.Context, firetime uint64) ([]*Schedule, error) {
+	d.Lock()
+	defer d.Unlock()
+	result := make([]*Schedule, 0)
+	for _, schedule := range d.schedules {
+		if (schedule.Status == SchedulePending || schedule.Status == ScheduleFiring) && schedule.FireTime <= firetime {
+			tmp := *schedule
+			result = append(result, &tmp)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool { return result[i].FireTime < result[j].FireTime })
+	return result, nil
+}
+
+func (d *memoryDao) UpdateSchedule(ctx context.Context, groupname, appname, id, from, to, result string) (*Schedule, error) {
+	d.Lock()
+	defer d.Unlock()
+	for _, schedule := range d.schedules {
+		if schedule.ID == id && schedule.Groupname == groupname && schedule.Appname == appname && schedule.Status == from {
+			schedule.Status = to
+			schedule.Result = result
+			tmp := *schedule
+			return &tmp, nil
+		}
+	}
+	return nil, ErrNotExist
+}
+
+func (d *memoryDao) SetReport(ctx context.Context, report *Report) error {
+	d.Lock()
+	defer d.Unlock()
+	reports, ok := d.reports[report.Groupname+"."+report.Appname]
+	if !ok {
+		reports = make(map[string]*Report)
+		d.reports[report.Groupname+"."+report.Appname] = reports
+	}
+	tmp := *report
+	reports[report.Instance] = &tmp
+	return nil
+}
+
+func (d *memoryDao) GetReports(ctx context.Context, groupname, appname string) ([]*Report, error) {
+	d.Lock()
+	defer d.Unlock()
+	result := make([]*Report, 0, len(d.reports[groupname+"."+appname]))
+	for _, report := range d.reports[groupname+"."+appname] {
+		tmp := *report
+		result = append(result, &tmp)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Instance < result[j].Instance })
+	return result, nil
+}
+
+func (d *memoryDao) SetGuard(ctx context.Context, guard *Guard) error {
+	d.Lock()
+	defer d.Unlock()
+	tmp := *guard
+	d.guards[guard.Groupname+"."+guard.Appname] = &tmp
+	return nil
+}
+
+func (d *memoryDao) GetGuard(ctx context.Context, groupname, appname string) (*Guard, error) {
+	d.Lock()
+	defer d.Unlock()
+	guard, ok := d.guards[groupname+"."+appname]
+	if !ok {
+		return nil, ErrNotExist
+	}
+	tmp := *guard
+	return &tmp, nil
+}
+
+func (d *memoryDao) GetGuards(ctx context.Context) ([]*Guard, error) {
+	d.Lock()
+	defer d.Unlock()
+	result := make([]*Guard, 0, len(d.guards))
+	for _, guard := range d.guards {
+		tmp := *guard
+		result = append(result, &tmp)
+	}
+	return result, nil
+}
+
+func (d *memoryDao) DelGuard(ctx context.Context, groupname, appname string, opnum uint64) error {
+	d.Lock()
+	defer d.Unlock()
+	if guard, ok := d.guards[groupname+"."+appname]; ok && guard.OpNum == opnum {
+		delete(d.guards, groupname+"."+appname)
+	}
+	return nil
+}
+
+func (d *memoryDao) SetPrincipal(ctx context.Context, principal *Principal) error {
+	d.Lock()
+	defer d.Unlock()
+	tmp := *principal
+	d.principals[principal.Name] = &tmp
+	return nil
+}
+
+func (d *memoryDao) GetPrincipal(ctx context.Context, name string) (*Principal, error) {
+	d.Lock()
+	defer d.Unlock()
+	principal, ok := d.principals[name]
+	if !ok {
+		return nil, ErrNotExist
+	}
+	tmp := *principal
+	return &tmp, nil
+}
+
+func (d *memoryDao) DelPrincipal(ctx context.Context, name string) error {
+	d.Lock()
+	defer d.Unlock()
+	if _, ok := d.principals[name]; !ok {
+		return ErrNotExist
+	}
+	delete(d.principals, name)
+	grants := make([]*Grant, 0, len(d.grants))
+	for _, grant := range d.grants {
+		if grant.Principal != name {
+			grants = append(grants, grant)
+		}
+	}
+	d.grants = grants
+	return nil
+}
+
+func (d *memoryDao) GetPrincipals(ctx context.Context) ([]*Principal, error) {
+	d.Lock()
+	defer d.Unlock()
+	result := make([]*Principal, 0, len(d.principals))
+	for _, principal := range d.principals {
+		tmp := *principal
+		result = append(result, &tmp)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result, nil
+}
+
+func (d *memoryDao) SetGrant(ctx context.Context, grant *Grant) error {
+	d.Lock()
+	defer d.Unlock()
+	tmp := *grant
+	for i, v := range d.grants {
+		if v.Principal == grant.Principal && v.Groupname == grant.Groupname && v.Appname == grant.Appname {
+			d.grants[i] = &tmp
+			return nil
+		}
+	}
+	d.grants = append(d.grants, &tmp)
+	return nil
+}
+
+func (d *memoryDao) DelGrant(ctx context.Context, principal, groupname, appname string) error {
+	d.Lock()
+	defer d.Unlock()
+	for i, v := range d.grants {
+		if v.Principal == principal && v.Groupname == groupname && v.Appname == appname {
+			d.grants = append(d.grants[:i], d.grants[i+1:]...)
+			return nil
+		}
+	}
+	return ErrNotExist
+}
+
+func (d *memoryDao) GetGrants(ctx context.Context, principal, groupname string) ([]*Grant, error) {
+	d.Lock()
+	defer d.Unlock()
+	result := make([]*Grant, 0)
+	for _, grant := range d.grants {
+		if (principal == "" || grant.Principal == principal) && (groupname == "" || grant.Groupname == groupname) {
+			tmp := *grant
+			result = append(result, &tmp)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Principal != result[j].Principal {
+			return result[i].Principal < result[j].Principal
+		}
+		if result[i].Groupname != result[j].Groupname {
+			return result[i].Groupname < result[j].Groupname
+		}
+		return result[i].Appname < result[j].Appname
+	})
+	return result, nil
+}
+
+func (d *memoryDao) AddReadToken(ctx context.Context, token *ReadToken) error {
+	d.Lock()
+	defer d.Unlock()
+	token.ID = primitive.NewObjectID().Hex()
+	tmp := *token
+	d.tokens[token.ID] = &tmp
+	return nil
+}
+
+func (d *memoryDao) GetReadToken(ctx context.Context, id string) (*ReadToken, error) {
+	d.Lock()
+	defer d.Unlock()
+	token, ok := d.tokens[id]
+	if !ok {
+		return nil, ErrNotExist
+	}
+	tmp := *token
+	return &tmp, nil
+}
+
+func (d *memoryDao) GetReadTokens(ctx context.Context, groupname, appname string) ([]*ReadToken, error) {
+	d.Lock()
+	defer d.Unlock()
+	result := make([]*ReadToken, 0)
+	for _, token := range d.tokens {
+		if token.Groupname == groupname && token.Appname == appname {
+			tmp := *token
+			result = append(result, &tmp)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Ctime != result[j].Ctime {
+			return result[i].Ctime < result[j].Ctime
+		}
+		return result[i].ID < result[j].ID
+	})
+	return result, nil
+}
+
+func (d *memoryDao) ExpireReadToken(ctx context.Context, groupname, appname, id string, expire uint64) error {
+	d.Lock()
+	defer d.Unlock()
+	token, ok := d.tokens[id]
+	if !ok || token.Groupname != groupname || token.Appname != appname {
+		return ErrNotExist
+	}
+	token.Expire = expire
+	return nil
+}
+
+func (d *memoryDao) DelReadToken(ctx context.Context, groupname, appname, id string) error {
+	d.Lock()
+	defer d.Unlock()
+	token, ok := d.tokens[id]
+	if !ok || token.Groupname != groupname || token.Appname != appname {
+		return ErrNotExist
+	}
+	delete(d.tokens, id)
+	return nil
+}
+
+func (d *memoryDao) Lease(ctx context.Context, name, owner string, ttl uint64) (bool, error) {
+	d.Lock()
+	defer d.Unlock()
+	now := uint64(time.Now().Unix())
+	lease, ok := d.leases[name]
+	if ok && lease.owner != owner && lease.expire >= now {
+		return false, nil
+	}
+	d.leases[name] = &memoryLease{owner: owner, expire: now + ttl}
+	return true, nil
+}
+
+func (d *memoryDao) AddAudit(ctx context.Context, audit *Audit) error {
+	d.Lock()
+	defer d.Unlock()
+	tmp := *audit
+	d.audits = append(d.audits, &tmp)
+	return nil
+}
+
+func (d *memoryDao) GetAudits(ctx context.Context, groupname, appname string, begin, end, skip, limit uint64) ([]*Audit, uint64, error) {
+	d.Lock()
+	defer d.Unlock()
+	result := make([]*Audit, 0, limit)
+	total := uint64(0)
+	//audits are appended in time order,so range from the tail
+	for i := len(d.audits) - 1; i >= 0; i-- {
+		audit := d.audits[i]
+		if audit.Groupname != groupname || (appname != "" && audit.Appname != appname) {
+			continue
+		}
+		if (begin != 0 && audit.Ctime < begin) || (end != 0 && audit.Ctime > end) {
+			continue
+		}
+		if total >= skip && total < skip+limit {
+			tmp := *audit
+			result = append(result, &tmp)
+		}
+		total++
+	}
+	return result, total, nil
+}
+
+func (d *memoryDao) Watch(ctx context.Context, groupname, appname string, update func(*Summary, *Config, *Config)) error {
+	curop := uint64(0)
+	first := true
+	for {
+		d.Lock()
+		app := d.getApp(groupname, appname, true)
+		summary := &Summary{}
+		config := &Config{}
+		var canary *Config
+		if app.summary != nil && app.summary.CurIndex != 0 && app.summary.Dtime == 0 {
+			*summary = *app.summary
+			*config = *app.configs[app.summary.CurIndex]
+			if summary.Canary != nil {
+				tmp := *app.configs[summary.Canary.Index]
+				canary = &tmp
+			}
+		}
+		notice := app.notice
+		d.Unlock()
+		if first || summary.OpNum != curop {
+			update(summary, config, canary)
+			curop = summary.OpNum
+		}
+		first = false
+		select {
+		case <-notice:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (d *memoryDao) WatchBase(ctx context.Context, groupname string, update func(*Base)) error {
+	for {
+		d.Lock()
+		b := d.getBase(groupname)
+		base := &Base{}
+		if b.base != nil {
+			*base = *b.base
+		}
+		notice := b.notice
+		d.Unlock()
+		update(base)
+		select {
+		case <-notice:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
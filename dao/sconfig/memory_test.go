@@ -0,0 +1,175 @@
+package sconfig
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func newTestDao(t *testing.T) Storage {
+	d, e := NewMemoryDao("")
+	if e != nil {
+		t.Fatal(e)
+	}
+	return d
+}
+
+func TestMemoryConfig(t *testing.T) {
+	ctx := context.Background()
+	d := newTestDao(t)
+	if _, _, e := d.GetInfo(ctx, "g", "a"); e != ErrNotExist {
+		t.Fatalf("get info of the new app error: %v", e)
+	}
+	steps := []struct {
+		name   string
+		op     func() (*Summary, error)
+		err    error
+		cur    uint64
+		max    uint64
+		opnum  uint64
+		config string //the current app config after this step
+	}{
+		{"set", func() (*Summary, error) {
+			return d.SetConfig(ctx, "g", "a", &Config{AppConfig: `{"v":1}`, SourceConfig: "{}"}, 0)
+		}, nil, 1, 1, 1, `{"v":1}`},
+		{"draft", func() (*Summary, error) {
+			return d.SetConfig(ctx, "g", "a", &Config{AppConfig: `{"v":2}`, SourceConfig: "{}", Draft: true}, 0)
+		}, nil, 1, 2, 1, `{"v":1}`},
+		{"conflict", func() (*Summary, error) {
+			return d.SetConfig(ctx, "g", "a", &Config{AppConfig: `{"v":3}`, SourceConfig: "{}"}, 5)
+		}, ErrOpNumConflict, 1, 2, 1, `{"v":1}`},
+		{"update", func() (*Summary, error) {
+			return d.UpdateConfig(ctx, "g", "a", func(current *Config) (*Config, error) {
+				return &Config{AppConfig: `{"v":3}`, SourceConfig: current.SourceConfig}, nil
+			}, 1)
+		}, nil, 3, 3, 2, `{"v":3}`},
+		{"publish draft", func() (*Summary, error) {
+			return d.PublishConfig(ctx, "g", "a", 2, 0)
+		}, nil, 2, 3, 3, `{"v":2}`},
+		{"rollback", func() (*Summary, error) {
+			return d.RollbackConfig(ctx, "g", "a", 1, 0)
+		}, nil, 1, 3, 4, `{"v":1}`},
+		{"rollback unknown", func() (*Summary, error) {
+			return d.RollbackConfig(ctx, "g", "a", 9, 0)
+		}, ErrNotExist, 1, 3, 4, `{"v":1}`},
+	}
+	for _, step := range steps {
+		sum, e := step.op()
+		if e != step.err {
+			t.Fatalf("%s error: %v,want %v", step.name, e, step.err)
+		}
+		if e == nil && (sum.CurIndex != step.cur || sum.MaxIndex != step.max || sum.OpNum != step.opnum) {
+			t.Fatalf("%s summary: %+v", step.name, sum)
+		}
+		sum, conf, e := d.GetInfo(ctx, "g", "a")
+		if e != nil {
+			t.Fatalf("%s get info error: %v", step.name, e)
+		}
+		if sum.CurIndex != step.cur || sum.MaxIndex != step.max || sum.OpNum != step.opnum || conf.AppConfig != step.config {
+			t.Fatalf("%s info: %+v %s", step.name, sum, conf.AppConfig)
+		}
+	}
+	conf, e := d.GetConfig(ctx, "g", "a", 2)
+	if e != nil || conf.Draft {
+		t.Fatalf("published draft: %+v,%v", conf, e)
+	}
+	if _, e := d.GetConfig(ctx, "g", "a", 9); e != ErrNotExist {
+		t.Fatalf("get unknown config error: %v", e)
+	}
+}
+
+func TestMemoryRecycle(t *testing.T) {
+	ctx := context.Background()
+	d := newTestDao(t)
+	if _, e := d.SetConfig(ctx, "g", "a", &Config{AppConfig: "{}", SourceConfig: "{}"}, 0); e != nil {
+		t.Fatal(e)
+	}
+	if e := d.PurgeApp(ctx, "g", "a", 0); e != ErrNotExist {
+		t.Fatalf("purge the live app error: %v", e)
+	}
+	if _, e := d.DelApp(ctx, "g", "a", 100); e != nil {
+		t.Fatal(e)
+	}
+	if _, _, e := d.GetInfo(ctx, "g", "a"); e != ErrNotExist {
+		t.Fatalf("get info of the deleted app error: %v", e)
+	}
+	if _, e := d.SetConfig(ctx, "g", "a", &Config{AppConfig: "{}", SourceConfig: "{}"}, 0); e != ErrDeleted {
+		t.Fatalf("set the deleted app error: %v", e)
+	}
+	if apps, _ := d.GetApps(ctx, "g"); len(apps) != 0 {
+		t.Fatalf("deleted app is listed: %v", apps)
+	}
+	if recycle, _ := d.GetRecycle(ctx, ""); len(recycle) != 1 || recycle[0].Appname != "a" || recycle[0].Dtime != 100 {
+		t.Fatalf("recycle: %v", recycle)
+	}
+	if _, e := d.RestoreApp(ctx, "g", "a"); e != nil {
+		t.Fatal(e)
+	}
+	if _, _, e := d.GetInfo(ctx, "g", "a"); e != nil {
+		t.Fatalf("get info of the restored app error: %v", e)
+	}
+	if _, e := d.DelApp(ctx, "g", "a", 200); e != nil {
+		t.Fatal(e)
+	}
+	if e := d.PurgeApp(ctx, "g", "a", 100); e != ErrNotExist {
+		t.Fatalf("purge with wrong dtime error: %v", e)
+	}
+	if e := d.PurgeApp(ctx, "g", "a", 200); e != nil {
+		t.Fatal(e)
+	}
+	//the app can be created again after purged
+	sum, e := d.SetConfig(ctx, "g", "a", &Config{AppConfig: "{}", SourceConfig: "{}"}, 0)
+	if e != nil || sum.CurIndex != 1 {
+		t.Fatalf("set the purged app: %+v,%v", sum, e)
+	}
+}
+
+func TestMemorySchema(t *testing.T) {
+	ctx := context.Background()
+	d := newTestDao(t)
+	if _, e := d.GetSchema(ctx, "g", "a", 0); e != ErrNotExist {
+		t.Fatalf("get unknown schema error: %v", e)
+	}
+	for i := uint64(1); i <= 2; i++ {
+		schema := &Schema{AppSchema: `{"type":"object"}`}
+		if e := d.SetSchema(ctx, "g", "a", schema); e != nil || schema.Index != i {
+			t.Fatalf("set schema: %d,%v", schema.Index, e)
+		}
+	}
+	if schema, e := d.GetSchema(ctx, "g", "a", 0); e != nil || schema.Index != 2 {
+		t.Fatalf("get newest schema: %+v,%v", schema, e)
+	}
+	if schema, e := d.GetSchema(ctx, "g", "a", 1); e != nil || schema.Index != 1 {
+		t.Fatalf("get schema 1: %+v,%v", schema, e)
+	}
+}
+
+func TestMemoryWatch(t *testing.T) {
+	d := newTestDao(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	updates := make(chan *Summary, 10)
+	go d.Watch(ctx, "g", "a", func(summary *Summary, config *Config, canary *Config) {
+		updates <- summary
+	})
+	wait := func(opnum uint64) {
+		select {
+		case sum := <-updates:
+			if sum.OpNum != opnum {
+				t.Fatalf("watch op_num: %d,want %d", sum.OpNum, opnum)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("watch op_num %d timeout", opnum)
+		}
+	}
+	//the app doesn't exist
+	wait(0)
+	if _, e := d.SetConfig(context.Background(), "g", "a", &Config{AppConfig: "{}", SourceConfig: "{}"}, 0); e != nil {
+		t.Fatal(e)
+	}
+	wait(1)
+	if _, e := d.SetCanary(context.Background(), "g", "a", &Canary{Index: 1, Percent: 10}, 0); e != nil {
+		t.Fatal(e)
+	}
+	wait(2)
+}
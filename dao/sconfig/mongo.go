@@ -2,50 +2,105 @@ package sconfig
 
 import (
 	"context"
+	"sort"
+	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.mongodb.org/mongo-driver/mongo/readconcern"
 )
 
+//every group is a database named s_<groupname>
+//every app is a collection named <appname> in the group's database
 //doesn't support sharding
 //index has a unique key
-//summary's index is 0
-//config's index start from 1
-type Summary struct {
-	Index    uint64 `bson:"index"`
-	CurIndex uint64 `bson:"cur_index"`
-	MaxIndex uint64 `bson:"max_index"`
-	OpNum    uint64 `bson:"op_num"`
+type mongoDao struct {
+	mongo *mongo.Client
 }
-type Config struct {
-	Index        uint64 `bson:"index"`
-	AppConfig    string `bson:"app_config"`
-	SourceConfig string `bson:"source_config"`
+
+//the summary's dtime doesn't exist or is 0
+var notDeleted = bson.M{"$not": bson.M{"$gt": 0}}
+
+//Init create the unique indexes,the upserts and the concurrent inserts depend on them
+func (d *mongoDao) Init(ctx context.Context) error {
+	indexes := map[string][]mongo.IndexModel{
+		"schema": {
+			{Keys: bson.D{{Key: "groupname", Value: 1}, {Key: "appname", Value: 1}, {Key: "index", Value: 1}}, Options: options.Index().SetUnique(true)},
+		},
+		"policy": {
+			{Keys: bson.D{{Key: "groupname", Value: 1}}, Options: options.Index().SetUnique(true)},
+		},
+		"approval": {
+			{Keys: bson.D{{Key: "groupname", Value: 1}, {Key: "appname", Value: 1}, {Key: "index", Value: 1}, {Key: "reviewer", Value: 1}}, Options: options.Index().SetUnique(true)},
+		},
+		"schedule": {
+			{Keys: bson.D{{Key: "status", Value: 1}, {Key: "fire_time", Value: 1}}},
+			{Keys: bson.D{{Key: "groupname", Value: 1}, {Key: "appname", Value: 1}, {Key: "status", Value: 1}}},
+		},
+		"report": {
+			{Keys: bson.D{{Key: "groupname", Value: 1}, {Key: "appname", Value: 1}, {Key: "instance", Value: 1}}, Options: options.Index().SetUnique(true)},
+		},
+		"guard": {
+			{Keys: bson.D{{Key: "groupname", Value: 1}, {Key: "appname", Value: 1}}, Options: options.Index().SetUnique(true)},
+		},
+		"retention": {
+			{Keys: bson.D{{Key: "groupname", Value: 1}}, Options: options.Index().SetUnique(true)},
+		},
+		"grant": {
+			{Keys: bson.D{{Key: "principal", Value: 1}, {Key: "groupname", Value: 1}, {Key: "appname", Value: 1}}, Options: options.Index().SetUnique(true)},
+			{Keys: bson.D{{Key: "groupname", Value: 1}}},
+		},
+		"read_token": {
+			{Keys: bson.D{{Key: "groupname", Value: 1}, {Key: "appname", Value: 1}, {Key: "ctime", Value: 1}}},
+		},
+		"audit": {
+			{Keys: bson.D{{Key: "groupname", Value: 1}, {Key: "appname", Value: 1}, {Key: "ctime", Value: -1}}},
+		},
+	}
+	for collection, models := range indexes {
+		if _, e := d.mongo.Database("sconfig").Collection(collection).Indexes().CreateMany(ctx, models); e != nil {
+			return e
+		}
+	}
+	return nil
 }
 
-func (d *Dao) MongoGetInfo(ctx context.Context, groupname, appname string) (*Summary, *Config, error) {
+func (d *mongoDao) GetInfo(ctx context.Context, groupname, appname string) (*Summary, *Config, error) {
 	summary := &Summary{}
 	if e := d.mongo.Database("s_"+groupname).Collection(appname).FindOne(ctx, bson.M{"index": 0}).Decode(summary); e != nil {
+		if e == mongo.ErrNoDocuments {
+			e = ErrNotExist
+		}
 		return nil, nil, e
 	}
+	if summary.CurIndex == 0 || summary.Dtime != 0 {
+		//only has drafts or deleted
+		return nil, nil, ErrNotExist
+	}
 	config := &Config{}
 	if e := d.mongo.Database("s_"+groupname).Collection(appname).FindOne(ctx, bson.M{"index": summary.CurIndex}).Decode(config); e != nil {
+		if e == mongo.ErrNoDocuments {
+			e = ErrNotExist
+		}
 		return nil, nil, e
 	}
 	return summary, config, nil
 }
 
-func (d *Dao) MongoGetConfig(ctx context.Context, groupname, appname string, index uint64) (*Config, error) {
+func (d *mongoDao) GetConfig(ctx context.Context, groupname, appname string, index uint64) (*Config, error) {
 	config := &Config{}
 	if e := d.mongo.Database("s_"+groupname).Collection(appname).FindOne(ctx, bson.M{"index": index}).Decode(config); e != nil {
+		if e == mongo.ErrNoDocuments {
+			e = ErrNotExist
+		}
 		return nil, e
 	}
 	return config, nil
 }
 
-func (d *Dao) MongoSetConfig(ctx context.Context, groupname, appname, appconfig, sourceconfig string) (e error) {
+func (d *mongoDao) transaction(ctx context.Context, f func(sctx mongo.SessionContext) error) (e error) {
 	var s mongo.Session
 	if s, e = d.mongo.StartSession(); e != nil {
 		return
@@ -62,121 +117,1015 @@ func (d *Dao) MongoSetConfig(ctx context.Context, groupname, appname, appconfig,
 			s.AbortTransaction(sctx)
 		}
 	}()
+	return f(sctx)
+}
+
+func (d *mongoDao) SetConfig(ctx context.Context, groupname, appname string, config *Config, expectopnum uint64) (summary *Summary, e error) {
+	e = d.transaction(ctx, func(sctx mongo.SessionContext) (e error) {
+		if e = d.mongo.Database("s_"+groupname).Collection(appname).FindOne(sctx, bson.M{"index": 0, "dtime": bson.M{"$gt": 0}}).Err(); e == nil {
+			return ErrDeleted
+		} else if e != mongo.ErrNoDocuments {
+			return
+		}
+		summary, e = d.setConfig(sctx, groupname, appname, config, expectopnum)
+		return
+	})
+	if e != nil {
+		return nil, e
+	}
+	return
+}
+
+func (d *mongoDao) UpdateConfig(ctx context.Context, groupname, appname string, update func(*Config) (*Config, error), expectopnum uint64) (summary *Summary, e error) {
+	e = d.transaction(ctx, func(sctx mongo.SessionContext) error {
+		var current *Config
+		cursummary := &Summary{}
+		if e := d.mongo.Database("s_"+groupname).Collection(appname).FindOne(sctx, bson.M{"index": 0}).Decode(cursummary); e != nil && e != mongo.ErrNoDocuments {
+			return e
+		} else if e == mongo.ErrNoDocuments {
+			if expectopnum != 0 {
+				return ErrOpNumConflict
+			}
+		} else if expectopnum != 0 && expectopnum != cursummary.OpNum {
+			return ErrOpNumConflict
+		}
+		if cursummary.Dtime != 0 {
+			return ErrDeleted
+		}
+		if cursummary.CurIndex != 0 {
+			current = &Config{}
+			if e := d.mongo.Database("s_"+groupname).Collection(appname).FindOne(sctx, bson.M{"index": cursummary.CurIndex}).Decode(current); e != nil {
+				return e
+			}
+		}
+		config, e := update(current)
+		if e != nil {
+			return e
+		}
+		//make sure nobody changed the summary after it was read
+		summary, e = d.setConfig(sctx, groupname, appname, config, cursummary.OpNum)
+		return e
+	})
+	if e != nil {
+		return nil, e
+	}
+	return
+}
+
+func (d *mongoDao) setConfig(sctx mongo.SessionContext, groupname, appname string, config *Config, expectopnum uint64) (*Summary, error) {
 	filter1 := bson.M{"index": 0}
-	update1 := bson.A{
-		bson.M{
-			"$set": bson.M{
-				"op_num": bson.M{
-					"$ifNull": bson.A{
-						bson.M{"$add": bson.A{"$op_num", 1}},
-						1,
+	var update1 bson.A
+	if config.Draft {
+		//draft is not live,only the max_index will be changed
+		update1 = bson.A{
+			bson.M{
+				"$set": bson.M{
+					"op_num": bson.M{
+						"$ifNull": bson.A{"$op_num", 0},
+					},
+					"max_index": bson.M{
+						"$ifNull": bson.A{
+							bson.M{"$add": bson.A{"$max_index", 1}},
+							1,
+						},
+					},
+					"prev_index": bson.M{
+						"$ifNull": bson.A{"$prev_index", 0},
+					},
+					"cur_index": bson.M{
+						"$ifNull": bson.A{"$cur_index", 0},
 					},
 				},
-				"max_index": bson.M{
-					"$ifNull": bson.A{
-						bson.M{"$add": bson.A{"$max_index", 1}},
-						1,
+			},
+		}
+	} else {
+		update1 = bson.A{
+			bson.M{
+				"$set": bson.M{
+					"op_num": bson.M{
+						"$ifNull": bson.A{
+							bson.M{"$add": bson.A{"$op_num", 1}},
+							1,
+						},
 					},
+					"max_index": bson.M{
+						"$ifNull": bson.A{
+							bson.M{"$add": bson.A{"$max_index", 1}},
+							1,
+						},
+					},
+					"prev_index": bson.M{
+						"$ifNull": bson.A{"$cur_index", 0},
+					},
+					"canary": nil,
 				},
 			},
-		},
+			bson.M{
+				"$set": bson.M{
+					"cur_index": bson.M{
+						"$toLong": "$max_index",
+					},
+				},
+			},
+		}
+	}
+	//when expect op_num is set,the summary must exist,so don't upsert
+	if expectopnum != 0 {
+		filter1["op_num"] = expectopnum
+	}
+	summary := &Summary{}
+	if e := d.mongo.Database("s_"+groupname).Collection(appname).FindOneAndUpdate(sctx, filter1, update1, options.FindOneAndUpdate().SetUpsert(expectopnum == 0).SetReturnDocument(options.After)).Decode(summary); e != nil {
+		if e == mongo.ErrNoDocuments {
+			e = ErrOpNumConflict
+		}
+		return nil, e
+	}
+	config.Index = summary.MaxIndex
+	filter2 := bson.M{"index": config.Index}
+	update2 := bson.M{"$set": bson.M{
+		"app_config":    config.AppConfig,
+		"source_config": config.SourceConfig,
+		"ctime":         config.Ctime,
+		"author":        config.Author,
+		"comment":       config.Comment,
+		"draft":         config.Draft,
+	}}
+	if _, e := d.mongo.Database("s_"+groupname).Collection(appname).UpdateOne(sctx, filter2, update2, options.Update().SetUpsert(true)); e != nil {
+		return nil, e
+	}
+	return summary, nil
+}
+
+func (d *mongoDao) RollbackConfig(ctx context.Context, groupname, appname string, index, expectopnum uint64) (summary *Summary, e error) {
+	e = d.transaction(ctx, func(sctx mongo.SessionContext) (e error) {
+		summary, e = d.rollbackConfig(sctx, groupname, appname, index, expectopnum)
+		return
+	})
+	if e != nil {
+		return nil, e
+	}
+	return
+}
+
+//rollbackConfig must be called in the transaction
+func (d *mongoDao) rollbackConfig(sctx mongo.SessionContext, groupname, appname string, index, expectopnum uint64) (*Summary, error) {
+	//the version may be pruned,the prune also writes the summary,so one of them will fail with write conflict
+	if e := d.mongo.Database("s_"+groupname).Collection(appname).FindOne(sctx, bson.M{"index": index}).Err(); e != nil {
+		if e == mongo.ErrNoDocuments {
+			e = ErrNotExist
+		}
+		return nil, e
+	}
+	filter := bson.M{"index": 0, "dtime": notDeleted}
+	if expectopnum != 0 {
+		filter["op_num"] = expectopnum
+	}
+	update := bson.A{
 		bson.M{
 			"$set": bson.M{
-				"cur_index": bson.M{
-					"$toLong": "$max_index",
-				},
+				"prev_index": "$cur_index",
+				"cur_index":  index,
+				"op_num":     bson.M{"$add": bson.A{"$op_num", 1}},
+				"canary":     nil,
 			},
 		},
 	}
 	summary := &Summary{}
-	r := d.mongo.Database("s_"+groupname).Collection(appname).FindOneAndUpdate(sctx, filter1, update1, options.FindOneAndUpdate().SetUpsert(true))
-	if r.Err() != nil && r.Err() != mongo.ErrNoDocuments {
-		e = r.Err()
-		return
-	} else if r.Err() == nil {
-		if e = r.Decode(summary); e != nil {
-			return
+	if e := d.mongo.Database("s_"+groupname).Collection(appname).FindOneAndUpdate(sctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(summary); e != nil {
+		if e != mongo.ErrNoDocuments {
+			return nil, e
+		}
+		if expectopnum == 0 {
+			return nil, ErrNotExist
+		}
+		//find out why the filter didn't match
+		if _, _, e := d.GetInfo(sctx, groupname, appname); e != nil {
+			return nil, e
+		}
+		return nil, ErrOpNumConflict
+	}
+	return summary, nil
+}
+
+func (d *mongoDao) SetCanary(ctx context.Context, groupname, appname string, canary *Canary, expectopnum uint64) (summary *Summary, e error) {
+	e = d.transaction(ctx, func(sctx mongo.SessionContext) error {
+		col := d.mongo.Database("s_"+groupname).Collection(appname)
+		if canary != nil {
+			if e := col.FindOne(sctx, bson.M{"index": canary.Index}).Err(); e != nil {
+				if e == mongo.ErrNoDocuments {
+					e = ErrNotExist
+				}
+				return e
+			}
+		}
+		filter := bson.M{"index": 0, "cur_index": bson.M{"$gt": 0}, "dtime": notDeleted}
+		if expectopnum != 0 {
+			filter["op_num"] = expectopnum
+		}
+		update := bson.M{"$set": bson.M{"canary": canary}, "$inc": bson.M{"op_num": 1}}
+		summary = &Summary{}
+		if e := col.FindOneAndUpdate(sctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(summary); e != nil {
+			if e != mongo.ErrNoDocuments {
+				return e
+			}
+			if expectopnum == 0 {
+				return ErrNotExist
+			}
+			//find out why the filter didn't match
+			if _, _, e = d.GetInfo(sctx, groupname, appname); e != nil {
+				return e
+			}
+			return ErrOpNumConflict
+		}
+		return nil
+	})
+	if e != nil {
+		return nil, e
+	}
+	return
+}
+
+func (d *mongoDao) PublishConfig(ctx context.Context, groupname, appname string, index, expectopnum uint64) (summary *Summary, e error) {
+	e = d.transaction(ctx, func(sctx mongo.SessionContext) error {
+		r, e := d.mongo.Database("s_"+groupname).Collection(appname).UpdateOne(sctx, bson.M{"index": index}, bson.M{"$set": bson.M{"draft": false}})
+		if e != nil {
+			return e
+		}
+		if r.MatchedCount == 0 {
+			return ErrNotExist
 		}
+		summary, e = d.rollbackConfig(sctx, groupname, appname, index, expectopnum)
+		return e
+	})
+	if e != nil {
+		return nil, e
 	}
-	filter2 := bson.M{"index": summary.CurIndex + 1}
-	update2 := bson.M{"$set": bson.M{"app_config": appconfig, "source_config": sourceconfig}}
-	_, e = d.mongo.Database("s_"+groupname).Collection(appname).UpdateOne(sctx, filter2, update2, options.Update().SetUpsert(true))
 	return
 }
 
-func (d *Dao) MongoRollbackConfig(ctx context.Context, groupname, appname string, index uint64) (bool, error) {
-	filter := bson.M{"index": 0, "max_index": bson.M{"$gte": index}}
+func (d *mongoDao) GetHistory(ctx context.Context, groupname, appname string, skip, limit uint64) ([]*Config, uint64, error) {
+	col := d.mongo.Database("s_"+groupname).Collection(appname)
+	filter := bson.M{"index": bson.M{"$gt": 0}}
+	total, e := col.CountDocuments(ctx, filter)
+	if e != nil {
+		return nil, 0, e
+	}
+	cursor, e := col.Find(ctx, filter, options.Find().SetSort(bson.M{"index": -1}).SetSkip(int64(skip)).SetLimit(int64(limit)))
+	if e != nil {
+		return nil, 0, e
+	}
+	result := make([]*Config, 0, limit)
+	if e = cursor.All(ctx, &result); e != nil {
+		return nil, 0, e
+	}
+	return result, uint64(total), nil
+}
+
+func (d *mongoDao) GetDrafts(ctx context.Context, groupname, appname string) ([]*Config, error) {
+	cursor, e := d.mongo.Database("s_"+groupname).Collection(appname).Find(ctx, bson.M{"index": bson.M{"$gt": 0}, "draft": true}, options.Find().SetSort(bson.M{"index": -1}))
+	if e != nil {
+		return nil, e
+	}
+	result := make([]*Config, 0)
+	if e = cursor.All(ctx, &result); e != nil {
+		return nil, e
+	}
+	return result, nil
+}
+
+func (d *mongoDao) GetVersions(ctx context.Context, groupname, appname string) ([]*Config, error) {
+	cursor, e := d.mongo.Database("s_"+groupname).Collection(appname).Find(ctx, bson.M{"index": bson.M{"$gt": 0}}, options.Find().SetSort(bson.M{"index": -1}).SetProjection(bson.M{"app_config": 0, "source_config": 0}))
+	if e != nil {
+		return nil, e
+	}
+	result := make([]*Config, 0)
+	if e = cursor.All(ctx, &result); e != nil {
+		return nil, e
+	}
+	return result, nil
+}
+
+func (d *mongoDao) SetTag(ctx context.Context, groupname, appname string, index uint64, tag string) error {
+	r, e := d.mongo.Database("s_"+groupname).Collection(appname).UpdateOne(ctx, bson.M{"index": index}, bson.M{"$set": bson.M{"tag": tag}})
+	if e != nil {
+		return e
+	}
+	if r.MatchedCount == 0 {
+		return ErrNotExist
+	}
+	return nil
+}
+
+func (d *mongoDao) DelConfigs(ctx context.Context, groupname, appname string, indexes []uint64) ([]uint64, error) {
+	result := make([]uint64, 0, len(indexes))
+	e := d.transaction(ctx, func(sctx mongo.SessionContext) error {
+		col := d.mongo.Database("s_"+groupname).Collection(appname)
+		//write the summary,so the concurrent rollback to the deleted version will fail with write conflict
+		summary := &Summary{}
+		if e := col.FindOneAndUpdate(sctx, bson.M{"index": 0}, bson.M{"$set": bson.M{"prune_time": time.Now().Unix()}}).Decode(summary); e != nil {
+			if e == mongo.ErrNoDocuments {
+				return nil
+			}
+			return e
+		}
+		for _, index := range indexes {
+			if index == summary.CurIndex || (summary.Canary != nil && index == summary.Canary.Index) {
+				continue
+			}
+			r, e := col.DeleteOne(sctx, bson.M{"index": index})
+			if e != nil {
+				return e
+			}
+			if r.DeletedCount != 0 {
+				result = append(result, index)
+			}
+		}
+		return nil
+	})
+	if e != nil {
+		return nil, e
+	}
+	return result, nil
+}
+
+func (d *mongoDao) GetGroups(ctx context.Context) ([]string, error) {
+	dbs, e := d.mongo.ListDatabaseNames(ctx, bson.M{"name": bson.M{"$regex": "^s_"}})
+	if e != nil {
+		return nil, e
+	}
+	result := make([]string, 0, len(dbs))
+	for _, db := range dbs {
+		apps, e := d.GetApps(ctx, db[2:])
+		if e != nil {
+			return nil, e
+		}
+		if len(apps) > 0 {
+			result = append(result, db[2:])
+		}
+	}
+	return result, nil
+}
+
+//the collection without summary or with deleted summary will be skipped
+func (d *mongoDao) GetApps(ctx context.Context, groupname string) ([]string, error) {
+	filter := bson.M{"index": 0, "dtime": notDeleted}
+	db := d.mongo.Database("s_" + groupname)
+	cols, e := db.ListCollectionNames(ctx, bson.M{})
+	if e != nil {
+		return nil, e
+	}
+	result := make([]string, 0, len(cols))
+	for _, col := range cols {
+		if e := db.Collection(col).FindOne(ctx, filter).Err(); e == nil {
+			result = append(result, col)
+		} else if e != mongo.ErrNoDocuments {
+			return nil, e
+		}
+	}
+	return result, nil
+}
+
+func (d *mongoDao) DelApp(ctx context.Context, groupname, appname string, dtime uint64) (*Summary, error) {
+	return d.setDtime(ctx, groupname, appname, notDeleted, dtime)
+}
+
+func (d *mongoDao) RestoreApp(ctx context.Context, groupname, appname string) (*Summary, error) {
+	return d.setDtime(ctx, groupname, appname, bson.M{"$gt": 0}, 0)
+}
+
+func (d *mongoDao) setDtime(ctx context.Context, groupname, appname string, from bson.M, to uint64) (*Summary, error) {
+	set := bson.M{"dtime": to}
+	if to != 0 {
+		set["canary"] = nil
+	}
+	summary := &Summary{}
+	if e := d.mongo.Database("s_"+groupname).Collection(appname).FindOneAndUpdate(ctx, bson.M{"index": 0, "dtime": from}, bson.M{"$set": set, "$inc": bson.M{"op_num": 1}}, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(summary); e != nil {
+		if e == mongo.ErrNoDocuments {
+			e = ErrNotExist
+		}
+		return nil, e
+	}
+	return summary, nil
+}
+
+func (d *mongoDao) PurgeApp(ctx context.Context, groupname, appname string, dtime uint64) error {
+	db := d.mongo.Database("s_" + groupname)
+	e := d.transaction(ctx, func(sctx mongo.SessionContext) error {
+		filter := bson.M{"index": 0, "dtime": bson.M{"$gt": 0}}
+		if dtime != 0 {
+			filter["dtime"] = dtime
+		}
+		if e := db.Collection(appname).FindOne(sctx, filter).Err(); e != nil {
+			if e == mongo.ErrNoDocuments {
+				e = ErrNotExist
+			}
+			return e
+		}
+		//the watchers will receive the delete events
+		if _, e := db.Collection(appname).DeleteMany(sctx, bson.M{}); e != nil {
+			return e
+		}
+		for _, col := range []string{"schema", "approval", "schedule", "report", "guard", "read_token"} {
+			if _, e := d.mongo.Database("sconfig").Collection(col).DeleteMany(sctx, bson.M{"groupname": groupname, "appname": appname}); e != nil {
+				return e
+			}
+		}
+		return nil
+	})
+	if e != nil {
+		return e
+	}
+	//drop can't be in the transaction
+	//the app may be created again after the transaction,so only drop the empty collection and database
+	if count, e := db.Collection(appname).CountDocuments(ctx, bson.M{}); e != nil || count != 0 {
+		return e
+	}
+	if e = db.Collection(appname).Drop(ctx); e != nil {
+		return e
+	}
+	if cols, e := db.ListCollectionNames(ctx, bson.M{}); e != nil || len(cols) != 0 {
+		return e
+	}
+	return db.Drop(ctx)
+}
+
+func (d *mongoDao) GetRecycle(ctx context.Context, groupname string) ([]*Recycle, error) {
+	groups := []string{groupname}
+	if groupname == "" {
+		dbs, e := d.mongo.ListDatabaseNames(ctx, bson.M{"name": bson.M{"$regex": "^s_"}})
+		if e != nil {
+			return nil, e
+		}
+		groups = groups[:0]
+		for _, db := range dbs {
+			groups = append(groups, db[2:])
+		}
+	}
+	result := make([]*Recycle, 0)
+	for _, g := range groups {
+		db := d.mongo.Database("s_" + g)
+		cols, e := db.ListCollectionNames(ctx, bson.M{})
+		if e != nil {
+			return nil, e
+		}
+		for _, col := range cols {
+			summary := &Summary{}
+			if e := db.Collection(col).FindOne(ctx, bson.M{"index": 0, "dtime": bson.M{"$gt": 0}}).Decode(summary); e != nil {
+				if e == mongo.ErrNoDocuments {
+					continue
+				}
+				return nil, e
+			}
+			result = append(result, &Recycle{Groupname: g, Appname: col, Dtime: summary.Dtime})
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool { return result[i].Dtime < result[j].Dtime })
+	return result, nil
+}
+
+//all schemas are in the database sconfig's collection schema
+//unique index: {groupname:1,appname:1,index:1}
+func (d *mongoDao) SetSchema(ctx context.Context, groupname, appname string, schema *Schema) error {
+	col := d.mongo.Database("sconfig").Collection("schema")
+	newest, e := d.GetSchema(ctx, groupname, appname, 0)
+	if e != nil && e != ErrNotExist {
+		return e
+	}
+	schema.Index = 1
+	if newest != nil {
+		schema.Index = newest.Index + 1
+	}
+	//the unique index will reject the concurrent set
+	_, e = col.InsertOne(ctx, bson.M{
+		"groupname":     groupname,
+		"appname":       appname,
+		"index":         schema.Index,
+		"app_schema":    schema.AppSchema,
+		"source_schema": schema.SourceSchema,
+		"ctime":         schema.Ctime,
+		"author":        schema.Author,
+	})
+	return e
+}
+
+func (d *mongoDao) GetSchema(ctx context.Context, groupname, appname string, index uint64) (*Schema, error) {
+	filter := bson.M{"groupname": groupname, "appname": appname}
+	if index != 0 {
+		filter["index"] = index
+	}
+	schema := &Schema{}
+	if e := d.mongo.Database("sconfig").Collection("schema").FindOne(ctx, filter, options.FindOne().SetSort(bson.M{"index": -1})).Decode(schema); e != nil {
+		if e == mongo.ErrNoDocuments {
+			e = ErrNotExist
+		}
+		return nil, e
+	}
+	return schema, nil
+}
+
+//all policies are in the database sconfig's collection policy
+//unique index: {groupname:1}
+func (d *mongoDao) SetPolicy(ctx context.Context, policy *Policy) error {
+	_, e := d.mongo.Database("sconfig").Collection("policy").ReplaceOne(ctx, bson.M{"groupname": policy.Groupname}, policy, options.Replace().SetUpsert(true))
+	return e
+}
+
+func (d *mongoDao) GetPolicy(ctx context.Context, groupname string) (*Policy, error) {
+	policy := &Policy{}
+	if e := d.mongo.Database("sconfig").Collection("policy").FindOne(ctx, bson.M{"groupname": groupname}).Decode(policy); e != nil {
+		if e == mongo.ErrNoDocuments {
+			e = ErrNotExist
+		}
+		return nil, e
+	}
+	return policy, nil
+}
+
+//all approvals are in the database sconfig's collection approval
+//unique index: {groupname:1,appname:1,index:1,reviewer:1}
+func (d *mongoDao) SetApproval(ctx context.Context, approval *Approval) error {
+	filter := bson.M{"groupname": approval.Groupname, "appname": approval.Appname, "index": approval.Index, "reviewer": approval.Reviewer}
+	_, e := d.mongo.Database("sconfig").Collection("approval").ReplaceOne(ctx, filter, approval, options.Replace().SetUpsert(true))
+	return e
+}
+
+func (d *mongoDao) GetApprovals(ctx context.Context, groupname, appname string, index uint64) ([]*Approval, error) {
+	cursor, e := d.mongo.Database("sconfig").Collection("approval").Find(ctx, bson.M{"groupname": groupname, "appname": appname, "index": index})
+	if e != nil {
+		return nil, e
+	}
+	result := make([]*Approval, 0)
+	if e = cursor.All(ctx, &result); e != nil {
+		return nil, e
+	}
+	return result, nil
+}
+
+//all schedules are in the database sconfig's collection schedule
+//index: {status:1,fire_time:1}
+//index: {groupname:1,appname:1,status:1}
+func (d *mongoDao) AddSchedule(ctx context.Context, schedule *Schedule) error {
+	schedule.ID = primitive.NewObjectID().Hex()
+	_, e := d.mongo.Database("sconfig").Collection("schedule").InsertOne(ctx, schedule)
+	return e
+}
+
+func (d *mongoDao) GetSchedules(ctx context.Context, groupname, appname string) ([]*Schedule, error) {
+	filter := bson.M{"groupname": groupname, "status": bson.M{"$in": []string{SchedulePending, ScheduleFiring}}}
+	if appname != "" {
+		filter["appname"] = appname
+	}
+	return d.getSchedules(ctx, filter)
+}
+
+func (d *mongoDao) GetDueSchedules(ctx context.Context, firetime uint64) ([]*Schedule, error) {
+	return d.getSchedules(ctx, bson.M{"status": bson.M{"$in": []string{SchedulePending, ScheduleFiring}}, "fire_time": bson.M{"$lte": firetime}})
+}
+
+func (d *mongoDao) getSchedules(ctx context.Context, filter bson.M) ([]*Schedule, error) {
+	cursor, e := d.mongo.Database("sconfig").Collection("schedule").Find(ctx, filter, options.Find().SetSort(bson.M{"fire_time": 1}))
+	if e != nil {
+		return nil, e
+	}
+	result := make([]*Schedule, 0)
+	if e = cursor.All(ctx, &result); e != nil {
+		return nil, e
+	}
+	return result, nil
+}
+
+func (d *mongoDao) UpdateSchedule(ctx context.Context, groupname, appname, id, from, to, result string) (*Schedule, error) {
+	filter := bson.M{"_id": id, "groupname": groupname, "appname": appname, "status": from}
+	update := bson.M{"$set": bson.M{"status": to, "result": result}}
+	schedule := &Schedule{}
+	if e := d.mongo.Database("sconfig").Collection("schedule").FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(schedule); e != nil {
+		if e == mongo.ErrNoDocuments {
+			e = ErrNotExist
+		}
+		return nil, e
+	}
+	return schedule, nil
+}
+
+//all reports are in the database sconfig's collection report
+//unique index: {groupname:1,appname:1,instance:1}
+func (d *mongoDao) SetReport(ctx context.Context, report *Report) error {
+	filter := bson.M{"groupname": report.Groupname, "appname": report.Appname, "instance": report.Instance}
+	_, e := d.mongo.Database("sconfig").Collection("report").ReplaceOne(ctx, filter, report, options.Replace().SetUpsert(true))
+	return e
+}
+
+func (d *mongoDao) GetReports(ctx context.Context, groupname, appname string) ([]*Report, error) {
+	cursor, e := d.mongo.Database("sconfig").Collection("report").Find(ctx, bson.M{"groupname": groupname, "appname": appname}, options.Find().SetSort(bson.M{"instance": 1}))
+	if e != nil {
+		return nil, e
+	}
+	result := make([]*Report, 0)
+	if e = cursor.All(ctx, &result); e != nil {
+		return nil, e
+	}
+	return result, nil
+}
+
+//all guards are in the database sconfig's collection guard
+//unique index: {groupname:1,appname:1}
+func (d *mongoDao) SetGuard(ctx context.Context, guard *Guard) error {
+	_, e := d.mongo.Database("sconfig").Collection("guard").ReplaceOne(ctx, bson.M{"groupname": guard.Groupname, "appname": guard.Appname}, guard, options.Replace().SetUpsert(true))
+	return e
+}
+
+func (d *mongoDao) GetGuard(ctx context.Context, groupname, appname string) (*Guard, error) {
+	guard := &Guard{}
+	if e := d.mongo.Database("sconfig").Collection("guard").FindOne(ctx, bson.M{"groupname": groupname, "appname": appname}).Decode(guard); e != nil {
+		if e == mongo.ErrNoDocuments {
+			e = ErrNotExist
+		}
+		return nil, e
+	}
+	return guard, nil
+}
+
+func (d *mongoDao) GetGuards(ctx context.Context) ([]*Guard, error) {
+	cursor, e := d.mongo.Database("sconfig").Collection("guard").Find(ctx, bson.M{})
+	if e != nil {
+		return nil, e
+	}
+	result := make([]*Guard, 0)
+	if e = cursor.All(ctx, &result); e != nil {
+		return nil, e
+	}
+	return result, nil
+}
+
+func (d *mongoDao) DelGuard(ctx context.Context, groupname, appname string, opnum uint64) error {
+	_, e := d.mongo.Database("sconfig").Collection("guard").DeleteOne(ctx, bson.M{"groupname": groupname, "appname": appname, "op_num": opnum})
+	return e
+}
+
+//all retentions are in the database sconfig's collection retention
+//unique index: {groupname:1}
+func (d *mongoDao) SetRetention(ctx context.Context, retention *Retention) error {
+	_, e := d.mongo.Database("sconfig").Collection("retention").ReplaceOne(ctx, bson.M{"groupname": retention.Groupname}, retention, options.Replace().SetUpsert(true))
+	return e
+}
+
+func (d *mongoDao) GetRetention(ctx context.Context, groupname string) (*Retention, error) {
+	retention := &Retention{}
+	if e := d.mongo.Database("sconfig").Collection("retention").FindOne(ctx, bson.M{"groupname": groupname}).Decode(retention); e != nil {
+		if e == mongo.ErrNoDocuments {
+			e = ErrNotExist
+		}
+		return nil, e
+	}
+	return retention, nil
+}
+
+func (d *mongoDao) GetRetentions(ctx context.Context) ([]*Retention, error) {
+	cursor, e := d.mongo.Database("sconfig").Collection("retention").Find(ctx, bson.M{}, options.Find().SetSort(bson.M{"groupname": 1}))
+	if e != nil {
+		return nil, e
+	}
+	result := make([]*Retention, 0)
+	if e = cursor.All(ctx, &result); e != nil {
+		return nil, e
+	}
+	return result, nil
+}
+
+//all bases are in the database sconfig's collection base
+//the base's groupname is the _id
+func (d *mongoDao) SetBase(ctx context.Context, base *Base, expectopnum uint64) error {
+	filter := bson.M{"_id": base.Groupname}
+	if expectopnum != 0 {
+		filter["op_num"] = expectopnum
+	}
 	update := bson.M{
-		"$set": bson.M{"cur_index": index},
+		"$set": bson.M{
+			"app_config":    base.AppConfig,
+			"source_config": base.SourceConfig,
+			"ctime":         base.Ctime,
+			"author":        base.Author,
+			"comment":       base.Comment,
+		},
 		"$inc": bson.M{"op_num": 1},
 	}
-	r, e := d.mongo.Database("s_"+groupname).Collection(appname).UpdateOne(ctx, filter, update)
+	//the base can only be created when the op_num is not checked
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After).SetUpsert(expectopnum == 0)
+	result := &Base{}
+	if e := d.mongo.Database("sconfig").Collection("base").FindOneAndUpdate(ctx, filter, update, opts).Decode(result); e != nil {
+		if e == mongo.ErrNoDocuments {
+			e = ErrOpNumConflict
+		}
+		return e
+	}
+	base.OpNum = result.OpNum
+	return nil
+}
+
+func (d *mongoDao) GetBase(ctx context.Context, groupname string) (*Base, error) {
+	base := &Base{}
+	if e := d.mongo.Database("sconfig").Collection("base").FindOne(ctx, bson.M{"_id": groupname}).Decode(base); e != nil {
+		if e == mongo.ErrNoDocuments {
+			e = ErrNotExist
+		}
+		return nil, e
+	}
+	return base, nil
+}
+
+//all principals are in the database sconfig's collection principal
+//the principal's name is the _id
+func (d *mongoDao) SetPrincipal(ctx context.Context, principal *Principal) error {
+	_, e := d.mongo.Database("sconfig").Collection("principal").ReplaceOne(ctx, bson.M{"_id": principal.Name}, principal, options.Replace().SetUpsert(true))
+	return e
+}
+
+func (d *mongoDao) GetPrincipal(ctx context.Context, name string) (*Principal, error) {
+	principal := &Principal{}
+	if e := d.mongo.Database("sconfig").Collection("principal").FindOne(ctx, bson.M{"_id": name}).Decode(principal); e != nil {
+		if e == mongo.ErrNoDocuments {
+			e = ErrNotExist
+		}
+		return nil, e
+	}
+	return principal, nil
+}
+
+func (d *mongoDao) DelPrincipal(ctx context.Context, name string) error {
+	return d.transaction(ctx, func(sctx mongo.SessionContext) error {
+		r, e := d.mongo.Database("sconfig").Collection("principal").DeleteOne(sctx, bson.M{"_id": name})
+		if e != nil {
+			return e
+		}
+		if r.DeletedCount == 0 {
+			return ErrNotExist
+		}
+		_, e = d.mongo.Database("sconfig").Collection("grant").DeleteMany(sctx, bson.M{"principal": name})
+		return e
+	})
+}
+
+func (d *mongoDao) GetPrincipals(ctx context.Context) ([]*Principal, error) {
+	cursor, e := d.mongo.Database("sconfig").Collection("principal").Find(ctx, bson.M{}, options.Find().SetSort(bson.M{"_id": 1}))
 	if e != nil {
-		return false, e
+		return nil, e
 	}
-	if r.MatchedCount == 0 {
-		return false, nil
+	result := make([]*Principal, 0)
+	if e = cursor.All(ctx, &result); e != nil {
+		return nil, e
 	}
-	return true, nil
+	return result, nil
+}
+
+//all grants are in the database sconfig's collection grant
+//unique index: {principal:1,groupname:1,appname:1}
+//index: {groupname:1}
+func (d *mongoDao) SetGrant(ctx context.Context, grant *Grant) error {
+	filter := bson.M{"principal": grant.Principal, "groupname": grant.Groupname, "appname": grant.Appname}
+	_, e := d.mongo.Database("sconfig").Collection("grant").ReplaceOne(ctx, filter, grant, options.Replace().SetUpsert(true))
+	return e
+}
+
+func (d *mongoDao) DelGrant(ctx context.Context, principal, groupname, appname string) error {
+	r, e := d.mongo.Database("sconfig").Collection("grant").DeleteOne(ctx, bson.M{"principal": principal, "groupname": groupname, "appname": appname})
+	if e != nil {
+		return e
+	}
+	if r.DeletedCount == 0 {
+		return ErrNotExist
+	}
+	return nil
+}
+
+func (d *mongoDao) GetGrants(ctx context.Context, principal, groupname string) ([]*Grant, error) {
+	filter := bson.M{}
+	if principal != "" {
+		filter["principal"] = principal
+	}
+	if groupname != "" {
+		filter["groupname"] = groupname
+	}
+	sort := bson.D{bson.E{Key: "principal", Value: 1}, bson.E{Key: "groupname", Value: 1}, bson.E{Key: "appname", Value: 1}}
+	cursor, e := d.mongo.Database("sconfig").Collection("grant").Find(ctx, filter, options.Find().SetSort(sort))
+	if e != nil {
+		return nil, e
+	}
+	result := make([]*Grant, 0)
+	if e = cursor.All(ctx, &result); e != nil {
+		return nil, e
+	}
+	return result, nil
+}
+
+//all read tokens are in the database sconfig's collection read_token
+//index: {groupname:1,appname:1,ctime:1}
+func (d *mongoDao) AddReadToken(ctx context.Context, token *ReadToken) error {
+	token.ID = primitive.NewObjectID().Hex()
+	_, e := d.mongo.Database("sconfig").Collection("read_token").InsertOne(ctx, token)
+	return e
 }
 
-func (d *Dao) MongoGetGroups(ctx context.Context) ([]string, error) {
-	result, e := d.mongo.ListDatabaseNames(ctx, bson.M{"name": bson.M{"$regex": "^s_"}})
+func (d *mongoDao) GetReadToken(ctx context.Context, id string) (*ReadToken, error) {
+	token := &ReadToken{}
+	if e := d.mongo.Database("sconfig").Collection("read_token").FindOne(ctx, bson.M{"_id": id}).Decode(token); e != nil {
+		if e == mongo.ErrNoDocuments {
+			e = ErrNotExist
+		}
+		return nil, e
+	}
+	return token, nil
+}
+
+func (d *mongoDao) GetReadTokens(ctx context.Context, groupname, appname string) ([]*ReadToken, error) {
+	sort := bson.D{bson.E{Key: "ctime", Value: 1}, bson.E{Key: "_id", Value: 1}}
+	cursor, e := d.mongo.Database("sconfig").Collection("read_token").Find(ctx, bson.M{"groupname": groupname, "appname": appname}, options.Find().SetSort(sort))
 	if e != nil {
 		return nil, e
 	}
-	for i := range result {
-		result[i] = result[i][2:]
+	result := make([]*ReadToken, 0)
+	if e = cursor.All(ctx, &result); e != nil {
+		return nil, e
 	}
 	return result, nil
 }
 
-func (d *Dao) MongoGetApps(ctx context.Context, groupname string) ([]string, error) {
-	return d.mongo.Database("s_"+groupname).ListCollectionNames(ctx, bson.M{})
+func (d *mongoDao) ExpireReadToken(ctx context.Context, groupname, appname, id string, expire uint64) error {
+	r, e := d.mongo.Database("sconfig").Collection("read_token").UpdateOne(ctx, bson.M{"_id": id, "groupname": groupname, "appname": appname}, bson.M{"$set": bson.M{"expire": expire}})
+	if e != nil {
+		return e
+	}
+	if r.MatchedCount == 0 {
+		return ErrNotExist
+	}
+	return nil
 }
 
-func (d *Dao) MongoWatch(groupname, appname string, update func(*Config)) error {
+func (d *mongoDao) DelReadToken(ctx context.Context, groupname, appname, id string) error {
+	r, e := d.mongo.Database("sconfig").Collection("read_token").DeleteOne(ctx, bson.M{"_id": id, "groupname": groupname, "appname": appname})
+	if e != nil {
+		return e
+	}
+	if r.DeletedCount == 0 {
+		return ErrNotExist
+	}
+	return nil
+}
+
+//all leases are in the database sconfig's collection lease
+//the lease's name is the _id
+func (d *mongoDao) Lease(ctx context.Context, name, owner string, ttl uint64) (bool, error) {
+	now := uint64(time.Now().Unix())
+	filter := bson.M{"_id": name, "$or": bson.A{bson.M{"owner": owner}, bson.M{"expire": bson.M{"$lt": now}}}}
+	if _, e := d.mongo.Database("sconfig").Collection("lease").UpdateOne(ctx, filter, bson.M{"$set": bson.M{"owner": owner, "expire": now + ttl}}, options.Update().SetUpsert(true)); e != nil {
+		if mongo.IsDuplicateKeyError(e) {
+			//held by others,so the filter didn't match and the upsert conflicted with the existing one
+			return false, nil
+		}
+		return false, e
+	}
+	return true, nil
+}
+
+//all audits are in the database sconfig's collection audit
+//index: {groupname:1,appname:1,ctime:-1}
+func (d *mongoDao) AddAudit(ctx context.Context, audit *Audit) error {
+	_, e := d.mongo.Database("sconfig").Collection("audit").InsertOne(ctx, audit)
+	return e
+}
+
+func (d *mongoDao) GetAudits(ctx context.Context, groupname, appname string, begin, end, skip, limit uint64) ([]*Audit, uint64, error) {
+	col := d.mongo.Database("sconfig").Collection("audit")
+	filter := bson.M{"groupname": groupname}
+	if appname != "" {
+		filter["appname"] = appname
+	}
+	if begin != 0 || end != 0 {
+		ctime := bson.M{}
+		if begin != 0 {
+			ctime["$gte"] = begin
+		}
+		if end != 0 {
+			ctime["$lte"] = end
+		}
+		filter["ctime"] = ctime
+	}
+	total, e := col.CountDocuments(ctx, filter)
+	if e != nil {
+		return nil, 0, e
+	}
+	cursor, e := col.Find(ctx, filter, options.Find().SetSort(bson.D{bson.E{Key: "ctime", Value: -1}, bson.E{Key: "_id", Value: -1}}).SetSkip(int64(skip)).SetLimit(int64(limit)))
+	if e != nil {
+		return nil, 0, e
+	}
+	result := make([]*Audit, 0, limit)
+	if e = cursor.All(ctx, &result); e != nil {
+		return nil, 0, e
+	}
+	return result, uint64(total), nil
+}
+
+func (d *mongoDao) Watch(ctx context.Context, groupname, appname string, update func(*Summary, *Config, *Config)) error {
 	curop := uint64(0)
 
-	pipeline := mongo.Pipeline{bson.D{bson.E{Key: "$match", Value: bson.M{"fullDocument.index": 0}}}}
-	c, e := d.mongo.Database("s_"+groupname, options.Database().SetReadConcern(readconcern.Majority())).Collection(appname).Watch(context.Background(), pipeline, options.ChangeStream().SetFullDocument(options.UpdateLookup))
+	//delete events don't have the full document,so all of them are received
+	pipeline := mongo.Pipeline{bson.D{bson.E{Key: "$match", Value: bson.M{"$or": bson.A{bson.M{"fullDocument.index": 0}, bson.M{"operationType": "delete"}}}}}}
+	c, e := d.mongo.Database("s_"+groupname, options.Database().SetReadConcern(readconcern.Majority())).Collection(appname).Watch(ctx, pipeline, options.ChangeStream().SetFullDocument(options.UpdateLookup))
 	if e != nil {
 		return e
 	}
 	defer c.Close(context.Background())
-	summary, config, e := d.MongoGetInfo(context.Background(), groupname, appname)
-	if e != nil && e != mongo.ErrNoDocuments {
+	summary, config, e := d.GetInfo(ctx, groupname, appname)
+	if e != nil && e != ErrNotExist {
 		return e
 	} else if e == nil {
-		update(config)
+		canary, e := d.getCanary(ctx, groupname, appname, summary)
+		if e != nil {
+			return e
+		}
+		update(summary, config, canary)
 		curop = summary.OpNum
 	} else {
-		update(&Config{})
+		update(&Summary{}, &Config{}, nil)
 	}
-	for c.Next(context.Background()) {
-		var curindex uint64
-		var opnum uint64
+	for c.Next(ctx) {
+		summary := &Summary{}
 		switch c.Current.Lookup("operationType").StringValue() {
 		case "insert":
-			curindex = uint64(c.Current.Lookup("fullDocument").Document().Lookup("cur_index").AsInt64())
-			opnum = uint64(c.Current.Lookup("fullDocument").Document().Lookup("op_num").AsInt64())
+			fallthrough
 		case "update":
-			curindex = uint64(c.Current.Lookup("updateDescription").Document().Lookup("updatedFields").Document().Lookup("cur_index").AsInt64())
-			opnum = uint64(c.Current.Lookup("updateDescription").Document().Lookup("updatedFields").Document().Lookup("op_num").AsInt64())
+			//full document is looked up when this event is received,it's the newest summary
+			full, ok := c.Current.Lookup("fullDocument").DocumentOK()
+			if !ok {
+				//summary was deleted after this event
+				continue
+			}
+			if e := bson.Unmarshal(full, summary); e != nil {
+				return e
+			}
 		case "delete":
-			curindex = 0
-			opnum = 0
+			if curop == 0 {
+				continue
+			}
+			//the deleted document may not be the summary
+			current, _, e := d.GetInfo(ctx, groupname, appname)
+			if e != nil && e != ErrNotExist {
+				return e
+			} else if e == nil {
+				if current.OpNum == curop {
+					continue
+				}
+				summary = current
+			}
 		}
 		config := &Config{}
-		if opnum == 0 {
-			update(config)
+		if summary.OpNum == 0 || summary.Dtime != 0 || summary.CurIndex == 0 {
+			//not exist,deleted or only has drafts
+			update(&Summary{}, config, nil)
 			curop = 0
-		} else if opnum > curop {
-			if e := d.mongo.Database("s_"+groupname).Collection(appname).FindOne(context.Background(), bson.M{"index": curindex}).Decode(config); e != nil {
+		} else if summary.OpNum > curop {
+			if e := d.mongo.Database("s_"+groupname).Collection(appname).FindOne(ctx, bson.M{"index": summary.CurIndex}).Decode(config); e != nil {
+				return e
+			}
+			canary, e := d.getCanary(ctx, groupname, appname, summary)
+			if e != nil {
+				return e
+			}
+			update(summary, config, canary)
+			curop = summary.OpNum
+		}
+	}
+	if c.Err() != nil {
+		return c.Err()
+	}
+	return nil
+}
+
+func (d *mongoDao) WatchBase(ctx context.Context, groupname string, update func(*Base)) error {
+	pipeline := mongo.Pipeline{bson.D{bson.E{Key: "$match", Value: bson.M{"documentKey._id": groupname}}}}
+	c, e := d.mongo.Database("sconfig", options.Database().SetReadConcern(readconcern.Majority())).Collection("base").Watch(ctx, pipeline, options.ChangeStream().SetFullDocument(options.UpdateLookup))
+	if e != nil {
+		return e
+	}
+	defer c.Close(context.Background())
+	base, e := d.GetBase(ctx, groupname)
+	if e != nil && e != ErrNotExist {
+		return e
+	} else if e == ErrNotExist {
+		base = &Base{}
+	}
+	update(base)
+	curop := base.OpNum
+	for c.Next(ctx) {
+		base := &Base{}
+		//full document is looked up when this event is received,it's the newest base
+		if full, ok := c.Current.Lookup("fullDocument").DocumentOK(); ok {
+			if e := bson.Unmarshal(full, base); e != nil {
 				return e
 			}
-			update(config)
-			curop = opnum
+		}
+		if base.OpNum != curop {
+			update(base)
+			curop = base.OpNum
 		}
 	}
 	if c.Err() != nil {
@@ -184,3 +1133,14 @@ func (d *Dao) MongoWatch(groupname, appname string, update func(*Config)) error
 	}
 	return nil
 }
+
+func (d *mongoDao) getCanary(ctx context.Context, groupname, appname string, summary *Summary) (*Config, error) {
+	if summary.Canary == nil {
+		return nil, nil
+	}
+	canary := &Config{}
+	if e := d.mongo.Database("s_"+groupname).Collection(appname).FindOne(ctx, bson.M{"index": summary.Canary.Index}).Decode(canary); e != nil {
+		return nil, e
+	}
+	return canary, nil
+}
@@ -1 +1,1177 @@
 package sconfig
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+//all data is in the database sconfig
+//the database and the tables are created by Init
+var sqlTables = []string{
+	`CREATE DATABASE IF NOT EXISTS sconfig`,
+	`CREATE TABLE IF NOT EXISTS sconfig.summary(
+	groupname VARCHAR(64) NOT NULL,
+	appname VARCHAR(64) NOT NULL,
+	cur_index BIGINT UNSIGNED NOT NULL,
+	max_index BIGINT UNSIGNED NOT NULL,
+	op_num BIGINT UNSIGNED NOT NULL,
+	prev_index BIGINT UNSIGNED NOT NULL DEFAULT 0,
+	canary TEXT NULL,
+	dtime BIGINT UNSIGNED NOT NULL DEFAULT 0,
+	PRIMARY KEY(groupname,appname),
+	INDEX(dtime)
+)`,
+	`CREATE TABLE IF NOT EXISTS sconfig.config(
+	groupname VARCHAR(64) NOT NULL,
+	appname VARCHAR(64) NOT NULL,
+	config_index BIGINT UNSIGNED NOT NULL,
+	app_config MEDIUMTEXT NOT NULL,
+	source_config MEDIUMTEXT NOT NULL,
+	ctime BIGINT UNSIGNED NOT NULL DEFAULT 0,
+	author VARCHAR(128) NOT NULL DEFAULT '',
+	comment VARCHAR(1024) NOT NULL DEFAULT '',
+	draft TINYINT(1) NOT NULL DEFAULT 0,
+	tag VARCHAR(64) NOT NULL DEFAULT '',
+	PRIMARY KEY(groupname,appname,config_index)
+)`,
+	`CREATE TABLE IF NOT EXISTS sconfig.schema(
+	groupname VARCHAR(64) NOT NULL,
+	appname VARCHAR(64) NOT NULL,
+	schema_index BIGINT UNSIGNED NOT NULL,
+	app_schema MEDIUMTEXT NOT NULL,
+	source_schema MEDIUMTEXT NOT NULL,
+	ctime BIGINT UNSIGNED NOT NULL,
+	author VARCHAR(128) NOT NULL,
+	PRIMARY KEY(groupname,appname,schema_index)
+)`,
+	`CREATE TABLE IF NOT EXISTS sconfig.policy(
+	groupname VARCHAR(64) NOT NULL,
+	reviewers TEXT NOT NULL,
+	approvals INT UNSIGNED NOT NULL,
+	ctime BIGINT UNSIGNED NOT NULL,
+	author VARCHAR(128) NOT NULL,
+	PRIMARY KEY(groupname)
+)`,
+	`CREATE TABLE IF NOT EXISTS sconfig.retention(
+	groupname VARCHAR(64) NOT NULL,
+	keep_num INT UNSIGNED NOT NULL,
+	keep_time BIGINT UNSIGNED NOT NULL,
+	ctime BIGINT UNSIGNED NOT NULL,
+	author VARCHAR(128) NOT NULL,
+	PRIMARY KEY(groupname)
+)`,
+	`CREATE TABLE IF NOT EXISTS sconfig.base(
+	groupname VARCHAR(64) NOT NULL,
+	app_config MEDIUMTEXT NOT NULL,
+	source_config MEDIUMTEXT NOT NULL,
+	op_num BIGINT UNSIGNED NOT NULL,
+	ctime BIGINT UNSIGNED NOT NULL,
+	author VARCHAR(128) NOT NULL,
+	comment VARCHAR(1024) NOT NULL,
+	PRIMARY KEY(groupname)
+)`,
+	`CREATE TABLE IF NOT EXISTS sconfig.principal(
+	name VARCHAR(64) NOT NULL,
+	token CHAR(64) NOT NULL,
+	ctime BIGINT UNSIGNED NOT NULL,
+	author VARCHAR(128) NOT NULL,
+	PRIMARY KEY(name)
+)`,
+	`CREATE TABLE IF NOT EXISTS sconfig.grant(
+	principal VARCHAR(64) NOT NULL,
+	groupname VARCHAR(64) NOT NULL,
+	appname VARCHAR(64) NOT NULL,
+	role VARCHAR(16) NOT NULL,
+	reveal TINYINT(1) NOT NULL DEFAULT 0,
+	ctime BIGINT UNSIGNED NOT NULL,
+	author VARCHAR(128) NOT NULL,
+	PRIMARY KEY(principal,groupname,appname),
+	INDEX(groupname)
+)`,
+	`CREATE TABLE IF NOT EXISTS sconfig.read_token(
+	id CHAR(24) NOT NULL,
+	groupname VARCHAR(64) NOT NULL,
+	appname VARCHAR(64) NOT NULL,
+	token CHAR(64) NOT NULL,
+	expire BIGINT UNSIGNED NOT NULL,
+	comment VARCHAR(1024) NOT NULL,
+	ctime BIGINT UNSIGNED NOT NULL,
+	author VARCHAR(128) NOT NULL,
+	PRIMARY KEY(id),
+	INDEX(groupname,appname)
+)`,
+	`CREATE TABLE IF NOT EXISTS sconfig.lease(
+	name VARCHAR(64) NOT NULL,
+	owner VARCHAR(64) NOT NULL,
+	expire BIGINT UNSIGNED NOT NULL,
+	PRIMARY KEY(name)
+)`,
+	`CREATE TABLE IF NOT EXISTS sconfig.approval(
+	groupname VARCHAR(64) NOT NULL,
+	appname VARCHAR(64) NOT NULL,
+	config_index BIGINT UNSIGNED NOT NULL,
+	reviewer VARCHAR(128) NOT NULL,
+	approved TINYINT(1) NOT NULL,
+	comment VARCHAR(1024) NOT NULL,
+	ctime BIGINT UNSIGNED NOT NULL,
+	PRIMARY KEY(groupname,appname,config_index,reviewer)
+)`,
+	`CREATE TABLE IF NOT EXISTS sconfig.schedule(
+	id VARCHAR(24) NOT NULL,
+	groupname VARCHAR(64) NOT NULL,
+	appname VARCHAR(64) NOT NULL,
+	config_index BIGINT UNSIGNED NOT NULL,
+	fire_time BIGINT UNSIGNED NOT NULL,
+	status VARCHAR(16) NOT NULL,
+	result VARCHAR(1024) NOT NULL DEFAULT '',
+	ctime BIGINT UNSIGNED NOT NULL,
+	author VARCHAR(128) NOT NULL,
+	PRIMARY KEY(id),
+	INDEX(status,fire_time),
+	INDEX(groupname,appname,status)
+)`,
+	`CREATE TABLE IF NOT EXISTS sconfig.report(
+	groupname VARCHAR(64) NOT NULL,
+	appname VARCHAR(64) NOT NULL,
+	instance VARCHAR(128) NOT NULL,
+	op_num BIGINT UNSIGNED NOT NULL,
+	config_index BIGINT UNSIGNED NOT NULL,
+	canary TINYINT(1) NOT NULL,
+	error VARCHAR(1024) NOT NULL,
+	health VARCHAR(1024) NOT NULL DEFAULT '',
+	utime BIGINT UNSIGNED NOT NULL,
+	PRIMARY KEY(groupname,appname,instance)
+)`,
+	`CREATE TABLE IF NOT EXISTS sconfig.guard(
+	groupname VARCHAR(64) NOT NULL,
+	appname VARCHAR(64) NOT NULL,
+	op_num BIGINT UNSIGNED NOT NULL,
+	config_index BIGINT UNSIGNED NOT NULL,
+	prev_index BIGINT UNSIGNED NOT NULL,
+	fail_percent INT UNSIGNED NOT NULL,
+	min_reports INT UNSIGNED NOT NULL,
+	window_sec BIGINT UNSIGNED NOT NULL,
+	ctime BIGINT UNSIGNED NOT NULL,
+	author VARCHAR(128) NOT NULL,
+	PRIMARY KEY(groupname,appname)
+)`,
+	`CREATE TABLE IF NOT EXISTS sconfig.audit(
+	id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+	groupname VARCHAR(64) NOT NULL,
+	appname VARCHAR(64) NOT NULL,
+	op VARCHAR(32) NOT NULL,
+	caller VARCHAR(128) NOT NULL,
+	ip VARCHAR(64) NOT NULL,
+	from_index BIGINT UNSIGNED NOT NULL,
+	to_index BIGINT UNSIGNED NOT NULL,
+	op_num BIGINT UNSIGNED NOT NULL,
+	ctime BIGINT UNSIGNED NOT NULL,
+	detail VARCHAR(1024) NOT NULL DEFAULT '',
+	PRIMARY KEY(id),
+	INDEX(groupname,appname,ctime),
+	INDEX(groupname,ctime)
+)`,
+}
+
+type sqlDao struct {
+	sql *sql.DB
+}
+
+//summary's canary is saved as json,NULL means no canary
+func scanSummary(row *sql.Row) (*Summary, error) {
+	summary := &Summary{}
+	var canary sql.NullString
+	if e := row.Scan(&summary.CurIndex, &summary.MaxIndex, &summary.OpNum, &summary.PrevIndex, &canary, &summary.Dtime); e != nil {
+		return nil, e
+	}
+	if canary.Valid {
+		summary.Canary = &Canary{}
+		if e := json.Unmarshal([]byte(canary.String), summary.Canary); e != nil {
+			return nil, e
+		}
+	}
+	return summary, nil
+}
+
+//Init create the database and the tables when they don't exist
+func (d *sqlDao) Init(ctx context.Context) error {
+	for _, table := range sqlTables {
+		if _, e := d.sql.ExecContext(ctx, table); e != nil {
+			return e
+		}
+	}
+	return nil
+}
+
+func (d *sqlDao) GetInfo(ctx context.Context, groupname, appname string) (*Summary, *Config, error) {
+	summary, e := scanSummary(d.sql.QueryRowContext(ctx, "SELECT cur_index,max_index,op_num,prev_index,canary,dtime FROM sconfig.summary WHERE groupname=? AND appname=?", groupname, appname))
+	if e != nil {
+		if e == sql.ErrNoRows {
+			e = ErrNotExist
+		}
+		return nil, nil, e
+	}
+	if summary.CurIndex == 0 || summary.Dtime != 0 {
+		//only has drafts or deleted
+		return nil, nil, ErrNotExist
+	}
+	config, e := d.GetConfig(ctx, groupname, appname, summary.CurIndex)
+	if e != nil {
+		return nil, nil, e
+	}
+	return summary, config, nil
+}
+
+func (d *sqlDao) GetConfig(ctx context.Context, groupname, appname string, index uint64) (*Config, error) {
+	config := &Config{}
+	if e := d.sql.QueryRowContext(ctx, "SELECT config_index,app_config,source_config,ctime,author,comment,draft,tag FROM sconfig.config WHERE groupname=? AND appname=? AND config_index=?", groupname, appname, index).Scan(&config.Index, &config.AppConfig, &config.SourceConfig, &config.Ctime, &config.Author, &config.Comment, &config.Draft, &config.Tag); e != nil {
+		if e == sql.ErrNoRows {
+			e = ErrNotExist
+		}
+		return nil, e
+	}
+	return config, nil
+}
+
+func (d *sqlDao) transaction(ctx context.Context, f func(tx *sql.Tx) error) (e error) {
+	var tx *sql.Tx
+	if tx, e = d.sql.BeginTx(ctx, nil); e != nil {
+		return
+	}
+	defer func() {
+		if e != nil {
+			tx.Rollback()
+		} else if e = tx.Commit(); e != nil {
+			tx.Rollback()
+		}
+	}()
+	return f(tx)
+}
+
+//lockSummary make sure the summary exist,then lock it
+func (d *sqlDao) lockSummary(ctx context.Context, tx *sql.Tx, groupname, appname string) (*Summary, error) {
+	if _, e := tx.ExecContext(ctx, "INSERT IGNORE INTO sconfig.summary(groupname,appname,cur_index,max_index,op_num,prev_index) VALUES(?,?,0,0,0,0)", groupname, appname); e != nil {
+		return nil, e
+	}
+	return scanSummary(tx.QueryRowContext(ctx, "SELECT cur_index,max_index,op_num,prev_index,canary,dtime FROM sconfig.summary WHERE groupname=? AND appname=? FOR UPDATE", groupname, appname))
+}
+
+//setConfig must be called with the locked summary
+func (d *sqlDao) setConfig(ctx context.Context, tx *sql.Tx, groupname, appname string, summary *Summary, config *Config) error {
+	summary.MaxIndex++
+	//draft is not live,only the max_index will be changed
+	if config.Draft {
+		if _, e := tx.ExecContext(ctx, "UPDATE sconfig.summary SET max_index=? WHERE groupname=? AND appname=?", summary.MaxIndex, groupname, appname); e != nil {
+			return e
+		}
+	} else {
+		summary.PrevIndex = summary.CurIndex
+		summary.CurIndex = summary.MaxIndex
+		summary.OpNum++
+		summary.Canary = nil
+		if _, e := tx.ExecContext(ctx, "UPDATE sconfig.summary SET cur_index=?,max_index=?,op_num=?,prev_index=?,canary=NULL WHERE groupname=? AND appname=?", summary.CurIndex, summary.MaxIndex, summary.OpNum, summary.PrevIndex, groupname, appname); e != nil {
+			return e
+		}
+	}
+	config.Index = summary.MaxIndex
+	_, e := tx.ExecContext(ctx, "INSERT INTO sconfig.config(groupname,appname,config_index,app_config,source_config,ctime,author,comment,draft) VALUES(?,?,?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE app_config=VALUES(app_config),source_config=VALUES(source_config),ctime=VALUES(ctime),author=VALUES(author),comment=VALUES(comment),draft=VALUES(draft)", groupname, appname, config.Index, config.AppConfig, config.SourceConfig, config.Ctime, config.Author, config.Comment, config.Draft)
+	return e
+}
+
+func (d *sqlDao) SetConfig(ctx context.Context, groupname, appname string, config *Config, expectopnum uint64) (*Summary, error) {
+	var summary *Summary
+	e := d.transaction(ctx, func(tx *sql.Tx) (e error) {
+		if summary, e = d.lockSummary(ctx, tx, groupname, appname); e != nil {
+			return
+		}
+		if expectopnum != 0 && summary.OpNum != expectopnum {
+			return ErrOpNumConflict
+		}
+		if summary.Dtime != 0 {
+			return ErrDeleted
+		}
+		return d.setConfig(ctx, tx, groupname, appname, summary, config)
+	})
+	if e != nil {
+		return nil, e
+	}
+	return summary, nil
+}
+
+func (d *sqlDao) UpdateConfig(ctx context.Context, groupname, appname string, update func(*Config) (*Config, error), expectopnum uint64) (*Summary, error) {
+	var summary *Summary
+	e := d.transaction(ctx, func(tx *sql.Tx) (e error) {
+		if summary, e = d.lockSummary(ctx, tx, groupname, appname); e != nil {
+			return
+		}
+		if expectopnum != 0 && summary.OpNum != expectopnum {
+			return ErrOpNumConflict
+		}
+		if summary.Dtime != 0 {
+			return ErrDeleted
+		}
+		var current *Config
+		if summary.CurIndex != 0 {
+			current = &Config{}
+			if e = tx.QueryRowContext(ctx, "SELECT config_index,app_config,source_config,ctime,author,comment,draft,tag FROM sconfig.config WHERE groupname=? AND appname=? AND config_index=?", groupname, appname, summary.CurIndex).Scan(&current.Index, &current.AppConfig, &current.SourceConfig, &current.Ctime, &current.Author, &current.Comment, &current.Draft, &current.Tag); e != nil {
+				return
+			}
+		}
+		config, e := update(current)
+		if e != nil {
+			return e
+		}
+		return d.setConfig(ctx, tx, groupname, appname, summary, config)
+	})
+	if e != nil {
+		return nil, e
+	}
+	return summary, nil
+}
+
+func (d *sqlDao) RollbackConfig(ctx context.Context, groupname, appname string, index, expectopnum uint64) (*Summary, error) {
+	var summary *Summary
+	e := d.transaction(ctx, func(tx *sql.Tx) (e error) {
+		summary, e = d.rollbackConfig(ctx, tx, groupname, appname, index, expectopnum)
+		return
+	})
+	if e != nil {
+		return nil, e
+	}
+	return summary, nil
+}
+
+func (d *sqlDao) PublishConfig(ctx context.Context, groupname, appname string, index, expectopnum uint64) (*Summary, error) {
+	var summary *Summary
+	e := d.transaction(ctx, func(tx *sql.Tx) (e error) {
+		if summary, e = d.rollbackConfig(ctx, tx, groupname, appname, index, expectopnum); e != nil {
+			return
+		}
+		_, e = tx.ExecContext(ctx, "UPDATE sconfig.config SET draft=0 WHERE groupname=? AND appname=? AND config_index=?", groupname, appname, index)
+		return
+	})
+	if e != nil {
+		return nil, e
+	}
+	return summary, nil
+}
+
+func (d *sqlDao) rollbackConfig(ctx context.Context, tx *sql.Tx, groupname, appname string, index, expectopnum uint64) (*Summary, error) {
+	summary := &Summary{}
+	if e := tx.QueryRowContext(ctx, "SELECT cur_index,max_index,op_num,dtime FROM sconfig.summary WHERE groupname=? AND appname=? FOR UPDATE", groupname, appname).Scan(&summary.CurIndex, &summary.MaxIndex, &summary.OpNum, &summary.Dtime); e != nil {
+		if e == sql.ErrNoRows {
+			e = ErrNotExist
+		}
+		return nil, e
+	}
+	if summary.Dtime != 0 {
+		return nil, ErrNotExist
+	}
+	//the version may be pruned,the prune also locks the summary
+	var tmp uint64
+	if e := tx.QueryRowContext(ctx, "SELECT config_index FROM sconfig.config WHERE groupname=? AND appname=? AND config_index=?", groupname, appname, index).Scan(&tmp); e != nil {
+		if e == sql.ErrNoRows {
+			e = ErrNotExist
+		}
+		return nil, e
+	}
+	if expectopnum != 0 && summary.OpNum != expectopnum {
+		return nil, ErrOpNumConflict
+	}
+	summary.PrevIndex = summary.CurIndex
+	summary.CurIndex = index
+	summary.OpNum++
+	if _, e := tx.ExecContext(ctx, "UPDATE sconfig.summary SET cur_index=?,op_num=?,prev_index=?,canary=NULL WHERE groupname=? AND appname=?", summary.CurIndex, summary.OpNum, summary.PrevIndex, groupname, appname); e != nil {
+		return nil, e
+	}
+	return summary, nil
+}
+
+func (d *sqlDao) SetCanary(ctx context.Context, groupname, appname string, canary *Canary, expectopnum uint64) (*Summary, error) {
+	var summary *Summary
+	e := d.transaction(ctx, func(tx *sql.Tx) (e error) {
+		if summary, e = scanSummary(tx.QueryRowContext(ctx, "SELECT cur_index,max_index,op_num,prev_index,canary,dtime FROM sconfig.summary WHERE groupname=? AND appname=? FOR UPDATE", groupname, appname)); e != nil {
+			if e == sql.ErrNoRows {
+				e = ErrNotExist
+			}
+			return
+		}
+		if summary.CurIndex == 0 || summary.Dtime != 0 {
+			return ErrNotExist
+		}
+		var canarystr sql.NullString
+		if canary != nil {
+			var tmp uint64
+			if e = tx.QueryRowContext(ctx, "SELECT config_index FROM sconfig.config WHERE groupname=? AND appname=? AND config_index=?", groupname, appname, canary.Index).Scan(&tmp); e != nil {
+				if e == sql.ErrNoRows {
+					e = ErrNotExist
+				}
+				return
+			}
+			tmpstr, _ := json.Marshal(canary)
+			canarystr.String = string(tmpstr)
+			canarystr.Valid = true
+		}
+		if expectopnum != 0 && summary.OpNum != expectopnum {
+			return ErrOpNumConflict
+		}
+		summary.OpNum++
+		summary.Canary = canary
+		_, e = tx.ExecContext(ctx, "UPDATE sconfig.summary SET op_num=?,canary=? WHERE groupname=? AND appname=?", summary.OpNum, canarystr, groupname, appname)
+		return
+	})
+	if e != nil {
+		return nil, e
+	}
+	return summary, nil
+}
+
+func (d *sqlDao) GetHistory(ctx context.Context, groupname, appname string, skip, limit uint64) ([]*Config, uint64, error) {
+	var total uint64
+	if e := d.sql.QueryRowContext(ctx, "SELECT COUNT(*) FROM sconfig.config WHERE groupname=? AND appname=?", groupname, appname).Scan(&total); e != nil {
+		return nil, 0, e
+	}
+	rows, e := d.sql.QueryContext(ctx, "SELECT config_index,app_config,source_config,ctime,author,comment,draft,tag FROM sconfig.config WHERE groupname=? AND appname=? ORDER BY config_index DESC LIMIT ?,?", groupname, appname, skip, limit)
+	if e != nil {
+		return nil, 0, e
+	}
+	defer rows.Close()
+	result := make([]*Config, 0, limit)
+	for rows.Next() {
+		config := &Config{}
+		if e = rows.Scan(&config.Index, &config.AppConfig, &config.SourceConfig, &config.Ctime, &config.Author, &config.Comment, &config.Draft, &config.Tag); e != nil {
+			return nil, 0, e
+		}
+		result = append(result, config)
+	}
+	if e = rows.Err(); e != nil {
+		return nil, 0, e
+	}
+	return result, total, nil
+}
+
+func (d *sqlDao) GetDrafts(ctx context.Context, groupname, appname string) ([]*Config, error) {
+	rows, e := d.sql.QueryContext(ctx, "SELECT config_index,app_config,source_config,ctime,author,comment,draft,tag FROM sconfig.config WHERE groupname=? AND appname=? AND draft=1 ORDER BY config_index DESC", groupname, appname)
+	if e != nil {
+		return nil, e
+	}
+	defer rows.Close()
+	result := make([]*Config, 0)
+	for rows.Next() {
+		config := &Config{}
+		if e = rows.Scan(&config.Index, &config.AppConfig, &config.SourceConfig, &config.Ctime, &config.Author, &config.Comment, &config.Draft, &config.Tag); e != nil {
+			return nil, e
+		}
+		result = append(result, config)
+	}
+	return result, rows.Err()
+}
+
+func (d *sqlDao) GetVersions(ctx context.Context, groupname, appname string) ([]*Config, error) {
+	rows, e := d.sql.QueryContext(ctx, "SELECT config_index,ctime,author,comment,draft,tag FROM sconfig.config WHERE groupname=? AND appname=? ORDER BY config_index DESC", groupname, appname)
+	if e != nil {
+		return nil, e
+	}
+	defer rows.Close()
+	result := make([]*Config, 0)
+	for rows.Next() {
+		config := &Config{}
+		if e = rows.Scan(&config.Index, &config.Ctime, &config.Author, &config.Comment, &config.Draft, &config.Tag); e != nil {
+			return nil, e
+		}
+		result = append(result, config)
+	}
+	return result, rows.Err()
+}
+
+func (d *sqlDao) SetTag(ctx context.Context, groupname, appname string, index uint64, tag string) error {
+	r, e := d.sql.ExecContext(ctx, "UPDATE sconfig.config SET tag=? WHERE groupname=? AND appname=? AND config_index=?", tag, groupname, appname, index)
+	if e != nil {
+		return e
+	}
+	//mysql's affected rows is 0 when nothing changed,so check the existence again
+	if affected, e := r.RowsAffected(); e != nil || affected != 0 {
+		return e
+	}
+	if _, e = d.GetConfig(ctx, groupname, appname, index); e != nil {
+		return e
+	}
+	return nil
+}
+
+func (d *sqlDao) DelConfigs(ctx context.Context, groupname, appname string, indexes []uint64) ([]uint64, error) {
+	result := make([]uint64, 0, len(indexes))
+	e := d.transaction(ctx, func(tx *sql.Tx) error {
+		//lock the summary,so the concurrent rollback will not point to the deleted version
+		summary, e := scanSummary(tx.QueryRowContext(ctx, "SELECT cur_index,max_index,op_num,prev_index,canary,dtime FROM sconfig.summary WHERE groupname=? AND appname=? FOR UPDATE", groupname, appname))
+		if e != nil {
+			if e == sql.ErrNoRows {
+				return nil
+			}
+			return e
+		}
+		for _, index := range indexes {
+			if index == summary.CurIndex || (summary.Canary != nil && index == summary.Canary.Index) {
+				continue
+			}
+			r, e := tx.ExecContext(ctx, "DELETE FROM sconfig.config WHERE groupname=? AND appname=? AND config_index=?", groupname, appname, index)
+			if e != nil {
+				return e
+			}
+			if affected, e := r.RowsAffected(); e != nil {
+				return e
+			} else if affected != 0 {
+				result = append(result, index)
+			}
+		}
+		return nil
+	})
+	if e != nil {
+		return nil, e
+	}
+	return result, nil
+}
+
+func (d *sqlDao) GetGroups(ctx context.Context) ([]string, error) {
+	return d.getStrings(ctx, "SELECT DISTINCT groupname FROM sconfig.summary WHERE dtime=0")
+}
+
+func (d *sqlDao) GetApps(ctx context.Context, groupname string) ([]string, error) {
+	return d.getStrings(ctx, "SELECT appname FROM sconfig.summary WHERE groupname=? AND dtime=0", groupname)
+}
+
+func (d *sqlDao) DelApp(ctx context.Context, groupname, appname string, dtime uint64) (*Summary, error) {
+	var summary *Summary
+	e := d.transaction(ctx, func(tx *sql.Tx) (e error) {
+		if summary, e = scanSummary(tx.QueryRowContext(ctx, "SELECT cur_index,max_index,op_num,prev_index,canary,dtime FROM sconfig.summary WHERE groupname=? AND appname=? FOR UPDATE", groupname, appname)); e != nil {
+			if e == sql.ErrNoRows {
+				e = ErrNotExist
+			}
+			return
+		}
+		if summary.Dtime != 0 {
+			return ErrNotExist
+		}
+		summary.Dtime = dtime
+		summary.OpNum++
+		summary.Canary = nil
+		_, e = tx.ExecContext(ctx, "UPDATE sconfig.summary SET dtime=?,op_num=?,canary=NULL WHERE groupname=? AND appname=?", summary.Dtime, summary.OpNum, groupname, appname)
+		return
+	})
+	if e != nil {
+		return nil, e
+	}
+	return summary, nil
+}
+
+func (d *sqlDao) RestoreApp(ctx context.Context, groupname, appname string) (*Summary, error) {
+	var summary *Summary
+	e := d.transaction(ctx, func(tx *sql.Tx) (e error) {
+		if summary, e = scanSummary(tx.QueryRowContext(ctx, "SELECT cur_index,max_index,op_num,prev_index,canary,dtime FROM sconfig.summary WHERE groupname=? AND appname=? FOR UPDATE", groupname, appname)); e != nil {
+			if e == sql.ErrNoRows {
+				e = ErrNotExist
+			}
+			return
+		}
+		if summary.Dtime == 0 {
+			return ErrNotExist
+		}
+		summary.Dtime = 0
+		summary.OpNum++
+		_, e = tx.ExecContext(ctx, "UPDATE sconfig.summary SET dtime=0,op_num=? WHERE groupname=? AND appname=?", summary.OpNum, groupname, appname)
+		return
+	})
+	if e != nil {
+		return nil, e
+	}
+	return summary, nil
+}
+
+func (d *sqlDao) PurgeApp(ctx context.Context, groupname, appname string, dtime uint64) error {
+	return d.transaction(ctx, func(tx *sql.Tx) error {
+		var current uint64
+		if e := tx.QueryRowContext(ctx, "SELECT dtime FROM sconfig.summary WHERE groupname=? AND appname=? FOR UPDATE", groupname, appname).Scan(&current); e != nil {
+			if e == sql.ErrNoRows {
+				e = ErrNotExist
+			}
+			return e
+		}
+		if current == 0 || (dtime != 0 && current != dtime) {
+			return ErrNotExist
+		}
+		for _, table := range []string{"summary", "config", "schema", "approval", "schedule", "report", "guard", "read_token"} {
+			if _, e := tx.ExecContext(ctx, "DELETE FROM sconfig."+table+" WHERE groupname=? AND appname=?", groupname, appname); e != nil {
+				return e
+			}
+		}
+		return nil
+	})
+}
+
+func (d *sqlDao) GetRecycle(ctx context.Context, groupname string) ([]*Recycle, error) {
+	query := "SELECT groupname,appname,dtime FROM sconfig.summary WHERE dtime>0"
+	args := make([]interface{}, 0, 1)
+	if groupname != "" {
+		query += " AND groupname=?"
+		args = append(args, groupname)
+	}
+	query += " ORDER BY dtime,groupname,appname"
+	rows, e := d.sql.QueryContext(ctx, query, args...)
+	if e != nil {
+		return nil, e
+	}
+	defer rows.Close()
+	result := make([]*Recycle, 0)
+	for rows.Next() {
+		recycle := &Recycle{}
+		if e = rows.Scan(&recycle.Groupname, &recycle.Appname, &recycle.Dtime); e != nil {
+			return nil, e
+		}
+		result = append(result, recycle)
+	}
+	return result, rows.Err()
+}
+
+func (d *sqlDao) getStrings(ctx context.Context, query string, args ...interface{}) ([]string, error) {
+	rows, e := d.sql.QueryContext(ctx, query, args...)
+	if e != nil {
+		return nil, e
+	}
+	defer rows.Close()
+	result := make([]string, 0)
+	for rows.Next() {
+		var str string
+		if e = rows.Scan(&str); e != nil {
+			return nil, e
+		}
+		result = append(result, str)
+	}
+	return result, rows.Err()
+}
+
+func (d *sqlDao) SetSchema(ctx context.Context, groupname, appname string, schema *Schema) error {
+	return d.transaction(ctx, func(tx *sql.Tx) error {
+		var max uint64
+		if e := tx.QueryRowContext(ctx, "SELECT IFNULL(MAX(schema_index),0) FROM sconfig.schema WHERE groupname=? AND appname=? FOR UPDATE", groupname, appname).Scan(&max); e != nil {
+			return e
+		}
+		schema.Index = max + 1
+		_, e := tx.ExecContext(ctx, "INSERT INTO sconfig.schema(groupname,appname,schema_index,app_schema,source_schema,ctime,author) VALUES(?,?,?,?,?,?,?)", groupname, appname, schema.Index, schema.AppSchema, schema.SourceSchema, schema.Ctime, schema.Author)
+		return e
+	})
+}
+
+func (d *sqlDao) GetSchema(ctx context.Context, groupname, appname string, index uint64) (*Schema, error) {
+	schema := &Schema{}
+	var row *sql.Row
+	if index == 0 {
+		row = d.sql.QueryRowContext(ctx, "SELECT schema_index,app_schema,source_schema,ctime,author FROM sconfig.schema WHERE groupname=? AND appname=? ORDER BY schema_index DESC LIMIT 1", groupname, appname)
+	} else {
+		row = d.sql.QueryRowContext(ctx, "SELECT schema_index,app_schema,source_schema,ctime,author FROM sconfig.schema WHERE groupname=? AND appname=? AND schema_index=?", groupname, appname, index)
+	}
+	if e := row.Scan(&schema.Index, &schema.AppSchema, &schema.SourceSchema, &schema.Ctime, &schema.Author); e != nil {
+		if e == sql.ErrNoRows {
+			e = ErrNotExist
+		}
+		return nil, e
+	}
+	return schema, nil
+}
+
+//reviewers is saved as json array
+func (d *sqlDao) SetPolicy(ctx context.Context, policy *Policy) error {
+	reviewers, _ := json.Marshal(policy.Reviewers)
+	_, e := d.sql.ExecContext(ctx, "INSERT INTO sconfig.policy(groupname,reviewers,approvals,ctime,author) VALUES(?,?,?,?,?) ON DUPLICATE KEY UPDATE reviewers=VALUES(reviewers),approvals=VALUES(approvals),ctime=VALUES(ctime),author=VALUES(author)", policy.Groupname, string(reviewers), policy.Approvals, policy.Ctime, policy.Author)
+	return e
+}
+
+func (d *sqlDao) GetPolicy(ctx context.Context, groupname string) (*Policy, error) {
+	policy := &Policy{Groupname: groupname}
+	var reviewers string
+	if e := d.sql.QueryRowContext(ctx, "SELECT reviewers,approvals,ctime,author FROM sconfig.policy WHERE groupname=?", groupname).Scan(&reviewers, &policy.Approvals, &policy.Ctime, &policy.Author); e != nil {
+		if e == sql.ErrNoRows {
+			e = ErrNotExist
+		}
+		return nil, e
+	}
+	if e := json.Unmarshal([]byte(reviewers), &policy.Reviewers); e != nil {
+		return nil, e
+	}
+	return policy, nil
+}
+
+func (d *sqlDao) SetRetention(ctx context.Context, retention *Retention) error {
+	_, e := d.sql.ExecContext(ctx, "INSERT INTO sconfig.retention(groupname,keep_num,keep_time,ctime,author) VALUES(?,?,?,?,?) ON DUPLICATE KEY UPDATE keep_num=VALUES(keep_num),keep_time=VALUES(keep_time),ctime=VALUES(ctime),author=VALUES(author)", retention.Groupname, retention.KeepNum, retention.KeepTime, retention.Ctime, retention.Author)
+	return e
+}
+
+func (d *sqlDao) GetRetention(ctx context.Context, groupname string) (*Retention, error) {
+	retention := &Retention{Groupname: groupname}
+	if e := d.sql.QueryRowContext(ctx, "SELECT keep_num,keep_time,ctime,author FROM sconfig.retention WHERE groupname=?", groupname).Scan(&retention.KeepNum, &retention.KeepTime, &retention.Ctime, &retention.Author); e != nil {
+		if e == sql.ErrNoRows {
+			e = ErrNotExist
+		}
+		return nil, e
+	}
+	return retention, nil
+}
+
+func (d *sqlDao) GetRetentions(ctx context.Context) ([]*Retention, error) {
+	rows, e := d.sql.QueryContext(ctx, "SELECT groupname,keep_num,keep_time,ctime,author FROM sconfig.retention ORDER BY groupname")
+	if e != nil {
+		return nil, e
+	}
+	defer rows.Close()
+	result := make([]*Retention, 0)
+	for rows.Next() {
+		retention := &Retention{}
+		if e = rows.Scan(&retention.Groupname, &retention.KeepNum, &retention.KeepTime, &retention.Ctime, &retention.Author); e != nil {
+			return nil, e
+		}
+		result = append(result, retention)
+	}
+	return result, rows.Err()
+}
+
+func (d *sqlDao) SetBase(ctx context.Context, base *Base, expectopnum uint64) error {
+	return d.transaction(ctx, func(tx *sql.Tx) error {
+		var opnum uint64
+		if e := tx.QueryRowContext(ctx, "SELECT op_num FROM sconfig.base WHERE groupname=? FOR UPDATE", base.Groupname).Scan(&opnum); e != nil && e != sql.ErrNoRows {
+			return e
+		}
+		if expectopnum != 0 && opnum != expectopnum {
+			return ErrOpNumConflict
+		}
+		base.OpNum = opnum + 1
+		_, e := tx.ExecContext(ctx, "INSERT INTO sconfig.base(groupname,app_config,source_config,op_num,ctime,author,comment) VALUES(?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE app_config=VALUES(app_config),source_config=VALUES(source_config),op_num=VALUES(op_num),ctime=VALUES(ctime),author=VALUES(author),comment=VALUES(comment)", base.Groupname, base.AppConfig, base.SourceConfig, base.OpNum, base.Ctime, base.Author, base.Comment)
+		return e
+	})
+}
+
+func (d *sqlDao) GetBase(ctx context.Context, groupname string) (*Base, error) {
+	base := &Base{Groupname: groupname}
+	if e := d.sql.QueryRowContext(ctx, "SELECT app_config,source_config,op_num,ctime,author,comment FROM sconfig.base WHERE groupname=?", groupname).Scan(&base.AppConfig, &base.SourceConfig, &base.OpNum, &base.Ctime, &base.Author, &base.Comment); e != nil {
+		if e == sql.ErrNoRows {
+			e = ErrNotExist
+		}
+		return nil, e
+	}
+	return base, nil
+}
+
+func (d *sqlDao) SetApproval(ctx context.Context, approval *Approval) error {
+	_, e := d.sql.ExecContext(ctx, "INSERT INTO sconfig.approval(groupname,appname,config_index,reviewer,approved,comment,ctime) VALUES(?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE approved=VALUES(approved),comment=VALUES(comment),ctime=VALUES(ctime)", approval.Groupname, approval.Appname, approval.Index, approval.Reviewer, approval.Approved, approval.Comment, approval.Ctime)
+	return e
+}
+
+func (d *sqlDao) GetApprovals(ctx context.Context, groupname, appname string, index uint64) ([]*Approval, error) {
+	rows, e := d.sql.QueryContext(ctx, "SELECT reviewer,approved,comment,ctime FROM sconfig.approval WHERE groupname=? AND appname=? AND config_index=?", groupname, appname, index)
+	if e != nil {
+		return nil, e
+	}
+	defer rows.Close()
+	result := make([]*Approval, 0)
+	for rows.Next() {
+		approval := &Approval{Groupname: groupname, Appname: appname, Index: index}
+		if e = rows.Scan(&approval.Reviewer, &approval.Approved, &approval.Comment, &approval.Ctime); e != nil {
+			return nil, e
+		}
+		result = append(result, approval)
+	}
+	return result, rows.Err()
+}
+
+func (d *sqlDao) AddSchedule(ctx context.Context, schedule *Schedule) error {
+	schedule.ID = primitive.NewObjectID().Hex()
+	_, e := d.sql.ExecContext(ctx, "INSERT INTO sconfig.schedule(id,groupname,appname,config_index,fire_time,status,result,ctime,author) VALUES(?,?,?,?,?,?,?,?,?)", schedule.ID, schedule.Groupname, schedule.Appname, schedule.Index, schedule.FireTime, schedule.Status, schedule.Result, schedule.Ctime, schedule.Author)
+	return e
+}
+
+func (d *sqlDao) GetSchedules(ctx context.Context, groupname, appname string) ([]*Schedule, error) {
+	if appname == "" {
+		return d.getSchedules(ctx, " WHERE groupname=? AND status IN (?,?)", groupname, SchedulePending, ScheduleFiring)
+	}
+	return d.getSchedules(ctx, " WHERE groupname=? AND appname=? AND status IN (?,?)", groupname, appname, SchedulePending, ScheduleFiring)
+}
+
+func (d *sqlDao) GetDueSchedules(ctx context.Context, firetime uint64) ([]*Schedule, error) {
+	return d.getSchedules(ctx, " WHERE status IN (?,?) AND fire_time<=?", SchedulePending, ScheduleFiring, firetime)
+}
+
+func (d *sqlDao) getSchedules(ctx context.Context, where string, args ...interface{}) ([]*Schedule, error) {
+	rows, e := d.sql.QueryContext(ctx, "SELECT id,groupname,appname,config_index,fire_time,status,result,ctime,author FROM sconfig.schedule"+where+" ORDER BY fire_time", args...)
+	if e != nil {
+		return nil, e
+	}
+	defer rows.Close()
+	result := make([]*Schedule, 0)
+	for rows.Next() {
+		schedule := &Schedule{}
+		if e = rows.Scan(&schedule.ID, &schedule.Groupname, &schedule.Appname, &schedule.Index, &schedule.FireTime, &schedule.Status, &schedule.Result, &schedule.Ctime, &schedule.Author); e != nil {
+			return nil, e
+		}
+		result = append(result, schedule)
+	}
+	return result, rows.Err()
+}
+
+func (d *sqlDao) UpdateSchedule(ctx context.Context, groupname, appname, id, from, to, result string) (*Schedule, error) {
+	r, e := d.sql.ExecContext(ctx, "UPDATE sconfig.schedule SET status=?,result=? WHERE id=? AND groupname=? AND appname=? AND status=?", to, result, id, groupname, appname, from)
+	if e != nil {
+		return nil, e
+	}
+	//status is changed,so the affected rows is accurate
+	if n, e := r.RowsAffected(); e != nil {
+		return nil, e
+	} else if n == 0 {
+		return nil, ErrNotExist
+	}
+	schedule := &Schedule{}
+	if e = d.sql.QueryRowContext(ctx, "SELECT id,groupname,appname,config_index,fire_time,status,result,ctime,author FROM sconfig.schedule WHERE id=?", id).Scan(&schedule.ID, &schedule.Groupname, &schedule.Appname, &schedule.Index, &schedule.FireTime, &schedule.Status, &schedule.Result, &schedule.Ctime, &schedule.Author); e != nil {
+		return nil, e
+	}
+	return schedule, nil
+}
+
+func (d *sqlDao) SetReport(ctx context.Context, report *Report) error {
+	_, e := d.sql.ExecContext(ctx, "INSERT INTO sconfig.report(groupname,appname,instance,op_num,config_index,canary,error,health,utime) VALUES(?,?,?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE op_num=VALUES(op_num),config_index=VALUES(config_index),canary=VALUES(canary),error=VALUES(error),health=VALUES(health),utime=VALUES(utime)", report.Groupname, report.Appname, report.Instance, report.OpNum, report.Index, report.Canary, report.Error, report.Health, report.Utime)
+	return e
+}
+
+func (d *sqlDao) GetReports(ctx context.Context, groupname, appname string) ([]*Report, error) {
+	rows, e := d.sql.QueryContext(ctx, "SELECT instance,op_num,config_index,canary,error,health,utime FROM sconfig.report WHERE groupname=? AND appname=? ORDER BY instance", groupname, appname)
+	if e != nil {
+		return nil, e
+	}
+	defer rows.Close()
+	result := make([]*Report, 0)
+	for rows.Next() {
+		report := &Report{Groupname: groupname, Appname: appname}
+		if e = rows.Scan(&report.Instance, &report.OpNum, &report.Index, &report.Canary, &report.Error, &report.Health, &report.Utime); e != nil {
+			return nil, e
+		}
+		result = append(result, report)
+	}
+	return result, rows.Err()
+}
+
+func (d *sqlDao) SetGuard(ctx context.Context, guard *Guard) error {
+	_, e := d.sql.ExecContext(ctx, "INSERT INTO sconfig.guard(groupname,appname,op_num,config_index,prev_index,fail_percent,min_reports,window_sec,ctime,author) VALUES(?,?,?,?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE op_num=VALUES(op_num),config_index=VALUES(config_index),prev_index=VALUES(prev_index),fail_percent=VALUES(fail_percent),min_reports=VALUES(min_reports),window_sec=VALUES(window_sec),ctime=VALUES(ctime),author=VALUES(author)", guard.Groupname, guard.Appname, guard.OpNum, guard.Index, guard.PrevIndex, guard.FailPercent, guard.MinReports, guard.Window, guard.Ctime, guard.Author)
+	return e
+}
+
+func (d *sqlDao) GetGuard(ctx context.Context, groupname, appname string) (*Guard, error) {
+	guard := &Guard{Groupname: groupname, Appname: appname}
+	if e := d.sql.QueryRowContext(ctx, "SELECT op_num,config_index,prev_index,fail_percent,min_reports,window_sec,ctime,author FROM sconfig.guard WHERE groupname=? AND appname=?", groupname, appname).Scan(&guard.OpNum, &guard.Index, &guard.PrevIndex, &guard.FailPercent, &guard.MinReports, &guard.Window, &guard.Ctime, &guard.Author); e != nil {
+		if e == sql.ErrNoRows {
+			e = ErrNotExist
+		}
+		return nil, e
+	}
+	return guard, nil
+}
+
+func (d *sqlDao) GetGuards(ctx context.Context) ([]*Guard, error) {
+	rows, e := d.sql.QueryContext(ctx, "SELECT groupname,appname,op_num,config_index,prev_index,fail_percent,min_reports,window_sec,ctime,author FROM sconfig.guard")
+	if e != nil {
+		return nil, e
+	}
+	defer rows.Close()
+	result := make([]*Guard, 0)
+	for rows.Next() {
+		guard := &Guard{}
+		if e = rows.Scan(&guard.Groupname, &guard.Appname, &guard.OpNum, &guard.Index, &guard.PrevIndex, &guard.FailPercent, &guard.MinReports, &guard.Window, &guard.Ctime, &guard.Author); e != nil {
+			return nil, e
+		}
+		result = append(result, guard)
+	}
+	return result, rows.Err()
+}
+
+func (d *sqlDao) DelGuard(ctx context.Context, groupname, appname string, opnum uint64) error {
+	_, e := d.sql.ExecContext(ctx, "DELETE FROM sconfig.guard WHERE groupname=? AND appname=? AND op_num=?", groupname, appname, opnum)
+	return e
+}
+
+func (d *sqlDao) SetPrincipal(ctx context.Context, principal *Principal) error {
+	_, e := d.sql.ExecContext(ctx, "INSERT INTO sconfig.principal(name,token,ctime,author) VALUES(?,?,?,?) ON DUPLICATE KEY UPDATE token=VALUES(token),ctime=VALUES(ctime),author=VALUES(author)", principal.Name, principal.Token, principal.Ctime, principal.Author)
+	return e
+}
+
+func (d *sqlDao) GetPrincipal(ctx context.Context, name string) (*Principal, error) {
+	principal := &Principal{Name: name}
+	if e := d.sql.QueryRowContext(ctx, "SELECT token,ctime,author FROM sconfig.principal WHERE name=?", name).Scan(&principal.Token, &principal.Ctime, &principal.Author); e != nil {
+		if e == sql.ErrNoRows {
+			e = ErrNotExist
+		}
+		return nil, e
+	}
+	return principal, nil
+}
+
+func (d *sqlDao) DelPrincipal(ctx context.Context, name string) error {
+	return d.transaction(ctx, func(tx *sql.Tx) error {
+		r, e := tx.ExecContext(ctx, "DELETE FROM sconfig.principal WHERE name=?", name)
+		if e != nil {
+			return e
+		}
+		if n, e := r.RowsAffected(); e != nil {
+			return e
+		} else if n == 0 {
+			return ErrNotExist
+		}
+		_, e = tx.ExecContext(ctx, "DELETE FROM sconfig.grant WHERE principal=?", name)
+		return e
+	})
+}
+
+func (d *sqlDao) GetPrincipals(ctx context.Context) ([]*Principal, error) {
+	rows, e := d.sql.QueryContext(ctx, "SELECT name,token,ctime,author FROM sconfig.principal ORDER BY name")
+	if e != nil {
+		return nil, e
+	}
+	defer rows.Close()
+	result := make([]*Principal, 0)
+	for rows.Next() {
+		principal := &Principal{}
+		if e = rows.Scan(&principal.Name, &principal.Token, &principal.Ctime, &principal.Author); e != nil {
+			return nil, e
+		}
+		result = append(result, principal)
+	}
+	return result, rows.Err()
+}
+
+func (d *sqlDao) SetGrant(ctx context.Context, grant *Grant) error {
+	_, e := d.sql.ExecContext(ctx, "INSERT INTO sconfig.grant(principal,groupname,appname,role,reveal,ctime,author) VALUES(?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE role=VALUES(role),reveal=VALUES(reveal),ctime=VALUES(ctime),author=VALUES(author)", grant.Principal, grant.Groupname, grant.Appname, grant.Role, grant.Reveal, grant.Ctime, grant.Author)
+	return e
+}
+
+func (d *sqlDao) DelGrant(ctx context.Context, principal, groupname, appname string) error {
+	r, e := d.sql.ExecContext(ctx, "DELETE FROM sconfig.grant WHERE principal=? AND groupname=? AND appname=?", principal, groupname, appname)
+	if e != nil {
+		return e
+	}
+	if n, e := r.RowsAffected(); e != nil {
+		return e
+	} else if n == 0 {
+		return ErrNotExist
+	}
+	return nil
+}
+
+func (d *sqlDao) GetGrants(ctx context.Context, principal, groupname string) ([]*Grant, error) {
+	query := "SELECT principal,groupname,appname,role,reveal,ctime,author FROM sconfig.grant WHERE 1=1"
+	args := make([]interface{}, 0, 2)
+	if principal != "" {
+		query += " AND principal=?"
+		args = append(args, principal)
+	}
+	if groupname != "" {
+		query += " AND groupname=?"
+		args = append(args, groupname)
+	}
+	query += " ORDER BY principal,groupname,appname"
+	rows, e := d.sql.QueryContext(ctx, query, args...)
+	if e != nil {
+		return nil, e
+	}
+	defer rows.Close()
+	result := make([]*Grant, 0)
+	for rows.Next() {
+		grant := &Grant{}
+		if e = rows.Scan(&grant.Principal, &grant.Groupname, &grant.Appname, &grant.Role, &grant.Reveal, &grant.Ctime, &grant.Author); e != nil {
+			return nil, e
+		}
+		result = append(result, grant)
+	}
+	return result, rows.Err()
+}
+
+func (d *sqlDao) AddReadToken(ctx context.Context, token *ReadToken) error {
+	token.ID = primitive.NewObjectID().Hex()
+	_, e := d.sql.ExecContext(ctx, "INSERT INTO sconfig.read_token(id,groupname,appname,token,expire,comment,ctime,author) VALUES(?,?,?,?,?,?,?,?)", token.ID, token.Groupname, token.Appname, token.Token, token.Expire, token.Comment, token.Ctime, token.Author)
+	return e
+}
+
+func (d *sqlDao) GetReadToken(ctx context.Context, id string) (*ReadToken, error) {
+	token := &ReadToken{ID: id}
+	if e := d.sql.QueryRowContext(ctx, "SELECT groupname,appname,token,expire,comment,ctime,author FROM sconfig.read_token WHERE id=?", id).Scan(&token.Groupname, &token.Appname, &token.Token, &token.Expire, &token.Comment, &token.Ctime, &token.Author); e != nil {
+		if e == sql.ErrNoRows {
+			e = ErrNotExist
+		}
+		return nil, e
+	}
+	return token, nil
+}
+
+func (d *sqlDao) GetReadTokens(ctx context.Context, groupname, appname string) ([]*ReadToken, error) {
+	rows, e := d.sql.QueryContext(ctx, "SELECT id,token,expire,comment,ctime,author FROM sconfig.read_token WHERE groupname=? AND appname=? ORDER BY ctime,id", groupname, appname)
+	if e != nil {
+		return nil, e
+	}
+	defer rows.Close()
+	result := make([]*ReadToken, 0)
+	for rows.Next() {
+		token := &ReadToken{Groupname: groupname, Appname: appname}
+		if e = rows.Scan(&token.ID, &token.Token, &token.Expire, &token.Comment, &token.Ctime, &token.Author); e != nil {
+			return nil, e
+		}
+		result = append(result, token)
+	}
+	return result, rows.Err()
+}
+
+func (d *sqlDao) ExpireReadToken(ctx context.Context, groupname, appname, id string, expire uint64) error {
+	r, e := d.sql.ExecContext(ctx, "UPDATE sconfig.read_token SET expire=? WHERE id=? AND groupname=? AND appname=?", expire, id, groupname, appname)
+	if e != nil {
+		return e
+	}
+	if n, e := r.RowsAffected(); e != nil {
+		return e
+	} else if n == 0 {
+		//the expire may be the same,mysql doesn't count it as affected
+		var tmp string
+		if e := d.sql.QueryRowContext(ctx, "SELECT id FROM sconfig.read_token WHERE id=? AND groupname=? AND appname=?", id, groupname, appname).Scan(&tmp); e != nil {
+			if e == sql.ErrNoRows {
+				e = ErrNotExist
+			}
+			return e
+		}
+	}
+	return nil
+}
+
+func (d *sqlDao) DelReadToken(ctx context.Context, groupname, appname, id string) error {
+	r, e := d.sql.ExecContext(ctx, "DELETE FROM sconfig.read_token WHERE id=? AND groupname=? AND appname=?", id, groupname, appname)
+	if e != nil {
+		return e
+	}
+	if n, e := r.RowsAffected(); e != nil {
+		return e
+	} else if n == 0 {
+		return ErrNotExist
+	}
+	return nil
+}
+
+func (d *sqlDao) Lease(ctx context.Context, name, owner string, ttl uint64) (bool, error) {
+	now := uint64(time.Now().Unix())
+	//the lease is only changed when it's expired or it's already held by the owner
+	//mysql evaluates the assignments from left to right,so the expire checks the new owner
+	if _, e := d.sql.ExecContext(ctx, "INSERT INTO sconfig.lease(name,owner,expire) VALUES(?,?,?) ON DUPLICATE KEY UPDATE owner=IF(owner=VALUES(owner) OR expire<?,VALUES(owner),owner),expire=IF(owner=VALUES(owner),VALUES(expire),expire)", name, owner, now+ttl, now); e != nil {
+		return false, e
+	}
+	var current string
+	if e := d.sql.QueryRowContext(ctx, "SELECT owner FROM sconfig.lease WHERE name=?", name).Scan(&current); e != nil {
+		return false, e
+	}
+	return current == owner, nil
+}
+
+func (d *sqlDao) AddAudit(ctx context.Context, audit *Audit) error {
+	_, e := d.sql.ExecContext(ctx, "INSERT INTO sconfig.audit(groupname,appname,op,caller,ip,from_index,to_index,op_num,ctime,detail) VALUES(?,?,?,?,?,?,?,?,?,?)", audit.Groupname, audit.Appname, audit.Op, audit.Caller, audit.IP, audit.FromIndex, audit.ToIndex, audit.OpNum, audit.Ctime, audit.Detail)
+	return e
+}
+
+func (d *sqlDao) GetAudits(ctx context.Context, groupname, appname string, begin, end, skip, limit uint64) ([]*Audit, uint64, error) {
+	where := " WHERE groupname=?"
+	args := []interface{}{groupname}
+	if appname != "" {
+		where += " AND appname=?"
+		args = append(args, appname)
+	}
+	if begin != 0 {
+		where += " AND ctime>=?"
+		args = append(args, begin)
+	}
+	if end != 0 {
+		where += " AND ctime<=?"
+		args = append(args, end)
+	}
+	var total uint64
+	if e := d.sql.QueryRowContext(ctx, "SELECT COUNT(*) FROM sconfig.audit"+where, args...).Scan(&total); e != nil {
+		return nil, 0, e
+	}
+	rows, e := d.sql.QueryContext(ctx, "SELECT groupname,appname,op,caller,ip,from_index,to_index,op_num,ctime,detail FROM sconfig.audit"+where+" ORDER BY ctime DESC,id DESC LIMIT ?,?", append(args, skip, limit)...)
+	if e != nil {
+		return nil, 0, e
+	}
+	defer rows.Close()
+	result := make([]*Audit, 0, limit)
+	for rows.Next() {
+		audit := &Audit{}
+		if e = rows.Scan(&audit.Groupname, &audit.Appname, &audit.Op, &audit.Caller, &audit.IP, &audit.FromIndex, &audit.ToIndex, &audit.OpNum, &audit.Ctime, &audit.Detail); e != nil {
+			return nil, 0, e
+		}
+		result = append(result, audit)
+	}
+	if e = rows.Err(); e != nil {
+		return nil, 0, e
+	}
+	return result, total, nil
+}
+
+//mysql doesn't have change stream,so the summary's op_num is polled
+func (d *sqlDao) Watch(ctx context.Context, groupname, appname string, update func(*Summary, *Config, *Config)) error {
+	curop := uint64(0)
+	first := true
+	tker := time.NewTicker(time.Second)
+	defer tker.Stop()
+	for {
+		summary, config, e := d.GetInfo(ctx, groupname, appname)
+		if e != nil && e != ErrNotExist {
+			return e
+		} else if e == ErrNotExist {
+			if first || curop != 0 {
+				update(&Summary{}, &Config{}, nil)
+				curop = 0
+			}
+		} else if first || summary.OpNum != curop {
+			var canary *Config
+			if summary.Canary != nil {
+				if canary, e = d.GetConfig(ctx, groupname, appname, summary.Canary.Index); e != nil {
+					return e
+				}
+			}
+			update(summary, config, canary)
+			curop = summary.OpNum
+		}
+		first = false
+		select {
+		case <-tker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+//mysql doesn't have change stream,so the base's op_num is polled
+func (d *sqlDao) WatchBase(ctx context.Context, groupname string, update func(*Base)) error {
+	curop := uint64(0)
+	first := true
+	tker := time.NewTicker(time.Second)
+	defer tker.Stop()
+	for {
+		base, e := d.GetBase(ctx, groupname)
+		if e != nil && e != ErrNotExist {
+			return e
+		} else if e == ErrNotExist {
+			base = &Base{}
+		}
+		if first || base.OpNum != curop {
+			update(base)
+			curop = base.OpNum
+		}
+		first = false
+		select {
+		case <-tker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
@@ -5,10 +5,22 @@ import (
 )
 
 var (
-	ErrUnknown       = cerror.ErrUnknown //10000
-	ErrReq           = cerror.ErrReq     //10001
-	ErrResp          = cerror.ErrResp    //10002
-	ErrSystem        = cerror.ErrSystem  //10003
-	ErrNotExist      = cerror.MakeError(10004, "not exist")
-	ErrCoinfigFormat = cerror.MakeError(10005, "config format error: must be json object")
+	ErrUnknown           = cerror.ErrUnknown //10000
+	ErrReq               = cerror.ErrReq     //10001
+	ErrResp              = cerror.ErrResp    //10002
+	ErrSystem            = cerror.ErrSystem  //10003
+	ErrNotExist          = cerror.MakeError(10004, "not exist")
+	ErrCoinfigFormat     = cerror.MakeError(10005, "config format error: must be json object")
+	ErrVersionConflict   = cerror.MakeError(10006, "version conflict: config changed by others")
+	ErrPatch             = cerror.MakeError(10007, "patch error: format wrong or can't be applied")
+	ErrSchemaFormat      = cerror.MakeError(10008, "schema format error: must be json schema")
+	ErrSchemaCheck       = cerror.MakeError(10009, "config doesn't match the schema")
+	ErrSourceConfigCheck = cerror.MakeError(10010, "source config doesn't match the resource layout")
+	ErrDraft             = cerror.MakeError(10011, "version is a draft: use publish instead of rollback")
+	ErrNotApproved       = cerror.MakeError(10012, "not enough approvals or rejected")
+	ErrReviewer          = cerror.MakeError(10013, "caller is not a reviewer or is the author")
+	ErrDeleted           = cerror.MakeError(10014, "app is in the recycle bin: restore or purge it first")
+	ErrAuth              = cerror.MakeError(10015, "missing or invalid token")
+	ErrPermission        = cerror.MakeError(10016, "permission denied")
+	ErrSecret            = cerror.MakeError(10017, "secret error: no key,can't be decrypted or the masked secret doesn't exist")
 )
@@ -6,6 +6,7 @@ require (
 	github.com/chenjie199234/Corelib v0.0.25
 	github.com/fsnotify/fsnotify v1.4.9
 	github.com/go-sql-driver/mysql v1.6.0
+	github.com/santhosh-tekuri/jsonschema/v5 v5.0.0
 	github.com/segmentio/kafka-go v0.4.17
 	go.mongodb.org/mongo-driver v1.7.1
 	google.golang.org/protobuf v1.27.1
@@ -4,32 +4,36 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"math"
 	"net"
+	"net/http"
 	"os"
+	"strings"
+	"sync"
 	"sync/atomic"
 	"time"
 	"unsafe"
 
 	"github.com/chenjie199234/Config/api"
-	"github.com/chenjie199234/Config/dao/sconfig"
 	"github.com/chenjie199234/Corelib/log"
 	"github.com/chenjie199234/Corelib/rpc"
 	"github.com/chenjie199234/Corelib/util/common"
-	cerror "github.com/chenjie199234/Corelib/util/error"
+	"github.com/chenjie199234/Corelib/util/metadata"
 	"github.com/chenjie199234/Corelib/web"
-	"go.mongodb.org/mongo-driver/mongo"
-	"go.mongodb.org/mongo-driver/mongo/options"
-	"go.mongodb.org/mongo-driver/mongo/readconcern"
-	"go.mongodb.org/mongo-driver/mongo/readpref"
 )
 
 type sdk struct {
 	path string
+
+	sync.Mutex
+	report     func(context.Context, *api.SreportReq) (*api.SreportResp, error)
+	lastreport *api.SreportReq
 }
 
 var instance *sdk
 
-func NewWebSdk(path, selfgroup, selfname string) error {
+//token is the app's read token issued by stoken_issue,it's required when the config server enabled the rbac
+func NewWebSdk(path, selfgroup, selfname, token string) error {
 	if !atomic.CompareAndSwapPointer((*unsafe.Pointer)(unsafe.Pointer(&instance)), nil, unsafe.Pointer(&sdk{
 		path: path,
 	})) {
@@ -66,61 +70,21 @@ func NewWebSdk(path, selfgroup, selfname string) error {
 		log.Error("[Config.websdk] new config client error:", e)
 		return e
 	}
-	ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(time.Second))
-	defer cancel()
-	var resp *api.SwatchaddrResp
-	for {
-		time.Sleep(time.Millisecond * 25)
-		resp, e = client.Swatchaddr(ctx, &api.SwatchaddrReq{}, nil)
-		if e != nil {
-			log.Error("[Config.websdk] call config server for watch addr error:", e)
-			if cerror.Equal(e, context.DeadlineExceeded) || cerror.Equal(e, context.Canceled) {
-				return errors.New("[Config.websdk] get watch addrs failed")
-			}
-			continue
+	header := func() http.Header {
+		if token == "" {
+			return nil
 		}
-		break
-	}
-	if len(resp.Addrs) == 0 {
-		log.Error("[Config.websdk] init watch error: config server doesn't support watch")
-		return errors.New("[Config.websdk] watch addrs emppty")
-	} else if db, e := newmongo(ctx, resp.Username, resp.Passwd, resp.ReplicaSetName, resp.Addrs); e != nil {
-		log.Error("[Config.websdk] init mongodb error:", e)
-		return errors.New("[Config.websdk] init mongodb failed")
-	} else {
-		//run watch logic
-		dao := sconfig.NewDao(nil, nil, db)
-		initch := make(chan error, 1)
-		notice := func(e error) {
-			select {
-			case initch <- e:
-			default:
-			}
-		}
-		go func() {
-			for {
-				if e := dao.MongoWatch(selfgroup, selfname, func(config *sconfig.Config) {
-					if e := instance.updateAppConfig(config.AppConfig); e != nil {
-						log.Error("[Config.websdk] write appconfig file error:", e)
-						notice(e)
-					}
-					if e := instance.updateSourceConfig(config.SourceConfig); e != nil {
-						log.Error("[Config.websdk] write sourceconfig file error:", e)
-						notice(e)
-					}
-					notice(nil)
-				}); e != nil {
-					log.Error("[Config.websdk] watch mongodb error:", e)
-					notice(e)
-					time.Sleep(time.Millisecond * 500)
-				}
-			}
-		}()
-		return <-initch
+		return http.Header{"Authorization": []string{"Bearer " + token}}
 	}
+	return instance.watch("[Config.websdk]", selfgroup, selfname, func(ctx context.Context, req *api.SwatchReq) (*api.SwatchResp, error) {
+		return client.Swatch(ctx, req, header())
+	}, func(ctx context.Context, req *api.SreportReq) (*api.SreportResp, error) {
+		return client.Sreport(ctx, req, header())
+	})
 }
 
-func NewRpcSdk(path, selfgroup, selfname string) error {
+//token is the app's read token issued by stoken_issue,it's required when the config server enabled the rbac
+func NewRpcSdk(path, selfgroup, selfname, token string) error {
 	if !atomic.CompareAndSwapPointer((*unsafe.Pointer)(unsafe.Pointer(&instance)), nil, unsafe.Pointer(&sdk{
 		path: path,
 	})) {
@@ -157,87 +121,139 @@ func NewRpcSdk(path, selfgroup, selfname string) error {
 		log.Error("[Config.rpcsdk] new config client error:", e)
 		return e
 	}
-	ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(time.Second))
-	defer cancel()
-	var resp *api.SwatchaddrResp
-	for {
-		time.Sleep(time.Millisecond * 25)
-		resp, e = client.Swatchaddr(ctx, &api.SwatchaddrReq{})
-		if e != nil {
-			log.Error("[Config.rpcsdk] call config server for watch addr error:", e)
-			if cerror.Equal(e, context.DeadlineExceeded) || cerror.Equal(e, context.Canceled) {
-				return errors.New("[Config.rpcsdk] get watch addr failed")
-			}
-			continue
+	withToken := func(ctx context.Context) context.Context {
+		if token == "" {
+			return ctx
 		}
-		break
+		return metadata.SetAllMetadata(ctx, map[string]string{"Authorization": token})
 	}
-	if len(resp.Addrs) == 0 {
-		log.Error("[Config.rpcsdk] init watch error: config server doesn't support watch")
-		return errors.New("[Config.rpcsdk] config server watch addrs emppty")
-	} else if db, e := newmongo(ctx, resp.Username, resp.Passwd, resp.ReplicaSetName, resp.Addrs); e != nil {
-		log.Error("[Config.rpcsdk] init watch error:", e)
-		return errors.New("[Config.rpcsdk] init watch failed")
-	} else {
-		//run watch logic
-		dao := sconfig.NewDao(nil, nil, db)
-		initch := make(chan error, 1)
-		notice := func(e error) {
-			select {
-			case initch <- e:
-			default:
-			}
+	return instance.watch("[Config.rpcsdk]", selfgroup, selfname, func(ctx context.Context, req *api.SwatchReq) (*api.SwatchResp, error) {
+		return client.Swatch(withToken(ctx), req)
+	}, func(ctx context.Context, req *api.SreportReq) (*api.SreportResp, error) {
+		return client.Sreport(withToken(ctx), req)
+	})
+}
+
+//identify return this instance's hostname and labels,they are used by the config server to decide whether this instance is in the canary
+//labels are from the env SCONFIG_LABELS,split by ','
+func identify() (string, []string) {
+	hostname, _ := os.Hostname()
+	labels := make([]string, 0)
+	for _, label := range strings.Split(os.Getenv("SCONFIG_LABELS"), ",") {
+		if label = strings.TrimSpace(label); label != "" {
+			labels = append(labels, label)
 		}
-		go func() {
-			for {
-				if e := dao.MongoWatch(selfgroup, selfname, func(config *sconfig.Config) {
-					if e := instance.updateAppConfig(config.AppConfig); e != nil {
-						log.Error("[Config.rpcsdk] write appconfig file error:", e)
-						notice(e)
-					}
-					if e := instance.updateAppConfig(config.AppConfig); e != nil {
-						log.Error("[Config.rpcsdk] write sourceconfig file error:", e)
-						notice(e)
-					}
-					notice(nil)
-				}); e != nil {
-					log.Error("[Config.rpcsdk] watch mongodb error:", e)
-					notice(e)
-					time.Sleep(time.Millisecond * 500)
-				}
-			}
-		}()
-		return <-initch
 	}
+	return hostname, labels
 }
 
-func newmongo(ctx context.Context, username, passwd, replicaset string, addrs []string) (*mongo.Client, error) {
-	op := &options.ClientOptions{}
-	if username != "" && passwd != "" {
-		op = op.SetAuth(options.Credential{Username: username, Password: passwd})
+//ReportHealth report the app defined health check's result of the current config to the config server
+//nil means healthy
+//if the config was published with a guard,too many unhealthy instances will make the config server rollback automatically
+func ReportHealth(health error) error {
+	if instance == nil {
+		return errors.New("[Config.sdk] not inited")
 	}
-	if replicaset != "" {
-		op.SetReplicaSet(replicaset)
+	instance.Lock()
+	if instance.lastreport == nil {
+		instance.Unlock()
+		return errors.New("[Config.sdk] config not applied yet")
 	}
-	op = op.SetHosts(addrs)
-	op = op.SetConnectTimeout(time.Second)
-	op = op.SetCompressors([]string{"zstd"}).SetZstdLevel(3)
-	op = op.SetMaxConnIdleTime(time.Minute)
-	op = op.SetMaxPoolSize(5)
-	op = op.SetSocketTimeout(time.Second)
-	op = op.SetHeartbeatInterval(time.Second * 5)
-	//default:secondary is preferred to be selected,if there is no secondary,primary will be selected
-	op = op.SetReadPreference(readpref.SecondaryPreferred())
-	//watch must set readconcern to majority
-	op = op.SetReadConcern(readconcern.Majority())
-	db, e := mongo.Connect(ctx, op)
-	if e != nil {
-		return nil, e
+	last := instance.lastreport
+	report := instance.report
+	instance.Unlock()
+	req := &api.SreportReq{
+		Groupname: last.Groupname,
+		Appname:   last.Appname,
+		Instance:  last.Instance,
+		OpNum:     last.OpNum,
+		Index:     last.Index,
+		Canary:    last.Canary,
+		Error:     last.Error,
+	}
+	if health != nil {
+		req.Health = health.Error()
 	}
-	if e = db.Ping(ctx, nil); e != nil {
-		return nil, e
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	_, e := report(ctx, req)
+	return e
+}
+
+//watch long poll the config server,and write the config files every time the config changed
+//the result of every write will be reported to the config server
+//this will block until the first config is written or failed
+func (s *sdk) watch(logprefix, selfgroup, selfname string,
+	call func(context.Context, *api.SwatchReq) (*api.SwatchResp, error),
+	report func(context.Context, *api.SreportReq) (*api.SreportResp, error)) error {
+	initch := make(chan error, 1)
+	notice := func(e error) {
+		select {
+		case initch <- e:
+		default:
+		}
 	}
-	return db, nil
+	go func() {
+		//make sure the first call return at once
+		curop := uint64(math.MaxUint64)
+		//the group's base config's op_num,the config is also changed when the base changed
+		curbaseop := uint64(0)
+		start := time.Now()
+		hostname, labels := identify()
+		for {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+			resp, e := call(ctx, &api.SwatchReq{Groupname: selfgroup, Appname: selfname, OpNum: curop, BaseOpNum: curbaseop, Instance: hostname, Labels: labels})
+			cancel()
+			if e != nil {
+				log.Error(logprefix, "call config server for watch error:", e)
+				//the config server may not be discovered yet when start
+				if curop != math.MaxUint64 || time.Since(start) > time.Second {
+					notice(e)
+				}
+				time.Sleep(time.Millisecond * 500)
+				continue
+			}
+			if resp.OpNum == curop && resp.BaseOpNum == curbaseop {
+				continue
+			}
+			curop = resp.OpNum
+			curbaseop = resp.BaseOpNum
+			var errs []string
+			if e := s.updateAppConfig(resp.AppConfig); e != nil {
+				log.Error(logprefix, "write appconfig file error:", e)
+				notice(e)
+				errs = append(errs, "write appconfig file error: "+e.Error())
+			}
+			if e := s.updateSourceConfig(resp.SourceConfig); e != nil {
+				log.Error(logprefix, "write sourceconfig file error:", e)
+				notice(e)
+				errs = append(errs, "write sourceconfig file error: "+e.Error())
+			}
+			notice(nil)
+			//op_num 0 means the config doesn't exist,nothing to report
+			if resp.OpNum != 0 {
+				req := &api.SreportReq{
+					Groupname: selfgroup,
+					Appname:   selfname,
+					Instance:  hostname,
+					OpNum:     resp.OpNum,
+					Index:     resp.CurIndex,
+					Canary:    resp.Canary,
+					Error:     strings.Join(errs, ";"),
+				}
+				s.Lock()
+				s.report = report
+				s.lastreport = req
+				s.Unlock()
+				ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+				if _, e := report(ctx, req); e != nil {
+					log.Error(logprefix, "report to config server error:", e)
+				}
+				cancel()
+			}
+		}
+	}()
+	return <-initch
 }
 
 func (s *sdk) updateAppConfig(appconfig string) error {
@@ -0,0 +1,24 @@
+package xrpc
+
+import (
+	"strings"
+
+	"github.com/chenjie199234/Config/service"
+
+	"github.com/chenjie199234/Corelib/rpc"
+)
+
+//auth reject the sconfig's request without a valid token when rbac is enabled
+//the token is passed by the metadata's Authorization
+//the role on the group and app is checked by the service,because the request is not decoded here
+func auth(ctx *rpc.Context) {
+	if !strings.HasPrefix(ctx.GetPath(), "/config.sconfig/") {
+		ctx.Next()
+		return
+	}
+	if e := service.SvcSconfig.Authenticate(ctx); e != nil {
+		ctx.Abort(e)
+		return
+	}
+	ctx.Next()
+}
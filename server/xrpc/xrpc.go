@@ -35,7 +35,7 @@ func StartRpcServer() {
 	}
 
 	//this place can register global midwares
-	//s.Use(globalmidwares)
+	s.Use(auth)
 
 	//you just need to register your service here
 	if e = api.RegisterStatusRpcServer(s, service.SvcStatus, mids.AllMids()); e != nil {
@@ -0,0 +1,31 @@
+package xweb
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/chenjie199234/Config/ecode"
+	"github.com/chenjie199234/Config/service"
+
+	cerror "github.com/chenjie199234/Corelib/util/error"
+	"github.com/chenjie199234/Corelib/web"
+)
+
+//auth reject the sconfig's request without a valid token when rbac is enabled
+//the token is passed by the header: Authorization: Bearer <token>
+//the role on the group and app is checked by the service,because the request is not decoded here
+func auth(ctx *web.Context) {
+	if !strings.HasPrefix(ctx.GetPath(), "/config.sconfig/") {
+		ctx.Next()
+		return
+	}
+	if e := service.SvcSconfig.Authenticate(ctx); e != nil {
+		if cerror.Equal(e, ecode.ErrAuth) {
+			ctx.AbortString(http.StatusUnauthorized, e.Error())
+		} else {
+			ctx.AbortString(http.StatusInternalServerError, e.Error())
+		}
+		return
+	}
+	ctx.Next()
+}
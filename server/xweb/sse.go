@@ -0,0 +1,143 @@
+package xweb
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/chenjie199234/Config/api"
+	"github.com/chenjie199234/Config/ecode"
+	"github.com/chenjie199234/Config/service"
+
+	"github.com/chenjie199234/Corelib/log"
+	cerror "github.com/chenjie199234/Corelib/util/error"
+	"github.com/chenjie199234/Corelib/web"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var _WebPathSconfigSstream = "/config.sconfig/sstream"
+
+//the stream will be closed by the server after this time,client should reconnect with Last-Event-ID
+const sstreamTimeout = time.Minute * 10
+
+//sstream push one specific app's config changes as Server-Sent Events
+//query: groupname,appname,op_num and base_op_num(optional,the op_num and base_op_num the caller already has)
+//instance and labels(split by ',') are optional,they are used by canary
+//the op_num and base_op_num can also be passed by the Last-Event-ID header when reconnect
+//every event's id is the op_num(<op_num>.<base_op_num> when the group has base config) and data is the same json as swatch's resp
+//a comment line will be sent when nothing changed in one watch round to keep the connection alive
+//the error before the stream starts is returned with the http status(401,403,404,400,500),the error after that is sent as an error event
+//example: curl -N 'http://host:8000/config.sconfig/sstream?groupname=xxx&appname=xxx'
+func sstream(ctx *web.Context) {
+	if e := ctx.ParseForm(); e != nil {
+		ctx.AbortString(http.StatusBadRequest, ecode.ErrReq.String())
+		return
+	}
+	req := &api.SwatchReq{
+		Groupname: ctx.GetForm("groupname"),
+		Appname:   ctx.GetForm("appname"),
+		//make sure the first watch return at once
+		OpNum:    math.MaxUint64,
+		Instance: ctx.GetForm("instance"),
+	}
+	if labels := ctx.GetForm("labels"); labels != "" {
+		req.Labels = strings.Split(labels, ",")
+	}
+	if req.Groupname == "" || req.Appname == "" {
+		ctx.AbortString(http.StatusBadRequest, ecode.ErrReq.String())
+		return
+	}
+	opnum, baseopnum := ctx.GetForm("op_num"), ctx.GetForm("base_op_num")
+	if opnum == "" {
+		opnum = ctx.GetHeader("Last-Event-ID")
+		if index := strings.IndexByte(opnum, '.'); index >= 0 {
+			opnum, baseopnum = opnum[:index], opnum[index+1:]
+		}
+	}
+	if opnum != "" {
+		var e error
+		if req.OpNum, e = strconv.ParseUint(opnum, 10, 64); e != nil {
+			ctx.AbortString(http.StatusBadRequest, ecode.ErrReq.String())
+			return
+		}
+	}
+	if baseopnum != "" {
+		var e error
+		if req.BaseOpNum, e = strconv.ParseUint(baseopnum, 10, 64); e != nil {
+			ctx.AbortString(http.StatusBadRequest, ecode.ErrReq.String())
+			return
+		}
+	}
+	w := ctx.GetResponse()
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		log.Error("[xweb.sstream] response writer doesn't support flush")
+		ctx.AbortString(http.StatusInternalServerError, ecode.ErrSystem.String())
+		return
+	}
+	//the first watch is done before the stream starts,so the error can be returned with the http status
+	resp, e := service.SvcSconfig.Swatch(ctx, req)
+	if e != nil {
+		log.Error("[xweb.sstream] group:", req.Groupname, "app:", req.Appname, "watch error:", e)
+		ctx.AbortString(sstreamStatus(e), e.Error())
+		return
+	}
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	for {
+		var data []byte
+		if resp.OpNum == req.OpNum && resp.BaseOpNum == req.BaseOpNum {
+			data = []byte(": ping\n\n")
+		} else {
+			req.OpNum = resp.OpNum
+			req.BaseOpNum = resp.BaseOpNum
+			respd, _ := protojson.MarshalOptions{UseProtoNames: true, UseEnumNumbers: true, EmitUnpopulated: true}.Marshal(resp)
+			data = make([]byte, 0, len(respd)+64)
+			data = append(data, "id: "...)
+			data = strconv.AppendUint(data, resp.OpNum, 10)
+			if resp.BaseOpNum != 0 {
+				data = append(data, '.')
+				data = strconv.AppendUint(data, resp.BaseOpNum, 10)
+			}
+			data = append(data, "\nevent: config\ndata: "...)
+			data = append(data, respd...)
+			data = append(data, "\n\n"...)
+		}
+		if _, e := w.Write(data); e != nil {
+			return
+		}
+		flusher.Flush()
+		if ctx.Err() != nil {
+			return
+		}
+		if resp, e = service.SvcSconfig.Swatch(ctx, req); e != nil {
+			if ctx.Err() == nil {
+				//the status is already sent,the error is sent as an event
+				log.Error("[xweb.sstream] group:", req.Groupname, "app:", req.Appname, "watch error:", e)
+				w.Write([]byte("event: error\ndata: " + e.Error() + "\n\n"))
+				flusher.Flush()
+			}
+			return
+		}
+	}
+}
+
+//sstreamStatus map the first watch's error to the http status
+func sstreamStatus(e error) int {
+	switch {
+	case cerror.Equal(e, ecode.ErrAuth):
+		return http.StatusUnauthorized
+	case cerror.Equal(e, ecode.ErrPermission):
+		return http.StatusForbidden
+	case cerror.Equal(e, ecode.ErrNotExist):
+		return http.StatusNotFound
+	case cerror.Equal(e, ecode.ErrReq):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -42,7 +42,7 @@ func StartWebServer() {
 	}
 
 	//this place can register global midwares
-	//s.Use(globalmidwares)
+	s.Use(auth)
 
 	//you just need to register your service here
 	if e = api.RegisterStatusWebServer(s, service.SvcStatus, mids.AllMids()); e != nil {
@@ -53,6 +53,11 @@ func StartWebServer() {
 		log.Error("[xweb] register handlers error:", e)
 		return
 	}
+	//server-sent events can't be generated from proto,register it manually
+	if e = s.Get(_WebPathSconfigSstream, sstreamTimeout, sstream); e != nil {
+		log.Error("[xweb] register handlers error:", e)
+		return
+	}
 	//example
 	//if e = api.RegisterExampleWebServer(s, service.SvcExample, mids.AllMids()); e != nil {
 	//log.Error("[xweb] register handlers error:", e)
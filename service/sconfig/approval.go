@@ -0,0 +1,129 @@
+package sconfig
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	sconfigdao "github.com/chenjie199234/Config/dao/sconfig"
+	"github.com/chenjie199234/Config/ecode"
+
+	"github.com/chenjie199234/Corelib/log"
+)
+
+//getPolicy return nil when the group's approval is disabled
+func (s *Service) getPolicy(ctx context.Context, groupname string) (*sconfigdao.Policy, error) {
+	policy, e := s.sconfigDao.GetPolicy(ctx, groupname)
+	if e != nil {
+		if e == sconfigdao.ErrNotExist {
+			return nil, nil
+		}
+		log.Error("[sconfig.getPolicy] group:", groupname, "error:", e)
+		return nil, ecode.ErrSystem
+	}
+	if policy.Approvals == 0 {
+		return nil, nil
+	}
+	return policy, nil
+}
+
+func isReviewer(policy *sconfigdao.Policy, name string) bool {
+	for _, reviewer := range policy.Reviewers {
+		if reviewer == name {
+			return true
+		}
+	}
+	return false
+}
+
+//approvers return the reviewers who approved,rejected is true when any reviewer rejected
+//the decisions from the callers who are not in the policy's reviewers now will be ignored
+func approvers(policy *sconfigdao.Policy, approvals []*sconfigdao.Approval) (approved []string, rejected bool) {
+	approved = make([]string, 0, len(approvals))
+	counted := make(map[string]bool, len(approvals))
+	for _, approval := range approvals {
+		if !isReviewer(policy, approval.Reviewer) {
+			continue
+		}
+		if !approval.Approved {
+			rejected = true
+		} else if !counted[approval.Reviewer] {
+			counted[approval.Reviewer] = true
+			approved = append(approved, approval.Reviewer)
+		}
+	}
+	sort.Strings(approved)
+	return
+}
+
+//checkApproval make sure the draft can be published
+func (s *Service) checkApproval(ctx context.Context, groupname, appname string, index uint64) error {
+	policy, e := s.getPolicy(ctx, groupname)
+	if e != nil || policy == nil {
+		return e
+	}
+	approvals, e := s.sconfigDao.GetApprovals(ctx, groupname, appname, index)
+	if e != nil {
+		log.Error("[sconfig.checkApproval] group:", groupname, "app:", appname, "index:", index, "error:", e)
+		return ecode.ErrSystem
+	}
+	approved, rejected := approvers(policy, approvals)
+	if rejected || len(approved) < int(policy.Approvals) {
+		return ecode.ErrNotApproved
+	}
+	return nil
+}
+
+//review record the caller's decision on one draft,return the valid approvals and the required approvals after this op
+func (s *Service) review(ctx context.Context, groupname, appname string, index uint64, comment string, approved bool) (uint32, uint32, error) {
+	policy, e := s.getPolicy(ctx, groupname)
+	if e != nil {
+		return 0, 0, e
+	}
+	if policy == nil {
+		return 0, 0, ecode.ErrReviewer
+	}
+	conf, e := s.sconfigDao.GetConfig(ctx, groupname, appname, index)
+	if e != nil {
+		log.Error("[sconfig.review] get config error:", e)
+		if e == sconfigdao.ErrNotExist {
+			return 0, 0, ecode.ErrNotExist
+		}
+		return 0, 0, ecode.ErrSystem
+	}
+	if !conf.Draft {
+		return 0, 0, ecode.ErrReq
+	}
+	//the reviewer must be authenticated,the peer name can be spoofed when rbac is disabled
+	reviewer := s.getPrincipal(ctx)
+	if reviewer == "" {
+		return 0, 0, ecode.ErrAuth
+	}
+	if !isReviewer(policy, reviewer) || reviewer == conf.Author {
+		return 0, 0, ecode.ErrReviewer
+	}
+	if e = s.sconfigDao.SetApproval(ctx, &sconfigdao.Approval{
+		Groupname: groupname,
+		Appname:   appname,
+		Index:     index,
+		Reviewer:  reviewer,
+		Approved:  approved,
+		Comment:   comment,
+		Ctime:     uint64(time.Now().Unix()),
+	}); e != nil {
+		log.Error("[sconfig.review] error:", e)
+		return 0, 0, ecode.ErrSystem
+	}
+	op := "approve"
+	if !approved {
+		op = "reject"
+	}
+	s.audit(ctx, groupname, appname, op, index, index, 0)
+	approvals, e := s.sconfigDao.GetApprovals(ctx, groupname, appname, index)
+	if e != nil {
+		log.Error("[sconfig.review] get approvals error:", e)
+		return 0, 0, ecode.ErrSystem
+	}
+	result, _ := approvers(policy, approvals)
+	return uint32(len(result)), policy.Approvals, nil
+}
@@ -0,0 +1,267 @@
+package sconfig
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/chenjie199234/Config/api"
+	sconfigdao "github.com/chenjie199234/Config/dao/sconfig"
+	"github.com/chenjie199234/Config/ecode"
+
+	"github.com/chenjie199234/Corelib/log"
+	"github.com/chenjie199234/Corelib/util/common"
+)
+
+//getBase return the group's base config
+//nil means the group doesn't have base config
+func (s *Service) getBase(ctx context.Context, groupname string) (*sconfigdao.Base, error) {
+	base, e := s.sconfigDao.GetBase(ctx, groupname)
+	if e != nil {
+		if e == sconfigdao.ErrNotExist {
+			return nil, nil
+		}
+		log.Error("[sconfig.getBase] group:", groupname, "error:", e)
+		return nil, ecode.ErrSystem
+	}
+	return base, nil
+}
+
+//mergeValue deep merge the app's value into the base's value
+//only objects are merged,the app's other values(include arrays and secrets) replace the base's
+//null in the app's object deletes the base's key,it is kept when the base doesn't have that key
+func mergeValue(base, app interface{}) interface{} {
+	basemap, ok := base.(map[string]interface{})
+	if !ok || isMarker(base) {
+		return app
+	}
+	appmap, ok := app.(map[string]interface{})
+	if !ok || isMarker(app) {
+		return app
+	}
+	result := make(map[string]interface{}, len(basemap)+len(appmap))
+	for k, v := range basemap {
+		result[k] = v
+	}
+	for k, v := range appmap {
+		basev, inherited := basemap[k]
+		if v == nil && inherited {
+			delete(result, k)
+		} else if inherited {
+			result[k] = mergeValue(basev, v)
+		} else {
+			result[k] = v
+		}
+	}
+	return result
+}
+
+//mergeConfig return the doc as it is when the base is empty,so the app's config's format is kept
+func mergeConfig(base, doc string) (string, error) {
+	if base == "" || base == "{}" {
+		return doc, nil
+	}
+	basev, e := decodeJSON(base)
+	if e != nil {
+		return "", e
+	}
+	docv, e := decodeJSON(doc)
+	if e != nil {
+		return "", e
+	}
+	return encodeJSON(mergeValue(basev, docv)), nil
+}
+
+//render merge the app's configs into the group's base configs,base can be nil
+func render(base *sconfigdao.Base, appconfig, sourceconfig string) (string, string, error) {
+	if base == nil {
+		return appconfig, sourceconfig, nil
+	}
+	appconfig, e := mergeConfig(base.AppConfig, appconfig)
+	if e != nil {
+		return "", "", e
+	}
+	sourceconfig, e = mergeConfig(base.SourceConfig, sourceconfig)
+	if e != nil {
+		return "", "", e
+	}
+	return appconfig, sourceconfig, nil
+}
+
+//set one specific group's base config
+func (s *Service) SbaseSet(ctx context.Context, in *api.SbaseSetReq) (*api.SbaseSetResp, error) {
+	if e := s.check(ctx, in.Groupname, "", rolePublisher); e != nil {
+		return nil, e
+	}
+	if in.AppConfig == "" {
+		in.AppConfig = "{}"
+	}
+	if len(in.AppConfig) < 2 || in.AppConfig[0] != '{' || in.AppConfig[len(in.AppConfig)-1] != '}' || !json.Valid(common.Str2byte(in.AppConfig)) {
+		return nil, ecode.ErrCoinfigFormat
+	}
+	if in.SourceConfig == "" {
+		in.SourceConfig = "{}"
+	}
+	if len(in.SourceConfig) < 2 || in.SourceConfig[0] != '{' || in.SourceConfig[len(in.SourceConfig)-1] != '}' || !json.Valid(common.Str2byte(in.SourceConfig)) {
+		return nil, ecode.ErrCoinfigFormat
+	}
+	//the base changes all apps in the group at once,it can't be reviewed,so only the group's admin can change it when approval is required
+	if policy, e := s.getPolicy(ctx, in.Groupname); e != nil {
+		return nil, e
+	} else if policy != nil {
+		if e := s.check(ctx, in.Groupname, "", roleAdmin); e != nil {
+			return nil, e
+		}
+	}
+	current, e := s.getBase(ctx, in.Groupname)
+	if e != nil {
+		return nil, e
+	}
+	//the masked secrets and the redacted values are set back from the current base
+	//the base's secrets are encrypted with empty appname,every app in the group can decrypt them
+	curconfig := &sconfigdao.Config{}
+	if current != nil {
+		curconfig.AppConfig, curconfig.SourceConfig = current.AppConfig, current.SourceConfig
+	}
+	if in.AppConfig, e = s.unredactConfig(in.Groupname, "", in.AppConfig, curconfig.AppConfig); e != nil {
+		return nil, e
+	}
+	if in.SourceConfig, e = s.unredactConfig(in.Groupname, "", in.SourceConfig, curconfig.SourceConfig); e != nil {
+		return nil, e
+	}
+	if in.AppConfig, e = s.sealSecrets(in.Groupname, "", in.AppConfig, curconfig); e != nil {
+		return nil, e
+	}
+	if in.SourceConfig, e = s.sealSecrets(in.Groupname, "", in.SourceConfig, curconfig); e != nil {
+		return nil, e
+	}
+	author, _ := s.getCaller(ctx)
+	base := &sconfigdao.Base{
+		Groupname:    in.Groupname,
+		AppConfig:    in.AppConfig,
+		SourceConfig: in.SourceConfig,
+		Ctime:        uint64(time.Now().Unix()),
+		Author:       author,
+		Comment:      in.Comment,
+	}
+	//every app's live configs must still be valid after merged into the new base
+	apps, e := s.sconfigDao.GetApps(ctx, in.Groupname)
+	if e != nil {
+		log.Error("[sconfig.SbaseSet] get apps error:", e)
+		return nil, ecode.ErrSystem
+	}
+	for _, appname := range apps {
+		if e := s.checkLive(ctx, base, in.Groupname, appname); e != nil {
+			return nil, e
+		}
+	}
+	if e := s.sconfigDao.SetBase(ctx, base, in.ExpectedOpNum); e != nil {
+		log.Error("[sconfig.SbaseSet] error:", e)
+		if e == sconfigdao.ErrOpNumConflict {
+			return nil, ecode.ErrVersionConflict
+		}
+		return nil, ecode.ErrSystem
+	}
+	s.audit(ctx, in.Groupname, "", "base", 0, 0, base.OpNum)
+	return &api.SbaseSetResp{OpNum: base.OpNum}, nil
+}
+
+//checkLive check the app's current and canary configs with the base
+func (s *Service) checkLive(ctx context.Context, base *sconfigdao.Base, groupname, appname string) error {
+	sum, conf, e := s.sconfigDao.GetInfo(ctx, groupname, appname)
+	if e != nil {
+		if e == sconfigdao.ErrNotExist {
+			//only has drafts
+			return nil
+		}
+		log.Error("[sconfig.checkLive] group:", groupname, "app:", appname, "error:", e)
+		return ecode.ErrSystem
+	}
+	schema, e := s.getSchema(ctx, groupname, appname)
+	if e != nil {
+		return e
+	}
+	if e = s.checkConfig(schema, base, groupname, appname, conf.AppConfig, conf.SourceConfig); e != nil {
+		log.Error("[sconfig.checkLive] group:", groupname, "app:", appname, "index:", conf.Index, "check failed with the base:", e)
+		return e
+	}
+	if sum.Canary == nil {
+		return nil
+	}
+	if conf, e = s.sconfigDao.GetConfig(ctx, groupname, appname, sum.Canary.Index); e != nil {
+		log.Error("[sconfig.checkLive] group:", groupname, "app:", appname, "get canary config error:", e)
+		return ecode.ErrSystem
+	}
+	if e = s.checkConfig(schema, base, groupname, appname, conf.AppConfig, conf.SourceConfig); e != nil {
+		log.Error("[sconfig.checkLive] group:", groupname, "app:", appname, "canary index:", conf.Index, "check failed with the base:", e)
+		return e
+	}
+	return nil
+}
+
+//get one specific group's base config
+func (s *Service) SbaseGet(ctx context.Context, in *api.SbaseGetReq) (*api.SbaseGetResp, error) {
+	if e := s.check(ctx, in.Groupname, "", roleViewer); e != nil {
+		return nil, e
+	}
+	reveal, e := s.canReveal(ctx, in.Groupname, "")
+	if e != nil {
+		log.Error("[sconfig.SbaseGet] error:", e)
+		return nil, ecode.ErrSystem
+	}
+	base, e := s.getBase(ctx, in.Groupname)
+	if e != nil {
+		return nil, e
+	}
+	if base == nil {
+		return nil, ecode.ErrNotExist
+	}
+	return &api.SbaseGetResp{
+		AppConfig:    s.showConfig(in.Groupname, "", base.AppConfig, reveal),
+		SourceConfig: s.showConfig(in.Groupname, "", base.SourceConfig, reveal),
+		OpNum:        base.OpNum,
+		Ctime:        base.Ctime,
+		Author:       base.Author,
+		Comment:      base.Comment,
+	}, nil
+}
+
+//get one specific app's effective config
+func (s *Service) Srender(ctx context.Context, in *api.SrenderReq) (*api.SrenderResp, error) {
+	if e := s.checkRead(ctx, in.Groupname, in.Appname); e != nil {
+		return nil, e
+	}
+	reveal, e := s.canReveal(ctx, in.Groupname, in.Appname)
+	if e != nil {
+		log.Error("[sconfig.Srender] error:", e)
+		return nil, ecode.ErrSystem
+	}
+	var conf *sconfigdao.Config
+	if in.Index == 0 {
+		_, conf, e = s.sconfigDao.GetInfo(ctx, in.Groupname, in.Appname)
+	} else {
+		conf, e = s.sconfigDao.GetConfig(ctx, in.Groupname, in.Appname, in.Index)
+	}
+	if e != nil {
+		log.Error("[sconfig.Srender] error:", e)
+		if e == sconfigdao.ErrNotExist {
+			return nil, ecode.ErrNotExist
+		}
+		return nil, ecode.ErrSystem
+	}
+	base, e := s.getBase(ctx, in.Groupname)
+	if e != nil {
+		return nil, e
+	}
+	resp := &api.SrenderResp{Index: conf.Index}
+	if base != nil {
+		resp.BaseOpNum = base.OpNum
+	}
+	if resp.AppConfig, resp.SourceConfig, e = render(base, conf.AppConfig, conf.SourceConfig); e != nil {
+		log.Error("[sconfig.Srender] error:", e)
+		return nil, ecode.ErrSystem
+	}
+	resp.AppConfig = s.showConfig(in.Groupname, in.Appname, resp.AppConfig, reveal)
+	resp.SourceConfig = s.showConfig(in.Groupname, in.Appname, resp.SourceConfig, reveal)
+	return resp, nil
+}
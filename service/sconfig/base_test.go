@@ -0,0 +1,36 @@
+package sconfig
+
+import "testing"
+
+func TestMergeConfig(t *testing.T) {
+	tests := []struct {
+		base   string
+		doc    string
+		result string
+	}{
+		{``, `{"a":1}`, `{"a":1}`},
+		{`{}`, `{"a":1}`, `{"a":1}`},
+		{`{"a":1,"b":2}`, `{"a":3}`, `{"a":3,"b":2}`},
+		{`{"a":{"b":1,"c":2}}`, `{"a":{"c":3}}`, `{"a":{"b":1,"c":3}}`},
+		{`{"a":[1,2]}`, `{"a":[3]}`, `{"a":[3]}`},
+		//null deletes the inherited key
+		{`{"a":1,"b":2}`, `{"a":null}`, `{"b":2}`},
+		{`{"a":{"b":1,"c":2}}`, `{"a":{"b":null}}`, `{"a":{"c":2}}`},
+		//null is kept when the base doesn't have the key
+		{`{"a":1}`, `{"b":null}`, `{"a":1,"b":null}`},
+		//secrets and redacted values are replaced as a whole
+		{`{"a":{"$encrypted":"x"}}`, `{"a":{"$secret":"y"}}`, `{"a":{"$secret":"y"}}`},
+		{`{"a":{"b":1}}`, `{"a":{"$encrypted":"x"}}`, `{"a":{"$encrypted":"x"}}`},
+		{`{"a":{"$encrypted":"x"}}`, `{"a":{"b":1}}`, `{"a":{"b":1}}`},
+	}
+	for _, test := range tests {
+		result, e := mergeConfig(test.base, test.doc)
+		if e != nil {
+			t.Errorf("merge %s into %s error: %v", test.doc, test.base, e)
+			continue
+		}
+		if result != test.result {
+			t.Errorf("merge %s into %s = %s,want %s", test.doc, test.base, result, test.result)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package sconfig
+
+import (
+	"context"
+	"strings"
+
+	"github.com/chenjie199234/Corelib/rpc"
+	"github.com/chenjie199234/Corelib/web"
+)
+
+//getCaller return the caller's name and ip
+//the ctx passed into the service's method is the rpc or web context
+//when rbac is enabled,the name is the principal's name in the token or token:<id> for the app's read token
+//the token is already checked by the midware
+func (s *Service) getCaller(ctx context.Context) (name string, ip string) {
+	switch c := ctx.(type) {
+	case *rpc.Context:
+		name, ip = c.GetPeerName(), c.GetRemoteAddr()
+	case *web.Context:
+		name, ip = c.GetPeerName(), c.GetRemoteAddr()
+	default:
+		return "", ""
+	}
+	if s.root != "" {
+		token := getToken(ctx)
+		if id, _ := parseReadToken(token); id != "" {
+			name = "token:" + id
+		} else {
+			name, _ = parseToken(s.root, token)
+		}
+	}
+	return
+}
+
+//getPrincipal return the authenticated principal's name
+//empty means the caller can't be identified:rbac is disabled(the peer name can be spoofed) or the caller uses the app's read token
+func (s *Service) getPrincipal(ctx context.Context) string {
+	if s.root == "" {
+		return ""
+	}
+	if id, _ := parseReadToken(getToken(ctx)); id != "" {
+		return ""
+	}
+	name, _ := parseToken(s.root, getToken(ctx))
+	return name
+}
+
+//getToken return the token passed by the rpc metadata's Authorization or the web header's Authorization(Bearer <token>)
+func getToken(ctx context.Context) string {
+	switch c := ctx.(type) {
+	case *rpc.Context:
+		return c.GetMetadata()["Authorization"]
+	case *web.Context:
+		return strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer ")
+	}
+	return ""
+}
@@ -0,0 +1,35 @@
+package sconfig
+
+import (
+	"hash/fnv"
+	"strconv"
+
+	sconfigdao "github.com/chenjie199234/Config/dao/sconfig"
+)
+
+//inCanary check whether the instance should get the canary version
+//the percent is picked by the hash of the instance and the canary's index
+//so the same instance always get the same result in one canary,and the picked instances will not change when the percent grows
+func inCanary(canary *sconfigdao.Canary, instance string, labels []string) bool {
+	if canary == nil {
+		return false
+	}
+	for _, hostname := range canary.Hostnames {
+		if hostname == instance && instance != "" {
+			return true
+		}
+	}
+	for _, label := range canary.Labels {
+		for _, v := range labels {
+			if v == label {
+				return true
+			}
+		}
+	}
+	if canary.Percent == 0 || instance == "" {
+		return false
+	}
+	h := fnv.New32a()
+	h.Write([]byte(instance + "." + strconv.FormatUint(canary.Index, 10)))
+	return h.Sum32()%100 < canary.Percent
+}
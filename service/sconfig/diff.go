@@ -0,0 +1,103 @@
+package sconfig
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/chenjie199234/Config/api"
+)
+
+//decodeJSON decode the json with number kept as json.Number,so the number's text will not be changed
+func decodeJSON(data string) (interface{}, error) {
+	var result interface{}
+	decoder := json.NewDecoder(strings.NewReader(data))
+	decoder.UseNumber()
+	if e := decoder.Decode(&result); e != nil {
+		return nil, e
+	}
+	return result, nil
+}
+
+func encodeJSON(v interface{}) string {
+	buf := &bytes.Buffer{}
+	encoder := json.NewEncoder(buf)
+	encoder.SetEscapeHTML(false)
+	encoder.Encode(v)
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+//escape the key for json pointer(rfc 6901)
+func escapePointer(key string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(key, "~", "~0"), "/", "~1")
+}
+
+//jsonDiff compare two json documents,the result's path is json pointer
+func jsonDiff(from, to string) ([]*api.DiffItem, error) {
+	fromv, e := decodeJSON(from)
+	if e != nil {
+		return nil, e
+	}
+	tov, e := decodeJSON(to)
+	if e != nil {
+		return nil, e
+	}
+	result := make([]*api.DiffItem, 0)
+	diffValue("", fromv, tov, &result)
+	return result, nil
+}
+
+func diffValue(path string, from, to interface{}, result *[]*api.DiffItem) {
+	switch fromv := from.(type) {
+	case map[string]interface{}:
+		if tov, ok := to.(map[string]interface{}); ok {
+			keys := make([]string, 0, len(fromv)+len(tov))
+			for k := range fromv {
+				keys = append(keys, k)
+			}
+			for k := range tov {
+				if _, ok := fromv[k]; !ok {
+					keys = append(keys, k)
+				}
+			}
+			sort.Strings(keys)
+			for _, k := range keys {
+				subpath := path + "/" + escapePointer(k)
+				subfrom, fromok := fromv[k]
+				subto, took := tov[k]
+				switch {
+				case !fromok:
+					*result = append(*result, &api.DiffItem{Op: "add", Path: subpath, NewValue: encodeJSON(subto)})
+				case !took:
+					*result = append(*result, &api.DiffItem{Op: "remove", Path: subpath, OldValue: encodeJSON(subfrom)})
+				default:
+					diffValue(subpath, subfrom, subto, result)
+				}
+			}
+			return
+		}
+	case []interface{}:
+		if tov, ok := to.([]interface{}); ok {
+			for i := 0; i < len(fromv) || i < len(tov); i++ {
+				subpath := path + "/" + strconv.Itoa(i)
+				switch {
+				case i >= len(fromv):
+					*result = append(*result, &api.DiffItem{Op: "add", Path: subpath, NewValue: encodeJSON(tov[i])})
+				case i >= len(tov):
+					*result = append(*result, &api.DiffItem{Op: "remove", Path: subpath, OldValue: encodeJSON(fromv[i])})
+				default:
+					diffValue(subpath, fromv[i], tov[i], result)
+				}
+			}
+			return
+		}
+	}
+	//scalar or type changed
+	fromstr := encodeJSON(from)
+	tostr := encodeJSON(to)
+	if fromstr != tostr {
+		*result = append(*result, &api.DiffItem{Op: "change", Path: path, OldValue: fromstr, NewValue: tostr})
+	}
+}
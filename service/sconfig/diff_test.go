@@ -0,0 +1,41 @@
+package sconfig
+
+import "testing"
+
+func TestJSONDiff(t *testing.T) {
+	tests := []struct {
+		from  string
+		to    string
+		items []string //op path old new
+	}{
+		{`{"a":1}`, `{"a":1}`, nil},
+		{`{"a":1}`, `{"a":2}`, []string{"change /a 1 2"}},
+		{`{"a":1}`, `{"a":1,"b":"x"}`, []string{`add /b  "x"`}},
+		{`{"a":1,"b":2}`, `{"b":2}`, []string{"remove /a 1 "}},
+		{`{"a":{"b":[1,2]}}`, `{"a":{"b":[1,3,4]}}`, []string{"change /a/b/1 2 3", "add /a/b/2  4"}},
+		{`{"a":[1,2]}`, `{"a":[1]}`, []string{"remove /a/1 2 "}},
+		{`{"a":{"b":1}}`, `{"a":[1]}`, []string{`change /a {"b":1} [1]`}},
+		{`{"a/b":1,"c~d":1}`, `{"a/b":2,"c~d":2}`, []string{"change /a~1b 1 2", "change /c~0d 1 2"}},
+		{`{"a":1.0}`, `{"a":1}`, []string{"change /a 1.0 1"}},
+		{`{"b":1,"a":1}`, `{"b":2,"a":2}`, []string{"change /a 1 2", "change /b 1 2"}},
+	}
+	for _, test := range tests {
+		items, e := jsonDiff(test.from, test.to)
+		if e != nil {
+			t.Errorf("diff %s %s error: %v", test.from, test.to, e)
+			continue
+		}
+		if len(items) != len(test.items) {
+			t.Errorf("diff %s %s = %v,want %v", test.from, test.to, items, test.items)
+			continue
+		}
+		for i, item := range items {
+			if str := item.Op + " " + item.Path + " " + item.OldValue + " " + item.NewValue; str != test.items[i] {
+				t.Errorf("diff %s %s item %d = %q,want %q", test.from, test.to, i, str, test.items[i])
+			}
+		}
+	}
+	if _, e := jsonDiff(`{"a":`, `{}`); e == nil {
+		t.Error("diff with invalid json should fail")
+	}
+}
@@ -0,0 +1,129 @@
+package sconfig
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/chenjie199234/Config/api"
+	sconfigdao "github.com/chenjie199234/Config/dao/sconfig"
+	"github.com/chenjie199234/Config/ecode"
+
+	"github.com/chenjie199234/Corelib/log"
+)
+
+//validGuard check the guard's params before the op
+func validGuard(guard *api.PublishGuard) error {
+	if guard == nil || guard.FailPercent == 0 {
+		return nil
+	}
+	if guard.FailPercent > 100 || guard.Window == 0 {
+		return ecode.ErrReq
+	}
+	return nil
+}
+
+//setGuard start watching the reports of the new current version
+//the publish is already done,so the failure here will only be logged
+func (s *Service) setGuard(ctx context.Context, groupname, appname string, guard *api.PublishGuard, sum *sconfigdao.Summary) {
+	if guard == nil || guard.FailPercent == 0 || sum.PrevIndex == 0 {
+		return
+	}
+	author, _ := s.getCaller(ctx)
+	if e := s.sconfigDao.SetGuard(ctx, &sconfigdao.Guard{
+		Groupname:   groupname,
+		Appname:     appname,
+		OpNum:       sum.OpNum,
+		Index:       sum.CurIndex,
+		PrevIndex:   sum.PrevIndex,
+		FailPercent: guard.FailPercent,
+		MinReports:  guard.MinReports,
+		Window:      guard.Window,
+		Ctime:       uint64(time.Now().Unix()),
+		Author:      author,
+	}); e != nil {
+		log.Error("[sconfig.setGuard] group:", groupname, "app:", appname, "op_num:", sum.OpNum, "error:", e)
+	}
+}
+
+//guardCheck is called after every report
+func (s *Service) guardCheck(ctx context.Context, groupname, appname string) {
+	guard, e := s.sconfigDao.GetGuard(ctx, groupname, appname)
+	if e != nil {
+		if e != sconfigdao.ErrNotExist {
+			log.Error("[sconfig.guardCheck] group:", groupname, "app:", appname, "get guard error:", e)
+		}
+		return
+	}
+	s.evalGuard(ctx, guard)
+}
+
+//checkGuards evaluate all open guards,so the guard works when the instances stop reporting(e.g. they crash)
+//it is called by the scheduler,which only runs on the replica holding the lease
+func (s *Service) checkGuards() {
+	ctx, cancel := context.WithTimeout(s.ctx, time.Second*5)
+	defer cancel()
+	guards, e := s.sconfigDao.GetGuards(ctx)
+	if e != nil {
+		if s.ctx.Err() == nil {
+			log.Error("[sconfig.checkGuards] get guards error:", e)
+		}
+		return
+	}
+	for _, guard := range guards {
+		s.evalGuard(ctx, guard)
+	}
+}
+
+//evalGuard rollback when too many instances failed on the guarded version
+//the rollback is protected by the op_num,so only one replica can do it
+func (s *Service) evalGuard(ctx context.Context, guard *sconfigdao.Guard) {
+	groupname, appname := guard.Groupname, guard.Appname
+	if uint64(time.Now().Unix()) > guard.Ctime+guard.Window {
+		//the version is stable
+		s.delGuard(ctx, guard)
+		return
+	}
+	reports, e := s.sconfigDao.GetReports(ctx, groupname, appname)
+	if e != nil {
+		log.Error("[sconfig.evalGuard] group:", groupname, "app:", appname, "get reports error:", e)
+		return
+	}
+	var total, failed uint32
+	for _, report := range reports {
+		if report.OpNum != guard.OpNum || report.Index != guard.Index || report.Canary {
+			continue
+		}
+		total++
+		if report.Error != "" || report.Health != "" {
+			failed++
+		}
+	}
+	min := guard.MinReports
+	if min == 0 {
+		min = 1
+	}
+	if total < min || uint64(failed)*100 <= uint64(guard.FailPercent)*uint64(total) {
+		return
+	}
+	sum, e := s.sconfigDao.RollbackConfig(ctx, groupname, appname, guard.PrevIndex, guard.OpNum)
+	if e != nil {
+		if e == sconfigdao.ErrOpNumConflict {
+			//changed by others or rollbacked by other replica
+			s.delGuard(ctx, guard)
+			return
+		}
+		log.Error("[sconfig.evalGuard] group:", groupname, "app:", appname, "rollback to:", guard.PrevIndex, "error:", e)
+		return
+	}
+	s.delGuard(ctx, guard)
+	detail := strconv.FormatUint(uint64(failed), 10) + "/" + strconv.FormatUint(uint64(total), 10) + " instances failed on op_num " + strconv.FormatUint(guard.OpNum, 10) + ",fail_percent is " + strconv.FormatUint(uint64(guard.FailPercent), 10)
+	log.Info("[sconfig.evalGuard] group:", groupname, "app:", appname, "auto rollback from:", guard.Index, "to:", guard.PrevIndex, "reason:", detail)
+	s.auditAs(ctx, "guard", "", groupname, appname, "auto_rollback", sum.PrevIndex, sum.CurIndex, sum.OpNum, detail)
+}
+
+func (s *Service) delGuard(ctx context.Context, guard *sconfigdao.Guard) {
+	if e := s.sconfigDao.DelGuard(ctx, guard.Groupname, guard.Appname, guard.OpNum); e != nil {
+		log.Error("[sconfig.delGuard] group:", guard.Groupname, "app:", guard.Appname, "op_num:", guard.OpNum, "error:", e)
+	}
+}
@@ -0,0 +1,255 @@
+package sconfig
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+//mergePatch apply the json merge patch(rfc 7386)
+func mergePatch(doc, patch interface{}) interface{} {
+	patchm, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+	docm, ok := doc.(map[string]interface{})
+	if !ok {
+		docm = make(map[string]interface{})
+	}
+	for k, v := range patchm {
+		if v == nil {
+			delete(docm, k)
+		} else {
+			docm[k] = mergePatch(docm[k], v)
+		}
+	}
+	return docm
+}
+
+type jsonPatchOp struct {
+	Op    string           `json:"op"`
+	Path  string           `json:"path"`
+	From  string           `json:"from"`
+	Value *json.RawMessage `json:"value"`
+}
+
+//jsonPatch apply the json patch(rfc 6902)
+func jsonPatch(doc interface{}, patch string) (interface{}, error) {
+	ops := make([]*jsonPatchOp, 0)
+	if e := json.Unmarshal([]byte(patch), &ops); e != nil {
+		return nil, e
+	}
+	for i, op := range ops {
+		path, e := parsePointer(op.Path)
+		if e != nil {
+			return nil, e
+		}
+		var value interface{}
+		switch op.Op {
+		case "add", "replace", "test":
+			if op.Value == nil {
+				return nil, errors.New("op " + strconv.Itoa(i) + " missing value")
+			}
+			if value, e = decodeJSON(string(*op.Value)); e != nil {
+				return nil, e
+			}
+		case "move", "copy":
+			from, e := parsePointer(op.From)
+			if e != nil {
+				return nil, e
+			}
+			if value, e = pointerGet(doc, from); e != nil {
+				return nil, e
+			}
+			if op.Op == "move" {
+				if strings.HasPrefix(op.Path+"/", op.From+"/") && op.Path != op.From {
+					return nil, errors.New("op " + strconv.Itoa(i) + " can't move a value into its child")
+				}
+				if doc, e = pointerRemove(doc, from); e != nil {
+					return nil, e
+				}
+			} else {
+				//copy must not share the same reference
+				if value, e = decodeJSON(encodeJSON(value)); e != nil {
+					return nil, e
+				}
+			}
+		case "remove":
+		default:
+			return nil, errors.New("op " + strconv.Itoa(i) + " unknown op: " + op.Op)
+		}
+		switch op.Op {
+		case "add", "move", "copy":
+			doc, e = pointerAdd(doc, path, value)
+		case "remove":
+			doc, e = pointerRemove(doc, path)
+		case "replace":
+			if len(path) == 0 {
+				doc = value
+			} else if doc, e = pointerRemove(doc, path); e == nil {
+				doc, e = pointerAdd(doc, path, value)
+			}
+		case "test":
+			var current interface{}
+			if current, e = pointerGet(doc, path); e == nil && encodeJSON(current) != encodeJSON(value) {
+				e = errors.New("op " + strconv.Itoa(i) + " test failed on path: " + op.Path)
+			}
+		}
+		if e != nil {
+			return nil, e
+		}
+	}
+	return doc, nil
+}
+
+//parsePointer parse the json pointer(rfc 6901)
+func parsePointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+	if pointer[0] != '/' {
+		return nil, errors.New("json pointer: " + pointer + " must start with '/'")
+	}
+	tokens := strings.Split(pointer[1:], "/")
+	for i := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(tokens[i], "~1", "/"), "~0", "~")
+	}
+	return tokens, nil
+}
+
+func arrayIndex(token string, length int, allowend bool) (int, error) {
+	if allowend && token == "-" {
+		return length, nil
+	}
+	index, e := strconv.Atoi(token)
+	if e != nil || index < 0 || (token != "0" && token[0] == '0') {
+		return 0, errors.New("array index: " + token + " format error")
+	}
+	if index > length || (!allowend && index == length) {
+		return 0, errors.New("array index: " + token + " out of range")
+	}
+	return index, nil
+}
+
+func pointerGet(doc interface{}, tokens []string) (interface{}, error) {
+	for _, token := range tokens {
+		switch node := doc.(type) {
+		case map[string]interface{}:
+			v, ok := node[token]
+			if !ok {
+				return nil, errors.New("key: " + token + " not exist")
+			}
+			doc = v
+		case []interface{}:
+			index, e := arrayIndex(token, len(node), false)
+			if e != nil {
+				return nil, e
+			}
+			doc = node[index]
+		default:
+			return nil, errors.New("key: " + token + " not exist")
+		}
+	}
+	return doc, nil
+}
+
+//pointerModify find the parent of the last token,and replace the parent with f's result
+func pointerModify(doc interface{}, tokens []string, f func(parent interface{}, token string) (interface{}, error)) (interface{}, error) {
+	if len(tokens) == 1 {
+		return f(doc, tokens[0])
+	}
+	switch node := doc.(type) {
+	case map[string]interface{}:
+		child, ok := node[tokens[0]]
+		if !ok {
+			return nil, errors.New("key: " + tokens[0] + " not exist")
+		}
+		child, e := pointerModify(child, tokens[1:], f)
+		if e != nil {
+			return nil, e
+		}
+		node[tokens[0]] = child
+		return node, nil
+	case []interface{}:
+		index, e := arrayIndex(tokens[0], len(node), false)
+		if e != nil {
+			return nil, e
+		}
+		if node[index], e = pointerModify(node[index], tokens[1:], f); e != nil {
+			return nil, e
+		}
+		return node, nil
+	}
+	return nil, errors.New("key: " + tokens[0] + " not exist")
+}
+
+func pointerAdd(doc interface{}, tokens []string, value interface{}) (interface{}, error) {
+	if len(tokens) == 0 {
+		return value, nil
+	}
+	return pointerModify(doc, tokens, func(parent interface{}, token string) (interface{}, error) {
+		switch node := parent.(type) {
+		case map[string]interface{}:
+			node[token] = value
+			return node, nil
+		case []interface{}:
+			index, e := arrayIndex(token, len(node), true)
+			if e != nil {
+				return nil, e
+			}
+			node = append(node, nil)
+			copy(node[index+1:], node[index:])
+			node[index] = value
+			return node, nil
+		}
+		return nil, errors.New("key: " + token + "'s parent is not an object or array")
+	})
+}
+
+func pointerRemove(doc interface{}, tokens []string) (interface{}, error) {
+	if len(tokens) == 0 {
+		return nil, errors.New("can't remove the whole document")
+	}
+	return pointerModify(doc, tokens, func(parent interface{}, token string) (interface{}, error) {
+		switch node := parent.(type) {
+		case map[string]interface{}:
+			if _, ok := node[token]; !ok {
+				return nil, errors.New("key: " + token + " not exist")
+			}
+			delete(node, token)
+			return node, nil
+		case []interface{}:
+			index, e := arrayIndex(token, len(node), false)
+			if e != nil {
+				return nil, e
+			}
+			return append(node[:index], node[index+1:]...), nil
+		}
+		return nil, errors.New("key: " + token + " not exist")
+	})
+}
+
+//applyPatch apply the patch on the json document
+//patchtype: merge(rfc 7386) or json(rfc 6902)
+func applyPatch(doc, patchtype, patch string) (string, error) {
+	docv, e := decodeJSON(doc)
+	if e != nil {
+		return "", e
+	}
+	switch patchtype {
+	case "merge":
+		patchv, e := decodeJSON(patch)
+		if e != nil {
+			return "", e
+		}
+		docv = mergePatch(docv, patchv)
+	case "json":
+		if docv, e = jsonPatch(docv, patch); e != nil {
+			return "", e
+		}
+	default:
+		return "", errors.New("unknown patch type: " + patchtype)
+	}
+	return encodeJSON(docv), nil
+}
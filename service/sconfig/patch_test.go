@@ -0,0 +1,67 @@
+package sconfig
+
+import "testing"
+
+func TestApplyMergePatch(t *testing.T) {
+	tests := []struct {
+		doc    string
+		patch  string
+		result string
+	}{
+		{`{"a":1,"b":2}`, `{"a":3}`, `{"a":3,"b":2}`},
+		{`{"a":1,"b":2}`, `{"a":null}`, `{"b":2}`},
+		{`{"a":{"b":1,"c":2}}`, `{"a":{"c":null,"d":3}}`, `{"a":{"b":1,"d":3}}`},
+		{`{"a":[1,2]}`, `{"a":[3]}`, `{"a":[3]}`},
+		{`{"a":1}`, `{"b":{"c":null}}`, `{"a":1,"b":{}}`},
+		{`{"a":1.50}`, `{}`, `{"a":1.50}`},
+	}
+	for _, test := range tests {
+		result, e := applyPatch(test.doc, "merge", test.patch)
+		if e != nil {
+			t.Errorf("merge patch %s on %s error: %v", test.patch, test.doc, e)
+			continue
+		}
+		if result != test.result {
+			t.Errorf("merge patch %s on %s = %s,want %s", test.patch, test.doc, result, test.result)
+		}
+	}
+}
+
+func TestApplyJSONPatch(t *testing.T) {
+	tests := []struct {
+		doc    string
+		patch  string
+		result string //empty means error
+	}{
+		{`{"a":1}`, `[{"op":"add","path":"/b","value":2}]`, `{"a":1,"b":2}`},
+		{`{"a":[1,2]}`, `[{"op":"add","path":"/a/1","value":3}]`, `{"a":[1,3,2]}`},
+		{`{"a":[1,2]}`, `[{"op":"add","path":"/a/-","value":3}]`, `{"a":[1,2,3]}`},
+		{`{"a":1,"b":2}`, `[{"op":"remove","path":"/a"}]`, `{"b":2}`},
+		{`{"a":1}`, `[{"op":"replace","path":"/a","value":{"c":1}}]`, `{"a":{"c":1}}`},
+		{`{"a":1}`, `[{"op":"move","from":"/a","path":"/b"}]`, `{"b":1}`},
+		{`{"a":{"b":1}}`, `[{"op":"copy","from":"/a","path":"/c"}]`, `{"a":{"b":1},"c":{"b":1}}`},
+		{`{"a/b":1,"c~d":2}`, `[{"op":"remove","path":"/a~1b"},{"op":"remove","path":"/c~0d"}]`, `{}`},
+		{`{"a":1}`, `[{"op":"test","path":"/a","value":1},{"op":"add","path":"/b","value":2}]`, `{"a":1,"b":2}`},
+		{`{"a":1}`, `[{"op":"test","path":"/a","value":2}]`, ``},
+		{`{"a":1}`, `[{"op":"remove","path":"/b"}]`, ``},
+		{`{"a":[1]}`, `[{"op":"add","path":"/a/5","value":1}]`, ``},
+		{`{"a":1}`, `[{"op":"unknown","path":"/a"}]`, ``},
+		{`{"a":1}`, `[{"op":"add","path":"/b","value":2},{"op":"remove","path":"/c"}]`, ``},
+	}
+	for _, test := range tests {
+		result, e := applyPatch(test.doc, "json", test.patch)
+		if test.result == "" {
+			if e == nil {
+				t.Errorf("json patch %s on %s = %s,want error", test.patch, test.doc, result)
+			}
+			continue
+		}
+		if e != nil {
+			t.Errorf("json patch %s on %s error: %v", test.patch, test.doc, e)
+			continue
+		}
+		if result != test.result {
+			t.Errorf("json patch %s on %s = %s,want %s", test.patch, test.doc, result, test.result)
+		}
+	}
+}
@@ -0,0 +1,348 @@
+package sconfig
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/chenjie199234/Config/api"
+	sconfigdao "github.com/chenjie199234/Config/dao/sconfig"
+	"github.com/chenjie199234/Config/ecode"
+
+	"github.com/chenjie199234/Corelib/log"
+)
+
+//every role contains all permissions of the lower roles
+const (
+	roleViewer    = iota + 1 //read,watch and report
+	roleEditor               //drafts,tags and schemas
+	rolePublisher            //make the version current:set,patch,publish,rollback,canary and schedule
+	roleAdmin                //policy,retention,recycle bin and grants
+)
+
+var roles = map[string]int{
+	"viewer":    roleViewer,
+	"editor":    roleEditor,
+	"publisher": rolePublisher,
+	"admin":     roleAdmin,
+}
+
+//rootName is the principal's name of the ROOT_TOKEN,it's the global admin and can't be changed by the api
+const rootName = "root"
+
+//parseToken return the principal's name and the secret in the principal's token
+//the token's format is <principal>.<secret>,the root token is returned as it is
+//empty name means the token is not a principal's token
+func parseToken(root, token string) (name, secret string) {
+	if token == "" {
+		return "", ""
+	}
+	if subtle.ConstantTimeCompare([]byte(token), []byte(root)) == 1 {
+		return rootName, ""
+	}
+	index := strings.IndexByte(token, '.')
+	if index <= 0 || strings.IndexByte(token[index+1:], '.') >= 0 {
+		return "", ""
+	}
+	return token[:index], token[index+1:]
+}
+
+//parseReadToken return the id and the secret in the app's read token
+//the token's format is app.<id>.<secret>,principal's name can't contain '.',so they will not be confused
+//empty id means the token is not a read token
+func parseReadToken(token string) (id, secret string) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 || parts[0] != "app" || parts[1] == "" {
+		return "", ""
+	}
+	return parts[1], parts[2]
+}
+
+func hashSecret(secret string) string {
+	hash := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(hash[:])
+}
+
+func newSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, e := rand.Read(buf); e != nil {
+		return "", e
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+//Authenticate is used by the rpc and web midwares,it checks the token in the ctx
+//the scope's permission is checked in every method,because only the method knows the groupname and appname
+func (s *Service) Authenticate(ctx context.Context) error {
+	if s.root == "" {
+		return nil
+	}
+	token := getToken(ctx)
+	if id, secret := parseReadToken(token); id != "" {
+		_, e := s.authenticateReadToken(ctx, id, secret)
+		return e
+	}
+	name, secret := parseToken(s.root, token)
+	if name == rootName {
+		return nil
+	}
+	if name == "" || secret == "" {
+		return ecode.ErrAuth
+	}
+	principal, e := s.sconfigDao.GetPrincipal(ctx, name)
+	if e != nil {
+		if e == sconfigdao.ErrNotExist {
+			return ecode.ErrAuth
+		}
+		log.Error("[sconfig.Authenticate] error:", e)
+		return ecode.ErrSystem
+	}
+	if subtle.ConstantTimeCompare([]byte(hashSecret(secret)), []byte(principal.Token)) != 1 {
+		return ecode.ErrAuth
+	}
+	return nil
+}
+
+//authenticateReadToken return the read token when the secret matches and it is not expired
+func (s *Service) authenticateReadToken(ctx context.Context, id, secret string) (*sconfigdao.ReadToken, error) {
+	token, e := s.sconfigDao.GetReadToken(ctx, id)
+	if e != nil {
+		if e == sconfigdao.ErrNotExist {
+			return nil, ecode.ErrAuth
+		}
+		log.Error("[sconfig.Authenticate] error:", e)
+		return nil, ecode.ErrSystem
+	}
+	if subtle.ConstantTimeCompare([]byte(hashSecret(secret)), []byte(token.Token)) != 1 {
+		return nil, ecode.ErrAuth
+	}
+	if token.Expire != 0 && token.Expire <= uint64(time.Now().Unix()) {
+		return nil, ecode.ErrAuth
+	}
+	return token, nil
+}
+
+//roleOn return the highest role in the grants on the scope
+//empty appname means the whole group,only the group's and the global grants count
+//empty groupname means all groups,only the global grants count
+func roleOn(grants []*sconfigdao.Grant, groupname, appname string) int {
+	var result int
+	for _, grant := range grants {
+		if grant.Groupname != "" && (grant.Groupname != groupname || (grant.Appname != "" && grant.Appname != appname)) {
+			continue
+		}
+		if roles[grant.Role] > result {
+			result = roles[grant.Role]
+		}
+	}
+	return result
+}
+
+//getGrants return the caller's grants
+//nil means the caller can do everything:rbac is disabled or the caller is the root
+func (s *Service) getGrants(ctx context.Context) ([]*sconfigdao.Grant, error) {
+	if s.root == "" {
+		return nil, nil
+	}
+	if id, _ := parseReadToken(getToken(ctx)); id != "" {
+		//the read token doesn't have any role,it can only be used in checkRead
+		return []*sconfigdao.Grant{}, nil
+	}
+	name, _ := s.getCaller(ctx)
+	if name == rootName {
+		return nil, nil
+	}
+	grants, e := s.sconfigDao.GetGrants(ctx, name, "")
+	if e != nil {
+		return nil, e
+	}
+	return grants, nil
+}
+
+//check return ErrPermission when the caller doesn't have the role on the scope
+func (s *Service) check(ctx context.Context, groupname, appname string, role int) error {
+	grants, e := s.getGrants(ctx)
+	if e != nil {
+		log.Error("[sconfig.check] error:", e)
+		return ecode.ErrSystem
+	}
+	if grants != nil && roleOn(grants, groupname, appname) < role {
+		return ecode.ErrPermission
+	}
+	return nil
+}
+
+//checkRead is used by the methods which read the app's config or are called by the app's sdk
+//the app's read token can be used on them,otherwise the caller needs the viewer role
+//the presented read token is checked here even if rbac is disabled,it can only read it's own app
+func (s *Service) checkRead(ctx context.Context, groupname, appname string) error {
+	id, secret := parseReadToken(getToken(ctx))
+	if id == "" {
+		return s.check(ctx, groupname, appname, roleViewer)
+	}
+	token, e := s.authenticateReadToken(ctx, id, secret)
+	if e != nil {
+		return e
+	}
+	if token.Groupname != groupname || token.Appname != appname {
+		return ecode.ErrPermission
+	}
+	return nil
+}
+
+//isOwner return true when the caller is the app's sdk:it has a valid read token of this app
+//the token is checked here even if rbac is disabled,because the midware doesn't check it in that case
+func (s *Service) isOwner(ctx context.Context, groupname, appname string) (bool, error) {
+	id, secret := parseReadToken(getToken(ctx))
+	if id == "" {
+		return false, nil
+	}
+	token, e := s.sconfigDao.GetReadToken(ctx, id)
+	if e != nil {
+		if e == sconfigdao.ErrNotExist {
+			return false, nil
+		}
+		log.Error("[sconfig.isOwner] error:", e)
+		return false, ecode.ErrSystem
+	}
+	if subtle.ConstantTimeCompare([]byte(hashSecret(secret)), []byte(token.Token)) != 1 {
+		return false, nil
+	}
+	if token.Expire != 0 && token.Expire <= uint64(time.Now().Unix()) {
+		return false, nil
+	}
+	return token.Groupname == groupname && token.Appname == appname, nil
+}
+
+//create a principal or reset it's token
+func (s *Service) SprincipalSet(ctx context.Context, in *api.SprincipalSetReq) (*api.SprincipalSetResp, error) {
+	if len(in.Name) > 64 || strings.Contains(in.Name, ".") || in.Name == rootName {
+		return nil, ecode.ErrReq
+	}
+	if e := s.check(ctx, "", "", roleAdmin); e != nil {
+		return nil, e
+	}
+	secret, e := newSecret()
+	if e != nil {
+		log.Error("[sconfig.SprincipalSet] generate token error:", e)
+		return nil, ecode.ErrSystem
+	}
+	author, _ := s.getCaller(ctx)
+	if e := s.sconfigDao.SetPrincipal(ctx, &sconfigdao.Principal{
+		Name:   in.Name,
+		Token:  hashSecret(secret),
+		Ctime:  uint64(time.Now().Unix()),
+		Author: author,
+	}); e != nil {
+		log.Error("[sconfig.SprincipalSet] error:", e)
+		return nil, ecode.ErrSystem
+	}
+	return &api.SprincipalSetResp{Token: in.Name + "." + secret}, nil
+}
+
+//delete a principal and all it's grants
+func (s *Service) SprincipalDel(ctx context.Context, in *api.SprincipalDelReq) (*api.SprincipalDelResp, error) {
+	if e := s.check(ctx, "", "", roleAdmin); e != nil {
+		return nil, e
+	}
+	if e := s.sconfigDao.DelPrincipal(ctx, in.Name); e != nil {
+		log.Error("[sconfig.SprincipalDel] error:", e)
+		if e == sconfigdao.ErrNotExist {
+			return nil, ecode.ErrNotExist
+		}
+		return nil, ecode.ErrSystem
+	}
+	return &api.SprincipalDelResp{}, nil
+}
+
+//get all principals
+func (s *Service) Sprincipals(ctx context.Context, in *api.SprincipalsReq) (*api.SprincipalsResp, error) {
+	if e := s.check(ctx, "", "", roleAdmin); e != nil {
+		return nil, e
+	}
+	principals, e := s.sconfigDao.GetPrincipals(ctx)
+	if e != nil {
+		log.Error("[sconfig.Sprincipals] error:", e)
+		return nil, ecode.ErrSystem
+	}
+	resp := &api.SprincipalsResp{Principals: make([]*api.PrincipalInfo, 0, len(principals))}
+	for _, principal := range principals {
+		resp.Principals = append(resp.Principals, &api.PrincipalInfo{Name: principal.Name, Ctime: principal.Ctime, Author: principal.Author})
+	}
+	return resp, nil
+}
+
+//give a principal one role on the scope or revoke it
+func (s *Service) Sgrant(ctx context.Context, in *api.SgrantReq) (*api.SgrantResp, error) {
+	if _, ok := roles[in.Role]; (!ok && in.Role != "") || (in.Groupname == "" && in.Appname != "") {
+		return nil, ecode.ErrReq
+	}
+	if e := s.check(ctx, in.Groupname, "", roleAdmin); e != nil {
+		return nil, e
+	}
+	caller, ip := s.getCaller(ctx)
+	if in.Role == "" {
+		if e := s.sconfigDao.DelGrant(ctx, in.Principal, in.Groupname, in.Appname); e != nil {
+			log.Error("[sconfig.Sgrant] error:", e)
+			if e == sconfigdao.ErrNotExist {
+				return nil, ecode.ErrNotExist
+			}
+			return nil, ecode.ErrSystem
+		}
+	} else {
+		if _, e := s.sconfigDao.GetPrincipal(ctx, in.Principal); e != nil {
+			log.Error("[sconfig.Sgrant] error:", e)
+			if e == sconfigdao.ErrNotExist {
+				return nil, ecode.ErrNotExist
+			}
+			return nil, ecode.ErrSystem
+		}
+		if e := s.sconfigDao.SetGrant(ctx, &sconfigdao.Grant{
+			Principal: in.Principal,
+			Groupname: in.Groupname,
+			Appname:   in.Appname,
+			Role:      in.Role,
+			Reveal:    in.Reveal,
+			Ctime:     uint64(time.Now().Unix()),
+			Author:    caller,
+		}); e != nil {
+			log.Error("[sconfig.Sgrant] error:", e)
+			return nil, ecode.ErrSystem
+		}
+	}
+	detail := in.Principal + ":" + in.Role
+	if in.Role != "" && in.Reveal {
+		detail += "+reveal"
+	}
+	s.auditAs(ctx, caller, ip, in.Groupname, in.Appname, "grant", 0, 0, 0, detail)
+	return &api.SgrantResp{}, nil
+}
+
+//get the grants of one principal or one group
+func (s *Service) Sgrants(ctx context.Context, in *api.SgrantsReq) (*api.SgrantsResp, error) {
+	if e := s.check(ctx, in.Groupname, "", roleAdmin); e != nil {
+		return nil, e
+	}
+	grants, e := s.sconfigDao.GetGrants(ctx, in.Principal, in.Groupname)
+	if e != nil {
+		log.Error("[sconfig.Sgrants] error:", e)
+		return nil, ecode.ErrSystem
+	}
+	resp := &api.SgrantsResp{Grants: make([]*api.GrantInfo, 0, len(grants))}
+	for _, grant := range grants {
+		resp.Grants = append(resp.Grants, &api.GrantInfo{
+			Principal: grant.Principal,
+			Groupname: grant.Groupname,
+			Appname:   grant.Appname,
+			Role:      grant.Role,
+			Reveal:    grant.Reveal,
+			Ctime:     grant.Ctime,
+			Author:    grant.Author,
+		})
+	}
+	return resp, nil
+}
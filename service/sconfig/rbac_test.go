@@ -0,0 +1,80 @@
+package sconfig
+
+import (
+	"testing"
+
+	sconfigdao "github.com/chenjie199234/Config/dao/sconfig"
+)
+
+func TestParseToken(t *testing.T) {
+	tests := []struct {
+		token  string
+		name   string
+		secret string
+	}{
+		{"", "", ""},
+		{"rootsecret", rootName, ""},
+		{"alice.abc", "alice", "abc"},
+		{"alice.", "alice", ""},
+		{".abc", "", ""},
+		{"alice", "", ""},
+		{"alice.abc.def", "", ""},
+		{"app.1.abc", "", ""},
+	}
+	for _, test := range tests {
+		name, secret := parseToken("rootsecret", test.token)
+		if name != test.name || secret != test.secret {
+			t.Errorf("parseToken(%q) = %q,%q,want %q,%q", test.token, name, secret, test.name, test.secret)
+		}
+	}
+}
+
+func TestParseReadToken(t *testing.T) {
+	tests := []struct {
+		token  string
+		id     string
+		secret string
+	}{
+		{"app.1.abc", "1", "abc"},
+		{"app..abc", "", ""},
+		{"alice.abc", "", ""},
+		{"token.1.abc", "", ""},
+		{"app.1.abc.def", "", ""},
+	}
+	for _, test := range tests {
+		id, secret := parseReadToken(test.token)
+		if id != test.id || secret != test.secret {
+			t.Errorf("parseReadToken(%q) = %q,%q,want %q,%q", test.token, id, secret, test.id, test.secret)
+		}
+	}
+}
+
+func TestRoleOn(t *testing.T) {
+	grants := []*sconfigdao.Grant{
+		{Groupname: "g1", Role: "viewer"},
+		{Groupname: "g1", Appname: "a1", Role: "publisher"},
+		{Groupname: "g2", Appname: "a2", Role: "editor"},
+	}
+	tests := []struct {
+		grants    []*sconfigdao.Grant
+		groupname string
+		appname   string
+		role      int
+	}{
+		{grants, "g1", "a1", rolePublisher},
+		{grants, "g1", "a2", roleViewer},
+		{grants, "g1", "", roleViewer},
+		{grants, "g2", "a2", roleEditor},
+		{grants, "g2", "", 0},
+		{grants, "g3", "a1", 0},
+		{grants, "", "", 0},
+		{[]*sconfigdao.Grant{{Role: "admin"}}, "g3", "a3", roleAdmin},
+		{[]*sconfigdao.Grant{{Role: "admin"}}, "", "", roleAdmin},
+		{[]*sconfigdao.Grant{{Groupname: "g1", Role: "unknown"}}, "g1", "", 0},
+	}
+	for _, test := range tests {
+		if role := roleOn(test.grants, test.groupname, test.appname); role != test.role {
+			t.Errorf("roleOn(%q,%q) = %d,want %d", test.groupname, test.appname, role, test.role)
+		}
+	}
+}
@@ -0,0 +1,196 @@
+package sconfig
+
+import (
+	"context"
+	"time"
+
+	"github.com/chenjie199234/Config/api"
+	"github.com/chenjie199234/Config/config"
+	sconfigdao "github.com/chenjie199234/Config/dao/sconfig"
+	"github.com/chenjie199234/Config/ecode"
+
+	"github.com/chenjie199234/Corelib/log"
+)
+
+//recycleRetention return how long the deleted app will be kept in the recycle bin
+//the app config can hot update,so read it every time
+func recycleRetention() uint64 {
+	if ac := config.AC; ac != nil && ac.RecycleRetention > 0 {
+		return uint64(time.Duration(ac.RecycleRetention).Seconds())
+	}
+	return uint64((time.Hour * 24 * 7).Seconds())
+}
+
+//runRecycle purge the expired apps in the recycle bin every minute until the service stop
+//every replica runs this,the purge only works when the app's dtime is not changed
+//so the app restored and deleted again will not be purged by the stale recycle info
+func (s *Service) runRecycle() {
+	tker := time.NewTicker(time.Minute)
+	defer tker.Stop()
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-tker.C:
+		}
+		recycles, e := s.sconfigDao.GetRecycle(s.ctx, "")
+		if e != nil {
+			if s.ctx.Err() == nil {
+				log.Error("[sconfig.runRecycle] get recycle error:", e)
+			}
+			continue
+		}
+		now := uint64(time.Now().Unix())
+		keep := recycleRetention()
+		for _, recycle := range recycles {
+			//sorted by dtime asc
+			if recycle.Dtime+keep > now {
+				break
+			}
+			ctx, cancel := context.WithTimeout(s.ctx, time.Second*5)
+			if e := s.sconfigDao.PurgeApp(ctx, recycle.Groupname, recycle.Appname, recycle.Dtime); e != nil {
+				if e != sconfigdao.ErrNotExist {
+					log.Error("[sconfig.runRecycle] group:", recycle.Groupname, "app:", recycle.Appname, "purge error:", e)
+				}
+				//purged by other replica or restored
+			} else {
+				schemaCache.Delete(appKey{groupname: recycle.Groupname, appname: recycle.Appname})
+				s.auditAs(ctx, "recycle", "", recycle.Groupname, recycle.Appname, "purge", 0, 0, 0, "expired")
+			}
+			cancel()
+		}
+	}
+}
+
+//move one specific app or all apps in one group into the recycle bin
+func (s *Service) Sdelete(ctx context.Context, in *api.SdeleteReq) (*api.SdeleteResp, error) {
+	if e := s.check(ctx, in.Groupname, in.Appname, roleAdmin); e != nil {
+		return nil, e
+	}
+	appnames := []string{in.Appname}
+	if in.Appname == "" {
+		var e error
+		if appnames, e = s.sconfigDao.GetApps(ctx, in.Groupname); e != nil {
+			log.Error("[sconfig.Sdelete] get apps error:", e)
+			return nil, ecode.ErrSystem
+		}
+	}
+	//all apps deleted together have the same dtime
+	dtime := uint64(time.Now().Unix())
+	resp := &api.SdeleteResp{Appnames: make([]string, 0, len(appnames))}
+	for _, appname := range appnames {
+		sum, e := s.sconfigDao.DelApp(ctx, in.Groupname, appname, dtime)
+		if e != nil {
+			if e == sconfigdao.ErrNotExist {
+				//deleted by others
+				continue
+			}
+			log.Error("[sconfig.Sdelete] group:", in.Groupname, "app:", appname, "error:", e)
+			return nil, ecode.ErrSystem
+		}
+		s.audit(ctx, in.Groupname, appname, "delete", sum.CurIndex, 0, sum.OpNum)
+		resp.Appnames = append(resp.Appnames, appname)
+	}
+	if len(resp.Appnames) == 0 {
+		return nil, ecode.ErrNotExist
+	}
+	return resp, nil
+}
+
+//move one specific app or all deleted apps in one group out of the recycle bin
+func (s *Service) Srestore(ctx context.Context, in *api.SrestoreReq) (*api.SrestoreResp, error) {
+	if e := s.check(ctx, in.Groupname, in.Appname, roleAdmin); e != nil {
+		return nil, e
+	}
+	appnames, e := s.deletedApps(ctx, in.Groupname, in.Appname)
+	if e != nil {
+		return nil, e
+	}
+	resp := &api.SrestoreResp{Appnames: make([]string, 0, len(appnames))}
+	for _, appname := range appnames {
+		sum, e := s.sconfigDao.RestoreApp(ctx, in.Groupname, appname)
+		if e != nil {
+			if e == sconfigdao.ErrNotExist {
+				//restored or purged by others
+				continue
+			}
+			log.Error("[sconfig.Srestore] group:", in.Groupname, "app:", appname, "error:", e)
+			return nil, ecode.ErrSystem
+		}
+		s.audit(ctx, in.Groupname, appname, "restore", 0, sum.CurIndex, sum.OpNum)
+		resp.Appnames = append(resp.Appnames, appname)
+	}
+	if len(resp.Appnames) == 0 {
+		return nil, ecode.ErrNotExist
+	}
+	return resp, nil
+}
+
+//remove one specific app or all deleted apps in one group from the recycle bin,this can't be undone
+func (s *Service) Spurge(ctx context.Context, in *api.SpurgeReq) (*api.SpurgeResp, error) {
+	if e := s.check(ctx, in.Groupname, in.Appname, roleAdmin); e != nil {
+		return nil, e
+	}
+	appnames, e := s.deletedApps(ctx, in.Groupname, in.Appname)
+	if e != nil {
+		return nil, e
+	}
+	resp := &api.SpurgeResp{Appnames: make([]string, 0, len(appnames))}
+	for _, appname := range appnames {
+		if e := s.sconfigDao.PurgeApp(ctx, in.Groupname, appname, 0); e != nil {
+			if e == sconfigdao.ErrNotExist {
+				//restored or purged by others
+				continue
+			}
+			log.Error("[sconfig.Spurge] group:", in.Groupname, "app:", appname, "error:", e)
+			return nil, ecode.ErrSystem
+		}
+		schemaCache.Delete(appKey{groupname: in.Groupname, appname: appname})
+		s.audit(ctx, in.Groupname, appname, "purge", 0, 0, 0)
+		resp.Appnames = append(resp.Appnames, appname)
+	}
+	if len(resp.Appnames) == 0 {
+		return nil, ecode.ErrNotExist
+	}
+	return resp, nil
+}
+
+//deletedApps return the app itself or all deleted apps in the group when the appname is empty
+func (s *Service) deletedApps(ctx context.Context, groupname, appname string) ([]string, error) {
+	if appname != "" {
+		return []string{appname}, nil
+	}
+	recycles, e := s.sconfigDao.GetRecycle(ctx, groupname)
+	if e != nil {
+		log.Error("[sconfig.deletedApps] group:", groupname, "error:", e)
+		return nil, ecode.ErrSystem
+	}
+	appnames := make([]string, 0, len(recycles))
+	for _, recycle := range recycles {
+		appnames = append(appnames, recycle.Appname)
+	}
+	return appnames, nil
+}
+
+//get the apps in the recycle bin
+func (s *Service) Srecycle(ctx context.Context, in *api.SrecycleReq) (*api.SrecycleResp, error) {
+	if e := s.check(ctx, in.Groupname, "", roleViewer); e != nil {
+		return nil, e
+	}
+	recycles, e := s.sconfigDao.GetRecycle(ctx, in.Groupname)
+	if e != nil {
+		log.Error("[sconfig.Srecycle] error:", e)
+		return nil, ecode.ErrSystem
+	}
+	keep := recycleRetention()
+	resp := &api.SrecycleResp{Apps: make([]*api.RecycleInfo, 0, len(recycles))}
+	for _, recycle := range recycles {
+		resp.Apps = append(resp.Apps, &api.RecycleInfo{
+			Groupname: recycle.Groupname,
+			Appname:   recycle.Appname,
+			Dtime:     recycle.Dtime,
+			PurgeTime: recycle.Dtime + keep,
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,191 @@
+package sconfig
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"github.com/chenjie199234/Config/ecode"
+
+	"github.com/chenjie199234/Corelib/log"
+)
+
+//the values whose key matches any pattern are shown as {"$redacted":"<fingerprint of the value>"}
+//unless the caller can reveal them,the fingerprint shows whether the value is changed without showing the value
+//the redacted value can be set back,it will be replaced by the value on the same path in the current version
+var defaultRedactKeys = []string{"passwd", "password", "secret", "token"}
+
+//parseRedactKeys split the comma separated patterns,the patterns are matched case-insensitively
+func parseRedactKeys(str string) []string {
+	keys := make([]string, 0)
+	for _, key := range strings.Split(str, ",") {
+		if key = strings.ToLower(strings.TrimSpace(key)); key != "" {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+func (s *Service) redactKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, pattern := range s.redact {
+		if strings.Contains(key, pattern) {
+			return true
+		}
+	}
+	return false
+}
+
+//fingerprint is a hmac keyed by the key file,so the value can't be guessed offline from the fingerprint
+//empty means there is no key file,then the redacted value is set back without checking the fingerprint
+func (s *Service) fingerprint(groupname, appname string, value interface{}) string {
+	if s.secret == nil {
+		return ""
+	}
+	mac := hmac.New(sha256.New, s.secret.mackey)
+	mac.Write([]byte(groupname + "/" + appname + "/" + encodeJSON(value)))
+	return hex.EncodeToString(mac.Sum(nil)[:8])
+}
+
+func hasRedacted(doc string) bool {
+	return strings.Contains(doc, `"$redacted"`)
+}
+
+//isMarker return true when the object is a secret or a redacted value,it's content should not be redacted again
+func isMarker(v interface{}) bool {
+	obj, ok := v.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	_, plain := obj["$secret"]
+	_, encrypted := obj["$encrypted"]
+	_, redacted := obj["$redacted"]
+	return plain || encrypted || redacted
+}
+
+//canReveal return true when the caller can see the redacted values on the scope
+//the app's sdk and the root can always see them
+//when rbac is disabled,the caller can't be identified,so only the app's sdk can see them
+func (s *Service) canReveal(ctx context.Context, groupname, appname string) (bool, error) {
+	if owner, e := s.isOwner(ctx, groupname, appname); e != nil || owner {
+		return owner, e
+	}
+	if s.root == "" {
+		return false, nil
+	}
+	grants, e := s.getGrants(ctx)
+	if e != nil {
+		return false, e
+	}
+	if grants == nil {
+		return true, nil
+	}
+	for _, grant := range grants {
+		if grant.Reveal && (grant.Groupname == "" || (grant.Groupname == groupname && (grant.Appname == "" || grant.Appname == appname))) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (s *Service) redactValue(groupname, appname string, v interface{}) interface{} {
+	if isMarker(v) {
+		return v
+	}
+	switch x := v.(type) {
+	case map[string]interface{}:
+		for k, sub := range x {
+			if s.redactKey(k) && !isMarker(sub) {
+				x[k] = map[string]interface{}{"$redacted": s.fingerprint(groupname, appname, sub)}
+			} else {
+				x[k] = s.redactValue(groupname, appname, sub)
+			}
+		}
+	case []interface{}:
+		for i, sub := range x {
+			x[i] = s.redactValue(groupname, appname, sub)
+		}
+	}
+	return v
+}
+
+//redactConfig replace the values whose key matches the patterns,the doc should be masked first
+func (s *Service) redactConfig(groupname, appname, doc string) string {
+	if len(s.redact) == 0 {
+		return doc
+	}
+	v, e := decodeJSON(doc)
+	if e != nil {
+		//the stored config is always a valid json,this should not happen
+		return doc
+	}
+	return encodeJSON(s.redactValue(groupname, appname, v))
+}
+
+//showConfig mask the secrets and redact the values for the caller who is not the app's sdk
+func (s *Service) showConfig(groupname, appname, doc string, reveal bool) string {
+	doc = maskSecrets(doc)
+	if !reveal {
+		doc = s.redactConfig(groupname, appname, doc)
+	}
+	return doc
+}
+
+//unredactValue replace the redacted values in v by the values on the same path in current
+func (s *Service) unredactValue(groupname, appname string, v, current interface{}) (interface{}, error) {
+	switch x := v.(type) {
+	case map[string]interface{}:
+		if fp, ok := x["$redacted"].(string); ok && len(x) == 1 {
+			if current == nil || isMarker(current) || s.fingerprint(groupname, appname, current) != fp {
+				return nil, ecode.ErrSecret
+			}
+			return current, nil
+		}
+		curmap, _ := current.(map[string]interface{})
+		for k, sub := range x {
+			r, e := s.unredactValue(groupname, appname, sub, curmap[k])
+			if e != nil {
+				return nil, e
+			}
+			x[k] = r
+		}
+	case []interface{}:
+		curarray, _ := current.([]interface{})
+		for i, sub := range x {
+			var cur interface{}
+			if i < len(curarray) {
+				cur = curarray[i]
+			}
+			r, e := s.unredactValue(groupname, appname, sub, cur)
+			if e != nil {
+				return nil, e
+			}
+			x[i] = r
+		}
+	}
+	return v, nil
+}
+
+//unredactConfig set back the redacted values from the current version's doc
+func (s *Service) unredactConfig(groupname, appname, doc, current string) (string, error) {
+	if !hasRedacted(doc) {
+		return doc, nil
+	}
+	v, e := decodeJSON(doc)
+	if e != nil {
+		return "", ecode.ErrCoinfigFormat
+	}
+	var cur interface{}
+	if current != "" {
+		if cur, e = decodeJSON(current); e != nil {
+			log.Error("[sconfig.unredactConfig] group:", groupname, "app:", appname, "decode current config error:", e)
+			return "", ecode.ErrSystem
+		}
+	}
+	if v, e = s.unredactValue(groupname, appname, v, cur); e != nil {
+		return "", e
+	}
+	return encodeJSON(v), nil
+}
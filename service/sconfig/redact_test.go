@@ -0,0 +1,96 @@
+package sconfig
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRedactConfig(t *testing.T) {
+	s := &Service{redact: parseRedactKeys(" Password,token ,"), secret: newTestKeyring(t, 1)}
+	tests := []struct {
+		doc      string
+		redacted []string //the redacted keys
+		kept     []string //the values which should still be shown
+	}{
+		{`{"user":"u","password":"p"}`, []string{"password"}, []string{`"u"`}},
+		{`{"DB_PASSWORD":"p","x":{"api_token":{"a":1}}}`, []string{"DB_PASSWORD", "api_token"}, nil},
+		{`{"list":[{"token":"t","name":"n"}]}`, []string{"token"}, []string{`"n"`}},
+		{`{"password":{"$secret":"******","$id":"x"}}`, nil, []string{`"$secret"`}},
+		{`{"passwd":"p"}`, nil, []string{`"p"`}},
+	}
+	for _, test := range tests {
+		redacted := s.showConfig("g", "a", test.doc, false)
+		for _, key := range test.redacted {
+			if !strings.Contains(redacted, `"`+key+`":{"$redacted":"`) {
+				t.Errorf("redact %s = %s,%s is not redacted", test.doc, redacted, key)
+			}
+		}
+		for _, value := range test.kept {
+			if !strings.Contains(redacted, value) {
+				t.Errorf("redact %s = %s,%s is missing", test.doc, redacted, value)
+			}
+		}
+		if revealed := s.showConfig("g", "a", test.doc, true); encodeJSON(mustDecode(t, revealed)) != encodeJSON(mustDecode(t, test.doc)) {
+			t.Errorf("reveal %s = %s", test.doc, revealed)
+		}
+		//the redacted doc can be set back
+		restored, e := s.unredactConfig("g", "a", redacted, test.doc)
+		if e != nil {
+			t.Errorf("unredact %s error: %v", redacted, e)
+			continue
+		}
+		if restored != encodeJSON(mustDecode(t, test.doc)) {
+			t.Errorf("unredact %s = %s,want %s", redacted, restored, test.doc)
+		}
+	}
+}
+
+func TestUnredactConfig(t *testing.T) {
+	s := &Service{redact: defaultRedactKeys, secret: newTestKeyring(t, 1)}
+	current := `{"password":"p","list":[{"token":"t"}]}`
+	redacted := s.showConfig("g", "a", current, false)
+	tests := []struct {
+		doc    string
+		result string //empty means error
+	}{
+		//untouched
+		{redacted, `{"list":[{"token":"t"}],"password":"p"}`},
+		//other parts changed
+		{strings.Replace(redacted, `"list":[`, `"user":"u","list":[`, 1), `{"list":[{"token":"t"}],"password":"p","user":"u"}`},
+		//the redacted value is replaced
+		{strings.Replace(redacted, `"list":[{"token":{"$redacted":`, `"list":[{"token":"n","x":{"$redacted":`, 1), ``},
+		{`{"password":"new"}`, `{"password":"new"}`},
+		//the fingerprint doesn't match the value on the path
+		{`{"password":{"$redacted":"0000"}}`, ``},
+		//the redacted value is moved to another path
+		{`{"other":{"$redacted":"` + s.fingerprint("g", "a", "p") + `"}}`, ``},
+	}
+	for _, test := range tests {
+		result, e := s.unredactConfig("g", "a", test.doc, current)
+		if test.result == "" {
+			if e == nil {
+				t.Errorf("unredact %s = %s,want error", test.doc, result)
+			}
+			continue
+		}
+		if e != nil || result != test.result {
+			t.Errorf("unredact %s = %s,%v,want %s", test.doc, result, e, test.result)
+		}
+	}
+	//the fingerprint is bound to the key file and the app
+	other := &Service{redact: defaultRedactKeys, secret: newTestKeyring(t, 1)}
+	if _, e := other.unredactConfig("g", "a", redacted, current); e == nil {
+		t.Error("unredact with other key file should fail")
+	}
+	if _, e := s.unredactConfig("g", "b", redacted, current); e == nil {
+		t.Error("unredact with other app should fail")
+	}
+}
+
+func mustDecode(t *testing.T, doc string) interface{} {
+	v, e := decodeJSON(doc)
+	if e != nil {
+		t.Fatal(e)
+	}
+	return v
+}
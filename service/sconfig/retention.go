@@ -0,0 +1,179 @@
+package sconfig
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/chenjie199234/Config/api"
+	sconfigdao "github.com/chenjie199234/Config/dao/sconfig"
+	"github.com/chenjie199234/Config/ecode"
+
+	"github.com/chenjie199234/Corelib/log"
+)
+
+//the versions are pruned every this interval
+const pruneInterval = time.Minute * 10
+
+//the pruner holds the lease for 2 rounds,so the other replica will take over when this replica is down
+const pruneLease = uint64(pruneInterval * 2 / time.Second)
+
+//runPrune prune the versions out of the group's retention every pruneInterval until the service stop
+//every replica runs this,but only the one holding the lease will prune
+func (s *Service) runPrune() {
+	tker := time.NewTicker(pruneInterval)
+	defer tker.Stop()
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-tker.C:
+		}
+		leader, e := s.sconfigDao.Lease(s.ctx, "prune", s.id, pruneLease)
+		if e != nil {
+			if s.ctx.Err() == nil {
+				log.Error("[sconfig.runPrune] lease error:", e)
+			}
+			continue
+		}
+		if !leader {
+			continue
+		}
+		retentions, e := s.sconfigDao.GetRetentions(s.ctx)
+		if e != nil {
+			if s.ctx.Err() == nil {
+				log.Error("[sconfig.runPrune] get retentions error:", e)
+			}
+			continue
+		}
+		for _, retention := range retentions {
+			if retention.KeepNum == 0 && retention.KeepTime == 0 {
+				continue
+			}
+			appnames, e := s.sconfigDao.GetApps(s.ctx, retention.Groupname)
+			if e != nil {
+				log.Error("[sconfig.runPrune] group:", retention.Groupname, "get apps error:", e)
+				continue
+			}
+			for _, appname := range appnames {
+				s.prune(retention, appname)
+			}
+		}
+	}
+}
+
+//prune never drops the current,canary,previous,draft,tagged and scheduled versions
+//the previous version is kept for the guard's automatic rollback
+func (s *Service) prune(retention *sconfigdao.Retention, appname string) {
+	ctx, cancel := context.WithTimeout(s.ctx, time.Second*10)
+	defer cancel()
+	sum, _, e := s.sconfigDao.GetInfo(ctx, retention.Groupname, appname)
+	if e != nil {
+		if e != sconfigdao.ErrNotExist {
+			log.Error("[sconfig.prune] group:", retention.Groupname, "app:", appname, "get info error:", e)
+		}
+		//only has drafts,nothing to prune
+		return
+	}
+	keep := map[uint64]bool{sum.CurIndex: true, sum.PrevIndex: true}
+	if sum.Canary != nil {
+		keep[sum.Canary.Index] = true
+	}
+	schedules, e := s.sconfigDao.GetSchedules(ctx, retention.Groupname, appname)
+	if e != nil {
+		log.Error("[sconfig.prune] group:", retention.Groupname, "app:", appname, "get schedules error:", e)
+		return
+	}
+	for _, schedule := range schedules {
+		keep[schedule.Index] = true
+	}
+	versions, e := s.sconfigDao.GetVersions(ctx, retention.Groupname, appname)
+	if e != nil {
+		log.Error("[sconfig.prune] group:", retention.Groupname, "app:", appname, "get versions error:", e)
+		return
+	}
+	now := uint64(time.Now().Unix())
+	indexes := make([]uint64, 0)
+	//versions are sorted by index desc
+	for i, version := range versions {
+		if keep[version.Index] || version.Draft || version.Tag != "" {
+			continue
+		}
+		if retention.KeepNum != 0 && i < int(retention.KeepNum) {
+			continue
+		}
+		if retention.KeepTime != 0 && version.Ctime+retention.KeepTime > now {
+			continue
+		}
+		indexes = append(indexes, version.Index)
+	}
+	if len(indexes) == 0 {
+		return
+	}
+	deleted, e := s.sconfigDao.DelConfigs(ctx, retention.Groupname, appname, indexes)
+	if e != nil {
+		log.Error("[sconfig.prune] group:", retention.Groupname, "app:", appname, "error:", e)
+		return
+	}
+	if len(deleted) == 0 {
+		return
+	}
+	//indexes are desc
+	s.auditAs(ctx, "pruner", "", retention.Groupname, appname, "prune", deleted[len(deleted)-1], deleted[0], sum.OpNum, "pruned "+strconv.Itoa(len(deleted))+" versions")
+}
+
+//tag one specific app's version,the tagged version will never be pruned
+func (s *Service) Stag(ctx context.Context, in *api.StagReq) (*api.StagResp, error) {
+	if e := s.check(ctx, in.Groupname, in.Appname, roleEditor); e != nil {
+		return nil, e
+	}
+	if len(in.Tag) > 64 {
+		return nil, ecode.ErrReq
+	}
+	if e := s.sconfigDao.SetTag(ctx, in.Groupname, in.Appname, in.Index, in.Tag); e != nil {
+		log.Error("[sconfig.Stag] error:", e)
+		if e == sconfigdao.ErrNotExist {
+			return nil, ecode.ErrNotExist
+		}
+		return nil, ecode.ErrSystem
+	}
+	caller, ip := s.getCaller(ctx)
+	s.auditAs(ctx, caller, ip, in.Groupname, in.Appname, "tag", in.Index, in.Index, 0, in.Tag)
+	return &api.StagResp{}, nil
+}
+
+//set one specific group's history retention
+func (s *Service) SretentionSet(ctx context.Context, in *api.SretentionSetReq) (*api.SretentionSetResp, error) {
+	if e := s.check(ctx, in.Groupname, "", roleAdmin); e != nil {
+		return nil, e
+	}
+	author, ip := s.getCaller(ctx)
+	if e := s.sconfigDao.SetRetention(ctx, &sconfigdao.Retention{
+		Groupname: in.Groupname,
+		KeepNum:   in.KeepNum,
+		KeepTime:  in.KeepTime,
+		Ctime:     uint64(time.Now().Unix()),
+		Author:    author,
+	}); e != nil {
+		log.Error("[sconfig.SretentionSet] error:", e)
+		return nil, ecode.ErrSystem
+	}
+	s.auditAs(ctx, author, ip, in.Groupname, "", "retention", 0, 0, 0, "keep_num:"+strconv.FormatUint(uint64(in.KeepNum), 10)+",keep_time:"+strconv.FormatUint(in.KeepTime, 10))
+	return &api.SretentionSetResp{}, nil
+}
+
+//get one specific group's history retention
+func (s *Service) SretentionGet(ctx context.Context, in *api.SretentionGetReq) (*api.SretentionGetResp, error) {
+	if e := s.check(ctx, in.Groupname, "", roleViewer); e != nil {
+		return nil, e
+	}
+	retention, e := s.sconfigDao.GetRetention(ctx, in.Groupname)
+	if e != nil {
+		if e == sconfigdao.ErrNotExist {
+			return &api.SretentionGetResp{}, nil
+		}
+		log.Error("[sconfig.SretentionGet] error:", e)
+		return nil, ecode.ErrSystem
+	}
+	return &api.SretentionGetResp{KeepNum: retention.KeepNum, KeepTime: retention.KeepTime, Ctime: retention.Ctime, Author: retention.Author}, nil
+}
@@ -0,0 +1,127 @@
+package sconfig
+
+import (
+	"context"
+	"time"
+
+	sconfigdao "github.com/chenjie199234/Config/dao/sconfig"
+
+	"github.com/chenjie199234/Corelib/log"
+)
+
+//the scheduler holds the lease for a few rounds,so the other replica will take over when this replica is down
+const scheduleLease = 10
+
+//the open guards are evaluated every this many rounds
+const guardRounds = 10
+
+//runSchedule check the due schedules every second until the service stop
+//every replica runs this,but only the one holding the lease will fire the schedules
+//the schedule claimed by the replica which is down during the publish will be settled by the next leader
+//the open guards are also evaluated here
+func (s *Service) runSchedule() {
+	tker := time.NewTicker(time.Second)
+	defer tker.Stop()
+	for round := 0; ; round++ {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-tker.C:
+		}
+		leader, e := s.sconfigDao.Lease(s.ctx, "schedule", s.id, scheduleLease)
+		if e != nil {
+			if s.ctx.Err() == nil {
+				log.Error("[sconfig.runSchedule] lease error:", e)
+			}
+			continue
+		}
+		if !leader {
+			continue
+		}
+		if round%guardRounds == 0 {
+			s.checkGuards()
+		}
+		schedules, e := s.sconfigDao.GetDueSchedules(s.ctx, uint64(time.Now().Unix()))
+		if e != nil {
+			if s.ctx.Err() == nil {
+				log.Error("[sconfig.runSchedule] get due schedules error:", e)
+			}
+			continue
+		}
+		for _, schedule := range schedules {
+			s.fireSchedule(schedule)
+		}
+	}
+}
+
+//fireSchedule claim the schedule(pending to firing) before the publish,so the canceled schedule will not be published
+//the publish expects the op_num before the claim,so it will not revert the changes made after that
+func (s *Service) fireSchedule(schedule *sconfigdao.Schedule) {
+	ctx, cancel := context.WithTimeout(s.ctx, time.Second*5)
+	defer cancel()
+	if schedule.Status == sconfigdao.ScheduleFiring {
+		s.settleSchedule(ctx, schedule)
+		return
+	}
+	var expectopnum uint64
+	if sum, _, e := s.sconfigDao.GetInfo(ctx, schedule.Groupname, schedule.Appname); e == nil {
+		expectopnum = sum.OpNum
+	} else if e != sconfigdao.ErrNotExist {
+		log.Error("[sconfig.fireSchedule] group:", schedule.Groupname, "app:", schedule.Appname, "schedule:", schedule.ID, "get info error:", e)
+		return
+	}
+	if _, e := s.sconfigDao.UpdateSchedule(ctx, schedule.Groupname, schedule.Appname, schedule.ID, sconfigdao.SchedulePending, sconfigdao.ScheduleFiring, ""); e != nil {
+		if e != sconfigdao.ErrNotExist {
+			log.Error("[sconfig.fireSchedule] group:", schedule.Groupname, "app:", schedule.Appname, "schedule:", schedule.ID, "claim error:", e)
+		}
+		//canceled
+		return
+	}
+	sum, e := s.publish(ctx, schedule.Groupname, schedule.Appname, schedule.Index, expectopnum)
+	if e != nil {
+		log.Error("[sconfig.fireSchedule] group:", schedule.Groupname, "app:", schedule.Appname, "schedule:", schedule.ID, "index:", schedule.Index, "error:", e)
+		if ctx.Err() != nil {
+			//the publish's result is unknown,the schedule is still firing and will be settled in the next round
+			return
+		}
+		s.finishSchedule(ctx, schedule, nil, e.Error())
+		return
+	}
+	s.finishSchedule(ctx, schedule, sum, "")
+}
+
+//settleSchedule finish the schedule which was claimed but it's result was not recorded
+//e.g. the replica was down during the publish or the status update failed
+//the schedule is never published again here,it is fired only when it's version is already current
+func (s *Service) settleSchedule(ctx context.Context, schedule *sconfigdao.Schedule) {
+	sum, _, e := s.sconfigDao.GetInfo(ctx, schedule.Groupname, schedule.Appname)
+	if e != nil && e != sconfigdao.ErrNotExist {
+		log.Error("[sconfig.settleSchedule] group:", schedule.Groupname, "app:", schedule.Appname, "schedule:", schedule.ID, "get info error:", e)
+		return
+	}
+	if e == nil && sum.CurIndex == schedule.Index {
+		s.finishSchedule(ctx, schedule, sum, "")
+		return
+	}
+	s.finishSchedule(ctx, schedule, nil, "interrupted,the version is not published")
+}
+
+//finishSchedule change the status from firing to fired(sum is not nil) or failed(sum is nil)
+//the schedule stays firing when the update failed,it will be settled in the next round
+func (s *Service) finishSchedule(ctx context.Context, schedule *sconfigdao.Schedule, sum *sconfigdao.Summary, reason string) {
+	to := sconfigdao.ScheduleFired
+	if sum == nil {
+		to = sconfigdao.ScheduleFailed
+	}
+	if _, e := s.sconfigDao.UpdateSchedule(ctx, schedule.Groupname, schedule.Appname, schedule.ID, sconfigdao.ScheduleFiring, to, reason); e != nil {
+		if e != sconfigdao.ErrNotExist {
+			log.Error("[sconfig.finishSchedule] group:", schedule.Groupname, "app:", schedule.Appname, "schedule:", schedule.ID, "update status error:", e)
+		}
+		return
+	}
+	if sum == nil {
+		s.auditAs(ctx, schedule.Author, "", schedule.Groupname, schedule.Appname, "schedule_fail", 0, schedule.Index, 0, reason)
+	} else {
+		s.auditAs(ctx, schedule.Author, "", schedule.Groupname, schedule.Appname, "schedule_fire", sum.PrevIndex, sum.CurIndex, sum.OpNum, "")
+	}
+}
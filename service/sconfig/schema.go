@@ -0,0 +1,150 @@
+package sconfig
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/chenjie199234/Config/config"
+	sconfigdao "github.com/chenjie199234/Config/dao/sconfig"
+	"github.com/chenjie199234/Config/ecode"
+
+	"github.com/chenjie199234/Corelib/log"
+	"github.com/chenjie199234/Corelib/util/common"
+	cerror "github.com/chenjie199234/Corelib/util/error"
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+//the newest compiled schema of every app,key is appKey
+//the entry is replaced when the schema changed and removed when the app is purged,so it holds at most one schema per app
+var schemaCache sync.Map
+
+type compiledSchema struct {
+	//the schema's index restarts after the app is purged,so the digest of the schema's content is used to find the change
+	digest string
+	app    *jsonschema.Schema
+	source *jsonschema.Schema
+}
+
+//compileSchema return nil when the schema is empty
+func compileSchema(schema string) (*jsonschema.Schema, error) {
+	if schema == "" {
+		return nil, nil
+	}
+	compiler := jsonschema.NewCompiler()
+	//don't load any resource from the server's file system or network
+	compiler.LoadURL = func(url string) (io.ReadCloser, error) {
+		return nil, errors.New("load " + url + " is not supported")
+	}
+	if e := compiler.AddResource("schema.json", strings.NewReader(schema)); e != nil {
+		return nil, e
+	}
+	return compiler.Compile("schema.json")
+}
+
+//validateSchema return the field level errors,nil means pass
+func validateSchema(schema *jsonschema.Schema, doc string) ([]string, error) {
+	if schema == nil {
+		return nil, nil
+	}
+	v, e := decodeJSON(doc)
+	if e != nil {
+		return nil, e
+	}
+	e = schema.Validate(v)
+	if e == nil {
+		return nil, nil
+	}
+	ve, ok := e.(*jsonschema.ValidationError)
+	if !ok {
+		return nil, e
+	}
+	result := make([]string, 0)
+	var leaf func(*jsonschema.ValidationError)
+	leaf = func(ve *jsonschema.ValidationError) {
+		if len(ve.Causes) == 0 {
+			location := ve.InstanceLocation
+			if location == "" {
+				location = "/"
+			}
+			result = append(result, location+": "+ve.Message)
+			return
+		}
+		for _, cause := range ve.Causes {
+			leaf(cause)
+		}
+	}
+	leaf(ve)
+	return result, nil
+}
+
+//getSchema return the app's newest compiled schema
+//nil means the app doesn't have schema
+func (s *Service) getSchema(ctx context.Context, groupname, appname string) (*compiledSchema, error) {
+	schema, e := s.sconfigDao.GetSchema(ctx, groupname, appname, 0)
+	if e != nil {
+		if e == sconfigdao.ErrNotExist {
+			schemaCache.Delete(appKey{groupname: groupname, appname: appname})
+			return nil, nil
+		}
+		log.Error("[sconfig.getSchema] group:", groupname, "app:", appname, "error:", e)
+		return nil, ecode.ErrSystem
+	}
+	hash := sha256.Sum256([]byte(schema.AppSchema + "\x00" + schema.SourceSchema))
+	digest := hex.EncodeToString(hash[:])
+	key := appKey{groupname: groupname, appname: appname}
+	if v, ok := schemaCache.Load(key); ok && v.(*compiledSchema).digest == digest {
+		return v.(*compiledSchema), nil
+	}
+	compiled := &compiledSchema{digest: digest}
+	if compiled.app, e = compileSchema(schema.AppSchema); e == nil {
+		compiled.source, e = compileSchema(schema.SourceSchema)
+	}
+	if e != nil {
+		//the schema is checked when it is set,this should not happen
+		log.Error("[sconfig.getSchema] group:", groupname, "app:", appname, "schema index:", schema.Index, "compile error:", e)
+		return nil, ecode.ErrSystem
+	}
+	schemaCache.Store(key, compiled)
+	return compiled, nil
+}
+
+//check the source config with the built-in layout first,then check the configs with the schema
+//return ecode.ErrSourceConfigCheck or ecode.ErrSchemaCheck with field level errors
+//c can be nil,then only the built-in check will be done
+func (c *compiledSchema) check(appconfig, sourceconfig string) error {
+	if fields := config.CheckSourceConfig(common.Str2byte(sourceconfig)); len(fields) > 0 {
+		for i := range fields {
+			fields[i] = "source_config" + fields[i]
+		}
+		return cerror.MakeError(ecode.ErrSourceConfigCheck.Code, ecode.ErrSourceConfigCheck.Msg+": "+strings.Join(fields, "; "))
+	}
+	if c == nil {
+		return nil
+	}
+	fields := make([]string, 0)
+	appfields, e := validateSchema(c.app, appconfig)
+	if e != nil {
+		log.Error("[sconfig.check] validate app config error:", e)
+		return ecode.ErrCoinfigFormat
+	}
+	for _, field := range appfields {
+		fields = append(fields, "app_config"+field)
+	}
+	sourcefields, e := validateSchema(c.source, sourceconfig)
+	if e != nil {
+		log.Error("[sconfig.check] validate source config error:", e)
+		return ecode.ErrCoinfigFormat
+	}
+	for _, field := range sourcefields {
+		fields = append(fields, "source_config"+field)
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+	return cerror.MakeError(ecode.ErrSchemaCheck.Code, ecode.ErrSchemaCheck.Msg+": "+strings.Join(fields, "; "))
+}
@@ -3,6 +3,10 @@ package sconfig
 import (
 	"context"
 	"encoding/json"
+	"os"
+	"sort"
+	"strconv"
+	"time"
 
 	"github.com/chenjie199234/Config/api"
 	"github.com/chenjie199234/Config/config"
@@ -11,39 +15,113 @@ import (
 
 	"github.com/chenjie199234/Corelib/log"
 	"github.com/chenjie199234/Corelib/util/common"
-	"go.mongodb.org/mongo-driver/mongo"
+	cerror "github.com/chenjie199234/Corelib/util/error"
 )
 
 //Service subservice for sconfig business
 type Service struct {
 	mongoname  string
-	sconfigDao *sconfigdao.Dao
+	sqlname    string
+	sconfigDao sconfigdao.Storage
+	hub        *hub
+	id         string   //this replica's id,used as the lease's owner
+	root       string   //the root token,empty means rbac is disabled
+	secret     *keyring //nil means the secrets can't be used
+	redact     []string //the patterns of the keys whose values are redacted
+	//background jobs will stop when this is canceled
+	ctx    context.Context
+	cancel context.CancelFunc
 }
 
 //Start -
-func Start() *Service {
+//if env SCONFIG_LOCAL_DIR is set,memory will be used and seeded from that dir
+//otherwise mongo is preferred,if the mongo doesn't exist,sql will be used
+func Start() (*Service, error) {
+	hostname, _ := os.Hostname()
 	s := &Service{
 		mongoname: "config_mongo",
+		sqlname:   "config_sql",
+		id:        hostname + ":" + strconv.Itoa(os.Getpid()),
+		redact:    defaultRedactKeys,
 	}
-	s.sconfigDao = sconfigdao.NewDao(nil, nil, config.GetMongo(s.mongoname))
-	return s
+	if config.EC.RedactKeys != nil {
+		s.redact = parseRedactKeys(*config.EC.RedactKeys)
+	}
+	if config.EC.RootToken != nil {
+		s.root = *config.EC.RootToken
+	}
+	if config.EC.SecretKeyFile != nil {
+		var e error
+		if s.secret, e = loadKeyring(*config.EC.SecretKeyFile); e != nil {
+			log.Error("[sconfig.Start] load secret key file:", *config.EC.SecretKeyFile, "error:", e)
+			return nil, e
+		}
+	}
+	if config.EC.SconfigLocalDir != nil {
+		var e error
+		if s.sconfigDao, e = sconfigdao.NewMemoryDao(*config.EC.SconfigLocalDir); e != nil {
+			log.Error("[sconfig.Start] init memory storage from:", *config.EC.SconfigLocalDir, "error:", e)
+			return nil, e
+		}
+		log.Info("[sconfig.Start] local mode,memory storage is used")
+	} else {
+		s.sconfigDao = sconfigdao.NewDao(config.GetSql(s.sqlname), nil, config.GetMongo(s.mongoname))
+	}
+	if e := s.sconfigDao.Init(context.Background()); e != nil {
+		log.Error("[sconfig.Start] init storage error:", e)
+		return nil, e
+	}
+	s.hub = newHub(s.sconfigDao)
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+	go s.runSchedule()
+	go s.runRecycle()
+	go s.runPrune()
+	return s, nil
 }
 
 //one specific app's current info
 func (s *Service) Sinfo(ctx context.Context, in *api.SinfoReq) (*api.SinfoResp, error) {
-	sum, conf, e := s.sconfigDao.MongoGetInfo(ctx, in.Groupname, in.Appname)
+	if e := s.checkRead(ctx, in.Groupname, in.Appname); e != nil {
+		return nil, e
+	}
+	reveal, e := s.canReveal(ctx, in.Groupname, in.Appname)
 	if e != nil {
 		log.Error("[sconfig.Sinfo] error:", e)
-		if e == mongo.ErrNoDocuments {
+		return nil, ecode.ErrSystem
+	}
+	sum, conf, e := s.sconfigDao.GetInfo(ctx, in.Groupname, in.Appname)
+	if e != nil {
+		log.Error("[sconfig.Sinfo] error:", e)
+		if e == sconfigdao.ErrNotExist {
 			return nil, ecode.ErrNotExist
 		}
 		return nil, ecode.ErrSystem
 	}
-	return &api.SinfoResp{CurIndex: sum.CurIndex, MaxIndex: sum.MaxIndex, OpNum: sum.OpNum, CurAppConfig: conf.AppConfig, CurSourceConfig: conf.SourceConfig}, nil
+	resp := &api.SinfoResp{
+		CurIndex:        sum.CurIndex,
+		MaxIndex:        sum.MaxIndex,
+		OpNum:           sum.OpNum,
+		CurAppConfig:    s.showConfig(in.Groupname, in.Appname, conf.AppConfig, reveal),
+		CurSourceConfig: s.showConfig(in.Groupname, in.Appname, conf.SourceConfig, reveal),
+	}
+	if sum.Canary != nil {
+		resp.CanaryIndex = sum.Canary.Index
+		resp.CanaryPercent = sum.Canary.Percent
+		resp.CanaryHostnames = sum.Canary.Hostnames
+		resp.CanaryLabels = sum.Canary.Labels
+	}
+	return resp, nil
 }
 
 //set one specific app's config
 func (s *Service) Sset(ctx context.Context, in *api.SsetReq) (*api.SsetResp, error) {
+	role := rolePublisher
+	if in.Draft {
+		role = roleEditor
+	}
+	if e := s.check(ctx, in.Groupname, in.Appname, role); e != nil {
+		return nil, e
+	}
 	if in.AppConfig == "" {
 		in.AppConfig = "{}"
 	}
@@ -56,72 +134,1027 @@ func (s *Service) Sset(ctx context.Context, in *api.SsetReq) (*api.SsetResp, err
 	if len(in.SourceConfig) < 2 || in.SourceConfig[0] != '{' || in.SourceConfig[len(in.SourceConfig)-1] != '}' || !json.Valid(common.Str2byte(in.SourceConfig)) {
 		return nil, ecode.ErrCoinfigFormat
 	}
-	e := s.sconfigDao.MongoSetConfig(ctx, in.Groupname, in.Appname, in.AppConfig, in.SourceConfig)
+	if e := validGuard(in.Guard); e != nil {
+		return nil, e
+	}
+	schema, e := s.getSchema(ctx, in.Groupname, in.Appname)
+	if e != nil {
+		return nil, e
+	}
+	base, e := s.getBase(ctx, in.Groupname)
+	if e != nil {
+		return nil, e
+	}
+	if hasSecret(in.AppConfig) || hasSecret(in.SourceConfig) || hasRedacted(in.AppConfig) || hasRedacted(in.SourceConfig) {
+		//the masked secrets and the redacted values are set back from the current version
+		_, current, e := s.sconfigDao.GetInfo(ctx, in.Groupname, in.Appname)
+		if e != nil && e != sconfigdao.ErrNotExist {
+			log.Error("[sconfig.Sset] get current config error:", e)
+			return nil, ecode.ErrSystem
+		}
+		var curapp, cursource string
+		if current != nil {
+			curapp, cursource = current.AppConfig, current.SourceConfig
+		}
+		if in.AppConfig, e = s.unredactConfig(in.Groupname, in.Appname, in.AppConfig, curapp); e != nil {
+			return nil, e
+		}
+		if in.SourceConfig, e = s.unredactConfig(in.Groupname, in.Appname, in.SourceConfig, cursource); e != nil {
+			return nil, e
+		}
+		if in.AppConfig, e = s.sealSecrets(in.Groupname, in.Appname, in.AppConfig, current); e != nil {
+			return nil, e
+		}
+		if in.SourceConfig, e = s.sealSecrets(in.Groupname, in.Appname, in.SourceConfig, current); e != nil {
+			return nil, e
+		}
+	}
+	if e = s.checkConfig(schema, base, in.Groupname, in.Appname, in.AppConfig, in.SourceConfig); e != nil {
+		return nil, e
+	}
+	//the change must be approved before it becomes current
+	if policy, e := s.getPolicy(ctx, in.Groupname); e != nil {
+		return nil, e
+	} else if policy != nil {
+		in.Draft = true
+	}
+	author, _ := s.getCaller(ctx)
+	sum, e := s.sconfigDao.SetConfig(ctx, in.Groupname, in.Appname, &sconfigdao.Config{
+		AppConfig:    in.AppConfig,
+		SourceConfig: in.SourceConfig,
+		Ctime:        uint64(time.Now().Unix()),
+		Author:       author,
+		Comment:      in.Comment,
+		Draft:        in.Draft,
+	}, in.ExpectedOpNum)
 	if e != nil {
 		log.Error("[sconfig.Sset] error:", e)
+		if e == sconfigdao.ErrOpNumConflict {
+			return nil, ecode.ErrVersionConflict
+		}
+		if e == sconfigdao.ErrDeleted {
+			return nil, ecode.ErrDeleted
+		}
 		return nil, ecode.ErrSystem
 	}
-	return &api.SsetResp{}, nil
+	if in.Draft {
+		s.audit(ctx, in.Groupname, in.Appname, "set_draft", sum.CurIndex, sum.MaxIndex, sum.OpNum)
+	} else {
+		s.audit(ctx, in.Groupname, in.Appname, "set", sum.PrevIndex, sum.CurIndex, sum.OpNum)
+		s.setGuard(ctx, in.Groupname, in.Appname, in.Guard, sum)
+	}
+	return &api.SsetResp{CurIndex: sum.CurIndex, MaxIndex: sum.MaxIndex, OpNum: sum.OpNum}, nil
 }
 
 //rollback one specific app's config
 func (s *Service) Srollback(ctx context.Context, in *api.SrollbackReq) (*api.SrollbackResp, error) {
-	status, e := s.sconfigDao.MongoRollbackConfig(ctx, in.Groupname, in.Appname, in.Index)
+	if e := s.check(ctx, in.Groupname, in.Appname, rolePublisher); e != nil {
+		return nil, e
+	}
+	schema, e := s.getSchema(ctx, in.Groupname, in.Appname)
 	if e != nil {
-		log.Error("[sconfig.Srollback] error:", e)
+		return nil, e
+	}
+	base, e := s.getBase(ctx, in.Groupname)
+	if e != nil {
+		return nil, e
+	}
+	conf, e := s.sconfigDao.GetConfig(ctx, in.Groupname, in.Appname, in.Index)
+	if e != nil {
+		log.Error("[sconfig.Srollback] get config error:", e)
+		if e == sconfigdao.ErrNotExist {
+			return nil, ecode.ErrNotExist
+		}
 		return nil, ecode.ErrSystem
 	}
-	if !status {
-		return nil, ecode.ErrNotExist
+	if conf.Draft {
+		return nil, ecode.ErrDraft
 	}
-	return &api.SrollbackResp{}, nil
+	if e = s.checkConfig(schema, base, in.Groupname, in.Appname, conf.AppConfig, conf.SourceConfig); e != nil {
+		return nil, e
+	}
+	sum, e := s.sconfigDao.RollbackConfig(ctx, in.Groupname, in.Appname, in.Index, in.ExpectedOpNum)
+	if e != nil {
+		log.Error("[sconfig.Srollback] error:", e)
+		if e == sconfigdao.ErrNotExist {
+			return nil, ecode.ErrNotExist
+		}
+		if e == sconfigdao.ErrOpNumConflict {
+			return nil, ecode.ErrVersionConflict
+		}
+		return nil, ecode.ErrSystem
+	}
+	s.audit(ctx, in.Groupname, in.Appname, "rollback", sum.PrevIndex, sum.CurIndex, sum.OpNum)
+	return &api.SrollbackResp{CurIndex: sum.CurIndex, MaxIndex: sum.MaxIndex, OpNum: sum.OpNum}, nil
 }
 
 //get one specific app's config
 func (s *Service) Sget(ctx context.Context, in *api.SgetReq) (*api.SgetResp, error) {
-	conf, e := s.sconfigDao.MongoGetConfig(ctx, in.Groupname, in.Appname, in.Index)
+	if e := s.checkRead(ctx, in.Groupname, in.Appname); e != nil {
+		return nil, e
+	}
+	reveal, e := s.canReveal(ctx, in.Groupname, in.Appname)
 	if e != nil {
 		log.Error("[sconfig.Sget] error:", e)
-		if e == mongo.ErrNoDocuments {
+		return nil, ecode.ErrSystem
+	}
+	conf, e := s.sconfigDao.GetConfig(ctx, in.Groupname, in.Appname, in.Index)
+	if e != nil {
+		log.Error("[sconfig.Sget] error:", e)
+		if e == sconfigdao.ErrNotExist {
 			return nil, ecode.ErrNotExist
 		}
 		return nil, ecode.ErrSystem
 	}
-	return &api.SgetResp{Index: conf.Index, AppConfig: conf.AppConfig, SourceConfig: conf.SourceConfig}, nil
+	return &api.SgetResp{
+		Index:        conf.Index,
+		AppConfig:    s.showConfig(in.Groupname, in.Appname, conf.AppConfig, reveal),
+		SourceConfig: s.showConfig(in.Groupname, in.Appname, conf.SourceConfig, reveal),
+		Ctime:        conf.Ctime,
+		Author:       conf.Author,
+		Comment:      conf.Comment,
+		Draft:        conf.Draft,
+		Tag:          conf.Tag,
+	}, nil
 }
 
 //get all groups
 func (s *Service) Sgroups(ctx context.Context, in *api.SgroupsReq) (*api.SgroupsResp, error) {
-	groups, e := s.sconfigDao.MongoGetGroups(ctx)
+	groups, e := s.sconfigDao.GetGroups(ctx)
+	if e != nil {
+		log.Error("[sconfig.Sgroups] error:", e)
+		return nil, ecode.ErrSystem
+	}
+	grants, e := s.getGrants(ctx)
 	if e != nil {
 		log.Error("[sconfig.Sgroups] error:", e)
 		return nil, ecode.ErrSystem
 	}
+	if grants != nil {
+		//only return the groups the caller has any grant in
+		visible := make([]string, 0, len(groups))
+		for _, group := range groups {
+			for _, grant := range grants {
+				if grant.Groupname == "" || grant.Groupname == group {
+					visible = append(visible, group)
+					break
+				}
+			}
+		}
+		groups = visible
+	}
 	return &api.SgroupsResp{Groups: groups}, nil
 }
 
 //get all apps
 func (s *Service) Sapps(ctx context.Context, in *api.SappsReq) (*api.SappsResp, error) {
-	apps, e := s.sconfigDao.MongoGetApps(ctx, in.Groupname)
+	apps, e := s.sconfigDao.GetApps(ctx, in.Groupname)
+	if e != nil {
+		log.Error("[sconfig.Sapps] error:", e)
+		return nil, ecode.ErrSystem
+	}
+	grants, e := s.getGrants(ctx)
 	if e != nil {
 		log.Error("[sconfig.Sapps] error:", e)
 		return nil, ecode.ErrSystem
 	}
+	if grants != nil {
+		//only return the apps the caller can view
+		visible := make([]string, 0, len(apps))
+		for _, app := range apps {
+			if roleOn(grants, in.Groupname, app) >= roleViewer {
+				visible = append(visible, app)
+			}
+		}
+		apps = visible
+	}
 	return &api.SappsResp{Apps: apps}, nil
 }
 
-//get watch addr
-func (s *Service) Swatchaddr(ctx context.Context, in *api.SwatchaddrReq) (*api.SwatchaddrResp, error) {
-	c := config.GetMongoC(s.mongoname)
-	return &api.SwatchaddrResp{
-		Username:       c.Username,
-		Passwd:         c.Passwd,
-		Addrs:          c.Addrs,
-		ReplicaSetName: c.ReplicaSetName,
+//watch one specific app's config
+func (s *Service) Swatch(ctx context.Context, in *api.SwatchReq) (*api.SwatchResp, error) {
+	if e := s.checkRead(ctx, in.Groupname, in.Appname); e != nil {
+		return nil, e
+	}
+	//return a little earlier than the caller's deadline,so the caller will get the unchanged config instead of the timeout error
+	waitctx, cancel := context.WithTimeout(ctx, time.Second*30)
+	defer cancel()
+	if dl, ok := ctx.Deadline(); ok && time.Until(dl) > time.Millisecond*200 {
+		waitctx, cancel = context.WithDeadline(waitctx, dl.Add(-time.Millisecond*100))
+		defer cancel()
+	}
+	//the app which doesn't exist(include only has drafts or deleted) doesn't get a watcher,so the arbitrary names will not create storage watches
+	//the caller still gets op_num 0 with empty config,and the app's existence is checked every second until the wait is done
+	var w *watcher
+	var e error
+	for {
+		w, e = s.hub.acquire(in.Groupname, in.Appname, func() error {
+			if _, _, e := s.sconfigDao.GetInfo(waitctx, in.Groupname, in.Appname); e != nil {
+				if e == sconfigdao.ErrNotExist {
+					return e
+				}
+				log.Error("[sconfig.Swatch] group:", in.Groupname, "app:", in.Appname, "error:", e)
+				return ecode.ErrSystem
+			}
+			return nil
+		})
+		if e != sconfigdao.ErrNotExist {
+			break
+		}
+		if in.OpNum != 0 || in.BaseOpNum != 0 {
+			return &api.SwatchResp{}, nil
+		}
+		select {
+		case <-waitctx.Done():
+			return &api.SwatchResp{}, nil
+		case <-time.After(time.Second):
+		}
+	}
+	if e != nil {
+		return nil, e
+	}
+	defer s.hub.release(in.Groupname, in.Appname, w)
+	sum, conf, canary, base, e := w.get(waitctx, in.OpNum, in.BaseOpNum)
+	if e != nil {
+		log.Error("[sconfig.Swatch] error:", e)
+		return nil, ecode.ErrSystem
+	}
+	resp := &api.SwatchResp{OpNum: sum.OpNum, CurIndex: sum.CurIndex, BaseOpNum: base.OpNum}
+	if canary != nil && inCanary(sum.Canary, in.Instance, in.Labels) {
+		conf = canary
+		resp.CurIndex = canary.Index
+		resp.Canary = true
+	}
+	resp.AppConfig = conf.AppConfig
+	resp.SourceConfig = conf.SourceConfig
+	//the app doesn't exist when the op_num is 0,the base is not delivered alone
+	if sum.OpNum != 0 {
+		if resp.AppConfig, resp.SourceConfig, e = render(base, resp.AppConfig, resp.SourceConfig); e != nil {
+			log.Error("[sconfig.Swatch] group:", in.Groupname, "app:", in.Appname, "merge with base error:", e)
+			return nil, ecode.ErrSystem
+		}
+	}
+	//only the app's own sdk gets the decrypted secrets
+	owner, e := s.isOwner(ctx, in.Groupname, in.Appname)
+	if e != nil {
+		return nil, e
+	}
+	if !owner {
+		resp.AppConfig = maskSecrets(resp.AppConfig)
+		resp.SourceConfig = maskSecrets(resp.SourceConfig)
+	} else if resp.AppConfig, e = s.openSecrets(in.Groupname, in.Appname, resp.AppConfig); e == nil {
+		resp.SourceConfig, e = s.openSecrets(in.Groupname, in.Appname, resp.SourceConfig)
+	}
+	if e != nil {
+		log.Error("[sconfig.Swatch] group:", in.Groupname, "app:", in.Appname, "decrypt error:", e)
+		return nil, ecode.ErrSystem
+	}
+	return resp, nil
+}
+
+//get one specific app's versions,newest first
+func (s *Service) Shistory(ctx context.Context, in *api.ShistoryReq) (*api.ShistoryResp, error) {
+	if e := s.checkRead(ctx, in.Groupname, in.Appname); e != nil {
+		return nil, e
+	}
+	if in.Page == 0 {
+		in.Page = 1
+	}
+	if in.Size == 0 {
+		in.Size = 20
+	} else if in.Size > 100 {
+		in.Size = 100
+	}
+	confs, total, e := s.sconfigDao.GetHistory(ctx, in.Groupname, in.Appname, uint64(in.Page-1)*uint64(in.Size), uint64(in.Size))
+	if e != nil {
+		log.Error("[sconfig.Shistory] error:", e)
+		return nil, ecode.ErrSystem
+	}
+	resp := &api.ShistoryResp{Total: total, Versions: make([]*api.HistoryInfo, 0, len(confs))}
+	for _, conf := range confs {
+		resp.Versions = append(resp.Versions, &api.HistoryInfo{
+			Index:            conf.Index,
+			Ctime:            conf.Ctime,
+			Author:           conf.Author,
+			Comment:          conf.Comment,
+			AppConfigSize:    uint64(len(conf.AppConfig)),
+			SourceConfigSize: uint64(len(conf.SourceConfig)),
+			Draft:            conf.Draft,
+			Tag:              conf.Tag,
+		})
+	}
+	return resp, nil
+}
+
+//get the op records,newest first
+func (s *Service) Saudit(ctx context.Context, in *api.SauditReq) (*api.SauditResp, error) {
+	if e := s.check(ctx, in.Groupname, in.Appname, roleViewer); e != nil {
+		return nil, e
+	}
+	if in.Page == 0 {
+		in.Page = 1
+	}
+	if in.Size == 0 {
+		in.Size = 20
+	} else if in.Size > 100 {
+		in.Size = 100
+	}
+	audits, total, e := s.sconfigDao.GetAudits(ctx, in.Groupname, in.Appname, in.BeginTime, in.EndTime, uint64(in.Page-1)*uint64(in.Size), uint64(in.Size))
+	if e != nil {
+		log.Error("[sconfig.Saudit] error:", e)
+		return nil, ecode.ErrSystem
+	}
+	resp := &api.SauditResp{Total: total, Audits: make([]*api.AuditInfo, 0, len(audits))}
+	for _, audit := range audits {
+		resp.Audits = append(resp.Audits, &api.AuditInfo{
+			Groupname: audit.Groupname,
+			Appname:   audit.Appname,
+			Op:        audit.Op,
+			Caller:    audit.Caller,
+			Ip:        audit.IP,
+			FromIndex: audit.FromIndex,
+			ToIndex:   audit.ToIndex,
+			OpNum:     audit.OpNum,
+			Ctime:     audit.Ctime,
+			Detail:    audit.Detail,
+		})
+	}
+	return resp, nil
+}
+
+//patch one specific app's current config and create a new version
+func (s *Service) Spatch(ctx context.Context, in *api.SpatchReq) (*api.SpatchResp, error) {
+	role := rolePublisher
+	if in.Draft {
+		role = roleEditor
+	}
+	if e := s.check(ctx, in.Groupname, in.Appname, role); e != nil {
+		return nil, e
+	}
+	if (in.PatchType != "merge" && in.PatchType != "json") || (in.AppConfigPatch == "" && in.SourceConfigPatch == "") {
+		return nil, ecode.ErrReq
+	}
+	if e := validGuard(in.Guard); e != nil {
+		return nil, e
+	}
+	schema, e := s.getSchema(ctx, in.Groupname, in.Appname)
+	if e != nil {
+		return nil, e
+	}
+	base, e := s.getBase(ctx, in.Groupname)
+	if e != nil {
+		return nil, e
+	}
+	//the change must be approved before it becomes current
+	if policy, e := s.getPolicy(ctx, in.Groupname); e != nil {
+		return nil, e
+	} else if policy != nil {
+		in.Draft = true
+	}
+	author, _ := s.getCaller(ctx)
+	sum, e := s.sconfigDao.UpdateConfig(ctx, in.Groupname, in.Appname, func(current *sconfigdao.Config) (*sconfigdao.Config, error) {
+		config := &sconfigdao.Config{
+			AppConfig:    "{}",
+			SourceConfig: "{}",
+			Ctime:        uint64(time.Now().Unix()),
+			Author:       author,
+			Comment:      in.Comment,
+			Draft:        in.Draft,
+		}
+		var curapp, cursource string
+		if current != nil {
+			curapp, cursource = current.AppConfig, current.SourceConfig
+			config.AppConfig = current.AppConfig
+			config.SourceConfig = current.SourceConfig
+		}
+		var e error
+		if in.AppConfigPatch != "" {
+			if config.AppConfig, e = applyPatch(config.AppConfig, in.PatchType, in.AppConfigPatch); e != nil {
+				log.Error("[sconfig.Spatch] patch app config error:", e)
+				return nil, ecode.ErrPatch
+			}
+			if len(config.AppConfig) < 2 || config.AppConfig[0] != '{' || config.AppConfig[len(config.AppConfig)-1] != '}' {
+				return nil, ecode.ErrCoinfigFormat
+			}
+		}
+		if in.SourceConfigPatch != "" {
+			if config.SourceConfig, e = applyPatch(config.SourceConfig, in.PatchType, in.SourceConfigPatch); e != nil {
+				log.Error("[sconfig.Spatch] patch source config error:", e)
+				return nil, ecode.ErrPatch
+			}
+			if len(config.SourceConfig) < 2 || config.SourceConfig[0] != '{' || config.SourceConfig[len(config.SourceConfig)-1] != '}' {
+				return nil, ecode.ErrCoinfigFormat
+			}
+		}
+		//the patch may contain the redacted values got from sinfo or sget
+		if config.AppConfig, e = s.unredactConfig(in.Groupname, in.Appname, config.AppConfig, curapp); e != nil {
+			return nil, e
+		}
+		if config.SourceConfig, e = s.unredactConfig(in.Groupname, in.Appname, config.SourceConfig, cursource); e != nil {
+			return nil, e
+		}
+		if config.AppConfig, e = s.sealSecrets(in.Groupname, in.Appname, config.AppConfig, current); e != nil {
+			return nil, e
+		}
+		if config.SourceConfig, e = s.sealSecrets(in.Groupname, in.Appname, config.SourceConfig, current); e != nil {
+			return nil, e
+		}
+		if e = s.checkConfig(schema, base, in.Groupname, in.Appname, config.AppConfig, config.SourceConfig); e != nil {
+			return nil, e
+		}
+		return config, nil
+	}, in.ExpectedOpNum)
+	if e != nil {
+		if _, ok := e.(*cerror.Error); ok {
+			return nil, e
+		}
+		log.Error("[sconfig.Spatch] error:", e)
+		if e == sconfigdao.ErrOpNumConflict {
+			return nil, ecode.ErrVersionConflict
+		}
+		if e == sconfigdao.ErrDeleted {
+			return nil, ecode.ErrDeleted
+		}
+		return nil, ecode.ErrSystem
+	}
+	if in.Draft {
+		s.audit(ctx, in.Groupname, in.Appname, "patch_draft", sum.CurIndex, sum.MaxIndex, sum.OpNum)
+	} else {
+		s.audit(ctx, in.Groupname, in.Appname, "patch", sum.PrevIndex, sum.CurIndex, sum.OpNum)
+		s.setGuard(ctx, in.Groupname, in.Appname, in.Guard, sum)
+	}
+	return &api.SpatchResp{CurIndex: sum.CurIndex, MaxIndex: sum.MaxIndex, OpNum: sum.OpNum}, nil
+}
+
+//set one specific app's json schema,this will create a new schema version
+func (s *Service) SschemaSet(ctx context.Context, in *api.SschemaSetReq) (*api.SschemaSetResp, error) {
+	if e := s.check(ctx, in.Groupname, in.Appname, roleEditor); e != nil {
+		return nil, e
+	}
+	if _, e := compileSchema(in.AppSchema); e != nil {
+		log.Error("[sconfig.SschemaSet] compile app schema error:", e)
+		return nil, ecode.ErrSchemaFormat
+	}
+	if _, e := compileSchema(in.SourceSchema); e != nil {
+		log.Error("[sconfig.SschemaSet] compile source schema error:", e)
+		return nil, ecode.ErrSchemaFormat
+	}
+	author, _ := s.getCaller(ctx)
+	schema := &sconfigdao.Schema{
+		AppSchema:    in.AppSchema,
+		SourceSchema: in.SourceSchema,
+		Ctime:        uint64(time.Now().Unix()),
+		Author:       author,
+	}
+	if e := s.sconfigDao.SetSchema(ctx, in.Groupname, in.Appname, schema); e != nil {
+		log.Error("[sconfig.SschemaSet] error:", e)
+		return nil, ecode.ErrSystem
+	}
+	return &api.SschemaSetResp{Index: schema.Index}, nil
+}
+
+//get one specific app's json schema
+func (s *Service) SschemaGet(ctx context.Context, in *api.SschemaGetReq) (*api.SschemaGetResp, error) {
+	if e := s.check(ctx, in.Groupname, in.Appname, roleViewer); e != nil {
+		return nil, e
+	}
+	schema, e := s.sconfigDao.GetSchema(ctx, in.Groupname, in.Appname, in.Index)
+	if e != nil {
+		log.Error("[sconfig.SschemaGet] error:", e)
+		if e == sconfigdao.ErrNotExist {
+			return nil, ecode.ErrNotExist
+		}
+		return nil, ecode.ErrSystem
+	}
+	return &api.SschemaGetResp{
+		Index:        schema.Index,
+		AppSchema:    schema.AppSchema,
+		SourceSchema: schema.SourceSchema,
+		Ctime:        schema.Ctime,
+		Author:       schema.Author,
 	}, nil
 }
 
+//diff one specific app's two config versions
+func (s *Service) Sdiff(ctx context.Context, in *api.SdiffReq) (*api.SdiffResp, error) {
+	if e := s.checkRead(ctx, in.Groupname, in.Appname); e != nil {
+		return nil, e
+	}
+	reveal, e := s.canReveal(ctx, in.Groupname, in.Appname)
+	if e != nil {
+		log.Error("[sconfig.Sdiff] error:", e)
+		return nil, ecode.ErrSystem
+	}
+	var to *sconfigdao.Config
+	if in.ToIndex == 0 {
+		_, to, e = s.sconfigDao.GetInfo(ctx, in.Groupname, in.Appname)
+	} else {
+		to, e = s.sconfigDao.GetConfig(ctx, in.Groupname, in.Appname, in.ToIndex)
+	}
+	if e != nil {
+		log.Error("[sconfig.Sdiff] get to config error:", e)
+		if e == sconfigdao.ErrNotExist {
+			return nil, ecode.ErrNotExist
+		}
+		return nil, ecode.ErrSystem
+	}
+	from, e := s.sconfigDao.GetConfig(ctx, in.Groupname, in.Appname, in.FromIndex)
+	if e != nil {
+		log.Error("[sconfig.Sdiff] get from config error:", e)
+		if e == sconfigdao.ErrNotExist {
+			return nil, ecode.ErrNotExist
+		}
+		return nil, ecode.ErrSystem
+	}
+	resp := &api.SdiffResp{FromIndex: from.Index, ToIndex: to.Index}
+	//the secrets and the redacted values are diffed by their ids and fingerprints
+	if resp.AppConfig, e = jsonDiff(s.showConfig(in.Groupname, in.Appname, from.AppConfig, reveal), s.showConfig(in.Groupname, in.Appname, to.AppConfig, reveal)); e != nil {
+		log.Error("[sconfig.Sdiff] diff app config error:", e)
+		return nil, ecode.ErrSystem
+	}
+	if resp.SourceConfig, e = jsonDiff(s.showConfig(in.Groupname, in.Appname, from.SourceConfig, reveal), s.showConfig(in.Groupname, in.Appname, to.SourceConfig, reveal)); e != nil {
+		log.Error("[sconfig.Sdiff] diff source config error:", e)
+		return nil, ecode.ErrSystem
+	}
+	return resp, nil
+}
+
+//make one specific app's version current,usually used to publish a draft
+func (s *Service) Spublish(ctx context.Context, in *api.SpublishReq) (*api.SpublishResp, error) {
+	if e := s.check(ctx, in.Groupname, in.Appname, rolePublisher); e != nil {
+		return nil, e
+	}
+	if e := validGuard(in.Guard); e != nil {
+		return nil, e
+	}
+	sum, e := s.publish(ctx, in.Groupname, in.Appname, in.Index, in.ExpectedOpNum)
+	if e != nil {
+		return nil, e
+	}
+	s.audit(ctx, in.Groupname, in.Appname, "publish", sum.PrevIndex, sum.CurIndex, sum.OpNum)
+	s.setGuard(ctx, in.Groupname, in.Appname, in.Guard, sum)
+	return &api.SpublishResp{CurIndex: sum.CurIndex, MaxIndex: sum.MaxIndex, OpNum: sum.OpNum}, nil
+}
+
+//publish is shared by the api and the schedule
+func (s *Service) publish(ctx context.Context, groupname, appname string, index, expectopnum uint64) (*sconfigdao.Summary, error) {
+	schema, e := s.getSchema(ctx, groupname, appname)
+	if e != nil {
+		return nil, e
+	}
+	base, e := s.getBase(ctx, groupname)
+	if e != nil {
+		return nil, e
+	}
+	conf, e := s.sconfigDao.GetConfig(ctx, groupname, appname, index)
+	if e != nil {
+		log.Error("[sconfig.publish] get config error:", e)
+		if e == sconfigdao.ErrNotExist {
+			return nil, ecode.ErrNotExist
+		}
+		return nil, ecode.ErrSystem
+	}
+	if conf.Draft {
+		if e = s.checkApproval(ctx, groupname, appname, index); e != nil {
+			return nil, e
+		}
+	}
+	//the schema may be changed after the draft was saved
+	if e = s.checkConfig(schema, base, groupname, appname, conf.AppConfig, conf.SourceConfig); e != nil {
+		return nil, e
+	}
+	sum, e := s.sconfigDao.PublishConfig(ctx, groupname, appname, index, expectopnum)
+	if e != nil {
+		log.Error("[sconfig.publish] error:", e)
+		if e == sconfigdao.ErrNotExist {
+			return nil, ecode.ErrNotExist
+		}
+		if e == sconfigdao.ErrOpNumConflict {
+			return nil, ecode.ErrVersionConflict
+		}
+		return nil, ecode.ErrSystem
+	}
+	return sum, nil
+}
+
+//schedule one specific app's version to become current at the given time
+func (s *Service) Sschedule(ctx context.Context, in *api.SscheduleReq) (*api.SscheduleResp, error) {
+	if e := s.check(ctx, in.Groupname, in.Appname, rolePublisher); e != nil {
+		return nil, e
+	}
+	firetime, e := time.Parse(time.RFC3339, in.FireTime)
+	if e != nil || !firetime.After(time.Now()) {
+		return nil, ecode.ErrReq
+	}
+	if _, e = s.sconfigDao.GetConfig(ctx, in.Groupname, in.Appname, in.Index); e != nil {
+		log.Error("[sconfig.Sschedule] get config error:", e)
+		if e == sconfigdao.ErrNotExist {
+			return nil, ecode.ErrNotExist
+		}
+		return nil, ecode.ErrSystem
+	}
+	author, _ := s.getCaller(ctx)
+	schedule := &sconfigdao.Schedule{
+		Groupname: in.Groupname,
+		Appname:   in.Appname,
+		Index:     in.Index,
+		FireTime:  uint64(firetime.Unix()),
+		Status:    sconfigdao.SchedulePending,
+		Ctime:     uint64(time.Now().Unix()),
+		Author:    author,
+	}
+	if e = s.sconfigDao.AddSchedule(ctx, schedule); e != nil {
+		log.Error("[sconfig.Sschedule] error:", e)
+		return nil, ecode.ErrSystem
+	}
+	s.audit(ctx, in.Groupname, in.Appname, "schedule", 0, in.Index, 0)
+	return &api.SscheduleResp{Id: schedule.ID, FireTime: schedule.FireTime}, nil
+}
+
+//get the pending schedules,sorted by fire_time asc
+func (s *Service) SscheduleList(ctx context.Context, in *api.SscheduleListReq) (*api.SscheduleListResp, error) {
+	if e := s.check(ctx, in.Groupname, in.Appname, roleViewer); e != nil {
+		return nil, e
+	}
+	schedules, e := s.sconfigDao.GetSchedules(ctx, in.Groupname, in.Appname)
+	if e != nil {
+		log.Error("[sconfig.SscheduleList] error:", e)
+		return nil, ecode.ErrSystem
+	}
+	resp := &api.SscheduleListResp{Schedules: make([]*api.ScheduleInfo, 0, len(schedules))}
+	for _, schedule := range schedules {
+		resp.Schedules = append(resp.Schedules, &api.ScheduleInfo{
+			Id:       schedule.ID,
+			Appname:  schedule.Appname,
+			Index:    schedule.Index,
+			FireTime: schedule.FireTime,
+			Ctime:    schedule.Ctime,
+			Author:   schedule.Author,
+		})
+	}
+	return resp, nil
+}
+
+//cancel one pending schedule
+func (s *Service) SscheduleCancel(ctx context.Context, in *api.SscheduleCancelReq) (*api.SscheduleCancelResp, error) {
+	if e := s.check(ctx, in.Groupname, in.Appname, rolePublisher); e != nil {
+		return nil, e
+	}
+	schedule, e := s.sconfigDao.UpdateSchedule(ctx, in.Groupname, in.Appname, in.Id, sconfigdao.SchedulePending, sconfigdao.ScheduleCanceled, "")
+	if e != nil {
+		log.Error("[sconfig.SscheduleCancel] error:", e)
+		if e == sconfigdao.ErrNotExist {
+			return nil, ecode.ErrNotExist
+		}
+		return nil, ecode.ErrSystem
+	}
+	s.audit(ctx, in.Groupname, in.Appname, "schedule_cancel", 0, schedule.Index, 0)
+	return &api.SscheduleCancelResp{}, nil
+}
+
+//deliver one specific app's version to part of the instances
+func (s *Service) Scanary(ctx context.Context, in *api.ScanaryReq) (*api.ScanaryResp, error) {
+	if e := s.check(ctx, in.Groupname, in.Appname, rolePublisher); e != nil {
+		return nil, e
+	}
+	if in.Percent > 100 || (in.Percent == 0 && len(in.Hostnames) == 0 && len(in.Labels) == 0) {
+		return nil, ecode.ErrReq
+	}
+	schema, e := s.getSchema(ctx, in.Groupname, in.Appname)
+	if e != nil {
+		return nil, e
+	}
+	base, e := s.getBase(ctx, in.Groupname)
+	if e != nil {
+		return nil, e
+	}
+	conf, e := s.sconfigDao.GetConfig(ctx, in.Groupname, in.Appname, in.Index)
+	if e != nil {
+		log.Error("[sconfig.Scanary] get config error:", e)
+		if e == sconfigdao.ErrNotExist {
+			return nil, ecode.ErrNotExist
+		}
+		return nil, ecode.ErrSystem
+	}
+	//canary is also live,so it needs the same check as publish
+	if conf.Draft {
+		if e = s.checkApproval(ctx, in.Groupname, in.Appname, in.Index); e != nil {
+			return nil, e
+		}
+	}
+	if e = s.checkConfig(schema, base, in.Groupname, in.Appname, conf.AppConfig, conf.SourceConfig); e != nil {
+		return nil, e
+	}
+	author, _ := s.getCaller(ctx)
+	sum, e := s.sconfigDao.SetCanary(ctx, in.Groupname, in.Appname, &sconfigdao.Canary{
+		Index:     in.Index,
+		Percent:   in.Percent,
+		Hostnames: in.Hostnames,
+		Labels:    in.Labels,
+		Ctime:     uint64(time.Now().Unix()),
+		Author:    author,
+	}, in.ExpectedOpNum)
+	if e != nil {
+		log.Error("[sconfig.Scanary] error:", e)
+		if e == sconfigdao.ErrNotExist {
+			return nil, ecode.ErrNotExist
+		}
+		if e == sconfigdao.ErrOpNumConflict {
+			return nil, ecode.ErrVersionConflict
+		}
+		return nil, ecode.ErrSystem
+	}
+	s.audit(ctx, in.Groupname, in.Appname, "canary", sum.CurIndex, in.Index, sum.OpNum)
+	return &api.ScanaryResp{OpNum: sum.OpNum}, nil
+}
+
+//make the canary version current for all instances
+func (s *Service) ScanaryPromote(ctx context.Context, in *api.ScanaryPromoteReq) (*api.ScanaryPromoteResp, error) {
+	if e := s.check(ctx, in.Groupname, in.Appname, rolePublisher); e != nil {
+		return nil, e
+	}
+	cur, _, e := s.sconfigDao.GetInfo(ctx, in.Groupname, in.Appname)
+	if e != nil {
+		log.Error("[sconfig.ScanaryPromote] get info error:", e)
+		if e == sconfigdao.ErrNotExist {
+			return nil, ecode.ErrNotExist
+		}
+		return nil, ecode.ErrSystem
+	}
+	if cur.Canary == nil {
+		return nil, ecode.ErrNotExist
+	}
+	//publish will clear the canary
+	sum, e := s.publish(ctx, in.Groupname, in.Appname, cur.Canary.Index, in.ExpectedOpNum)
+	if e != nil {
+		return nil, e
+	}
+	s.audit(ctx, in.Groupname, in.Appname, "canary_promote", sum.PrevIndex, sum.CurIndex, sum.OpNum)
+	return &api.ScanaryPromoteResp{CurIndex: sum.CurIndex, MaxIndex: sum.MaxIndex, OpNum: sum.OpNum}, nil
+}
+
+//stop the canary,all instances will use the current version
+func (s *Service) ScanaryAbort(ctx context.Context, in *api.ScanaryAbortReq) (*api.ScanaryAbortResp, error) {
+	if e := s.check(ctx, in.Groupname, in.Appname, rolePublisher); e != nil {
+		return nil, e
+	}
+	cur, _, e := s.sconfigDao.GetInfo(ctx, in.Groupname, in.Appname)
+	if e != nil {
+		log.Error("[sconfig.ScanaryAbort] get info error:", e)
+		if e == sconfigdao.ErrNotExist {
+			return nil, ecode.ErrNotExist
+		}
+		return nil, ecode.ErrSystem
+	}
+	if cur.Canary == nil {
+		return nil, ecode.ErrNotExist
+	}
+	sum, e := s.sconfigDao.SetCanary(ctx, in.Groupname, in.Appname, nil, in.ExpectedOpNum)
+	if e != nil {
+		log.Error("[sconfig.ScanaryAbort] error:", e)
+		if e == sconfigdao.ErrNotExist {
+			return nil, ecode.ErrNotExist
+		}
+		if e == sconfigdao.ErrOpNumConflict {
+			return nil, ecode.ErrVersionConflict
+		}
+		return nil, ecode.ErrSystem
+	}
+	s.audit(ctx, in.Groupname, in.Appname, "canary_abort", cur.Canary.Index, sum.CurIndex, sum.OpNum)
+	return &api.ScanaryAbortResp{OpNum: sum.OpNum}, nil
+}
+
+//the sdk reports the version it applied
+func (s *Service) Sreport(ctx context.Context, in *api.SreportReq) (*api.SreportResp, error) {
+	if e := s.checkRead(ctx, in.Groupname, in.Appname); e != nil {
+		return nil, e
+	}
+	//the reports are keyed by the instance
+	if in.Instance == "" {
+		return nil, ecode.ErrReq
+	}
+	if e := s.sconfigDao.SetReport(ctx, &sconfigdao.Report{
+		Groupname: in.Groupname,
+		Appname:   in.Appname,
+		Instance:  in.Instance,
+		OpNum:     in.OpNum,
+		Index:     in.Index,
+		Canary:    in.Canary,
+		Error:     in.Error,
+		Health:    in.Health,
+		Utime:     uint64(time.Now().Unix()),
+	}); e != nil {
+		log.Error("[sconfig.Sreport] error:", e)
+		return nil, ecode.ErrSystem
+	}
+	s.guardCheck(ctx, in.Groupname, in.Appname)
+	return &api.SreportResp{}, nil
+}
+
+//get the applied version of every instance
+func (s *Service) Srollout(ctx context.Context, in *api.SrolloutReq) (*api.SrolloutResp, error) {
+	if e := s.check(ctx, in.Groupname, in.Appname, roleViewer); e != nil {
+		return nil, e
+	}
+	sum, _, e := s.sconfigDao.GetInfo(ctx, in.Groupname, in.Appname)
+	if e != nil {
+		log.Error("[sconfig.Srollout] get info error:", e)
+		if e == sconfigdao.ErrNotExist {
+			return nil, ecode.ErrNotExist
+		}
+		return nil, ecode.ErrSystem
+	}
+	reports, e := s.sconfigDao.GetReports(ctx, in.Groupname, in.Appname)
+	if e != nil {
+		log.Error("[sconfig.Srollout] error:", e)
+		return nil, ecode.ErrSystem
+	}
+	resp := &api.SrolloutResp{CurIndex: sum.CurIndex, OpNum: sum.OpNum, Instances: make([]*api.RolloutInfo, 0, len(reports))}
+	if sum.Canary != nil {
+		resp.CanaryIndex = sum.Canary.Index
+	}
+	for _, report := range reports {
+		if report.Error != "" || report.Health != "" {
+			resp.Failed++
+		} else if report.OpNum == sum.OpNum {
+			resp.Applied++
+		} else {
+			resp.Stale++
+		}
+		resp.Instances = append(resp.Instances, &api.RolloutInfo{
+			Instance: report.Instance,
+			OpNum:    report.OpNum,
+			Index:    report.Index,
+			Canary:   report.Canary,
+			Error:    report.Error,
+			Utime:    report.Utime,
+			Health:   report.Health,
+		})
+	}
+	return resp, nil
+}
+
+//set one specific group's approval policy
+func (s *Service) SpolicySet(ctx context.Context, in *api.SpolicySetReq) (*api.SpolicySetResp, error) {
+	if e := s.check(ctx, in.Groupname, "", roleAdmin); e != nil {
+		return nil, e
+	}
+	reviewers := make([]string, 0, len(in.Reviewers))
+	for _, reviewer := range in.Reviewers {
+		if reviewer == "" {
+			continue
+		}
+		dup := false
+		for _, v := range reviewers {
+			if v == reviewer {
+				dup = true
+				break
+			}
+		}
+		if !dup {
+			reviewers = append(reviewers, reviewer)
+		}
+	}
+	if int(in.Approvals) > len(reviewers) {
+		return nil, ecode.ErrReq
+	}
+	//the reviewers are principals,there is no principal when the rbac is disabled,so the drafts could never be approved
+	if in.Approvals > 0 && s.root == "" {
+		return nil, ecode.ErrAuth
+	}
+	author, _ := s.getCaller(ctx)
+	if e := s.sconfigDao.SetPolicy(ctx, &sconfigdao.Policy{
+		Groupname: in.Groupname,
+		Reviewers: reviewers,
+		Approvals: in.Approvals,
+		Ctime:     uint64(time.Now().Unix()),
+		Author:    author,
+	}); e != nil {
+		log.Error("[sconfig.SpolicySet] error:", e)
+		return nil, ecode.ErrSystem
+	}
+	s.audit(ctx, in.Groupname, "", "policy", 0, 0, 0)
+	return &api.SpolicySetResp{}, nil
+}
+
+//get one specific group's approval policy
+func (s *Service) SpolicyGet(ctx context.Context, in *api.SpolicyGetReq) (*api.SpolicyGetResp, error) {
+	if e := s.check(ctx, in.Groupname, "", roleViewer); e != nil {
+		return nil, e
+	}
+	policy, e := s.sconfigDao.GetPolicy(ctx, in.Groupname)
+	if e != nil {
+		if e == sconfigdao.ErrNotExist {
+			return &api.SpolicyGetResp{}, nil
+		}
+		log.Error("[sconfig.SpolicyGet] error:", e)
+		return nil, ecode.ErrSystem
+	}
+	return &api.SpolicyGetResp{Reviewers: policy.Reviewers, Approvals: policy.Approvals, Ctime: policy.Ctime, Author: policy.Author}, nil
+}
+
+//approve one specific app's draft
+func (s *Service) Sapprove(ctx context.Context, in *api.SapproveReq) (*api.SapproveResp, error) {
+	if e := s.check(ctx, in.Groupname, in.Appname, roleViewer); e != nil {
+		return nil, e
+	}
+	approvals, required, e := s.review(ctx, in.Groupname, in.Appname, in.Index, in.Comment, true)
+	if e != nil {
+		return nil, e
+	}
+	return &api.SapproveResp{Approvals: approvals, Required: required}, nil
+}
+
+//reject one specific app's draft
+func (s *Service) Sreject(ctx context.Context, in *api.SrejectReq) (*api.SrejectResp, error) {
+	if e := s.check(ctx, in.Groupname, in.Appname, roleViewer); e != nil {
+		return nil, e
+	}
+	if _, _, e := s.review(ctx, in.Groupname, in.Appname, in.Index, in.Comment, false); e != nil {
+		return nil, e
+	}
+	return &api.SrejectResp{}, nil
+}
+
+//get the drafts waiting for approval,newest first
+func (s *Service) Spending(ctx context.Context, in *api.SpendingReq) (*api.SpendingResp, error) {
+	if e := s.check(ctx, in.Groupname, in.Appname, roleViewer); e != nil {
+		return nil, e
+	}
+	policy, e := s.getPolicy(ctx, in.Groupname)
+	if e != nil {
+		return nil, e
+	}
+	apps := []string{in.Appname}
+	if in.Appname == "" {
+		if apps, e = s.sconfigDao.GetApps(ctx, in.Groupname); e != nil {
+			log.Error("[sconfig.Spending] get apps error:", e)
+			return nil, ecode.ErrSystem
+		}
+	}
+	resp := &api.SpendingResp{Changes: make([]*api.PendingInfo, 0)}
+	for _, app := range apps {
+		drafts, e := s.sconfigDao.GetDrafts(ctx, in.Groupname, app)
+		if e != nil {
+			log.Error("[sconfig.Spending] app:", app, "get drafts error:", e)
+			return nil, ecode.ErrSystem
+		}
+		for _, draft := range drafts {
+			info := &api.PendingInfo{
+				Appname: app,
+				Index:   draft.Index,
+				Ctime:   draft.Ctime,
+				Author:  draft.Author,
+				Comment: draft.Comment,
+			}
+			if policy != nil {
+				approvals, e := s.sconfigDao.GetApprovals(ctx, in.Groupname, app, draft.Index)
+				if e != nil {
+					log.Error("[sconfig.Spending] app:", app, "index:", draft.Index, "get approvals error:", e)
+					return nil, ecode.ErrSystem
+				}
+				approved, rejected := approvers(policy, approvals)
+				if rejected {
+					continue
+				}
+				info.Approvers = approved
+				info.Required = policy.Approvals
+			}
+			resp.Changes = append(resp.Changes, info)
+		}
+	}
+	sort.SliceStable(resp.Changes, func(i, j int) bool { return resp.Changes[i].Ctime > resp.Changes[j].Ctime })
+	return resp, nil
+}
+
+//audit record the op,opnum is the op_num after this op
+//the op is already done,so the failure here will only be logged
+func (s *Service) audit(ctx context.Context, groupname, appname, op string, from, to, opnum uint64) {
+	caller, ip := s.getCaller(ctx)
+	s.auditAs(ctx, caller, ip, groupname, appname, op, from, to, opnum, "")
+}
+
+//auditAs is used by the background jobs,which don't have the caller in the ctx
+//detail is the reason why the background job did this op
+func (s *Service) auditAs(ctx context.Context, caller, ip, groupname, appname, op string, from, to, opnum uint64, detail string) {
+	if e := s.sconfigDao.AddAudit(ctx, &sconfigdao.Audit{
+		Groupname: groupname,
+		Appname:   appname,
+		Op:        op,
+		Caller:    caller,
+		IP:        ip,
+		FromIndex: from,
+		ToIndex:   to,
+		OpNum:     opnum,
+		Ctime:     uint64(time.Now().Unix()),
+		Detail:    detail,
+	}); e != nil {
+		log.Error("[sconfig.audit] group:", groupname, "app:", appname, "op:", op, "from:", from, "to:", to, "error:", e)
+	}
+}
+
 //Stop -
 func (s *Service) Stop() {
-
+	s.cancel()
+	s.hub.stop()
 }
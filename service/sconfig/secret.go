@@ -0,0 +1,325 @@
+package sconfig
+
+import (
+	"bufio"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"os"
+	"strings"
+
+	sconfigdao "github.com/chenjie199234/Config/dao/sconfig"
+	"github.com/chenjie199234/Config/ecode"
+
+	"github.com/chenjie199234/Corelib/log"
+)
+
+//the secret is set as {"$secret":<any json value>} in sset and spatch
+//it is stored as {"$encrypted":"v1:<key id>:<encrypted data key>:<encrypted value>"} with envelope encryption:
+//every secret has it's own random data key,the data key is encrypted by the key from the key file
+//the value is encrypted with the groupname and appname as the additional data,so it can't be copied to other apps
+//the group's base config's secrets are encrypted with empty appname,so every app in the group can decrypt them
+//only the app's sdk gets the decrypted value,others get {"$secret":"******","$id":"<digest of the encrypted value>"}
+//the masked secret can be set back,it will be replaced by the encrypted value with the same $id in the current version
+const secretMask = "******"
+
+type secretKey struct {
+	id   string
+	aead cipher.AEAD
+}
+
+type keyring struct {
+	current *secretKey            //used to encrypt the new data keys
+	keys    map[string]*secretKey //all keys can be used to decrypt,key id
+	mackey  []byte                //derived from the current key,used by the redacted value's fingerprint
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, e := aes.NewCipher(key)
+	if e != nil {
+		return nil, e
+	}
+	return cipher.NewGCM(block)
+}
+
+//loadKeyring load the keys from the key file,every line is a base64 std encoded 32 bytes key
+//the first key is used to encrypt,the others are only used to decrypt,so the keys can be rotated
+func loadKeyring(path string) (*keyring, error) {
+	file, e := os.Open(path)
+	if e != nil {
+		return nil, e
+	}
+	defer file.Close()
+	k := &keyring{keys: make(map[string]*secretKey)}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		key, e := base64.StdEncoding.DecodeString(line)
+		if e != nil || len(key) != 32 {
+			return nil, errors.New("key must be base64 std encoded 32 bytes")
+		}
+		aead, e := newAEAD(key)
+		if e != nil {
+			return nil, e
+		}
+		hash := sha256.Sum256(key)
+		sk := &secretKey{id: hex.EncodeToString(hash[:4]), aead: aead}
+		if k.current == nil {
+			k.current = sk
+			mac := hmac.New(sha256.New, key)
+			mac.Write([]byte("redact"))
+			k.mackey = mac.Sum(nil)
+		}
+		k.keys[sk.id] = sk
+	}
+	if e := scanner.Err(); e != nil {
+		return nil, e
+	}
+	if k.current == nil {
+		return nil, errors.New("missing key")
+	}
+	return k, nil
+}
+
+func seal(aead cipher.AEAD, plaintext, additional []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, e := rand.Read(nonce); e != nil {
+		return nil, e
+	}
+	return aead.Seal(nonce, nonce, plaintext, additional), nil
+}
+
+func open(aead cipher.AEAD, ciphertext, additional []byte) ([]byte, error) {
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, errors.New("ciphertext too short")
+	}
+	return aead.Open(nil, ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():], additional)
+}
+
+func (k *keyring) encrypt(groupname, appname string, value []byte) (string, error) {
+	datakey := make([]byte, 32)
+	if _, e := rand.Read(datakey); e != nil {
+		return "", e
+	}
+	aead, e := newAEAD(datakey)
+	if e != nil {
+		return "", e
+	}
+	data, e := seal(aead, value, []byte(groupname+"/"+appname))
+	if e != nil {
+		return "", e
+	}
+	wrapped, e := seal(k.current.aead, datakey, nil)
+	if e != nil {
+		return "", e
+	}
+	return "v1:" + k.current.id + ":" + base64.StdEncoding.EncodeToString(wrapped) + ":" + base64.StdEncoding.EncodeToString(data), nil
+}
+
+func (k *keyring) decrypt(groupname, appname, envelope string) ([]byte, error) {
+	parts := strings.Split(envelope, ":")
+	if len(parts) != 4 || parts[0] != "v1" {
+		return nil, errors.New("unknown encrypted format")
+	}
+	key, ok := k.keys[parts[1]]
+	if !ok {
+		return nil, errors.New("key " + parts[1] + " doesn't exist in the key file")
+	}
+	wrapped, e := base64.StdEncoding.DecodeString(parts[2])
+	if e != nil {
+		return nil, e
+	}
+	data, e := base64.StdEncoding.DecodeString(parts[3])
+	if e != nil {
+		return nil, e
+	}
+	datakey, e := open(key.aead, wrapped, nil)
+	if e != nil {
+		return nil, e
+	}
+	aead, e := newAEAD(datakey)
+	if e != nil {
+		return nil, e
+	}
+	return open(aead, data, []byte(groupname+"/"+appname))
+}
+
+func secretID(envelope string) string {
+	hash := sha256.Sum256([]byte(envelope))
+	return hex.EncodeToString(hash[:4])
+}
+
+func hasSecret(doc string) bool {
+	return strings.Contains(doc, `"$secret"`) || strings.Contains(doc, `"$encrypted"`)
+}
+
+//walkSecrets replace every secret object(the object has $secret or $encrypted key) in v by f's result
+func walkSecrets(v interface{}, f func(map[string]interface{}) (interface{}, error)) (interface{}, error) {
+	switch x := v.(type) {
+	case map[string]interface{}:
+		_, plain := x["$secret"]
+		_, encrypted := x["$encrypted"]
+		if plain || encrypted {
+			return f(x)
+		}
+		for k, sub := range x {
+			r, e := walkSecrets(sub, f)
+			if e != nil {
+				return nil, e
+			}
+			x[k] = r
+		}
+	case []interface{}:
+		for i, sub := range x {
+			r, e := walkSecrets(sub, f)
+			if e != nil {
+				return nil, e
+			}
+			x[i] = r
+		}
+	}
+	return v, nil
+}
+
+//transformSecrets return the doc as it is when it doesn't have any secret,so the normal config's format is kept
+func transformSecrets(doc string, f func(map[string]interface{}) (interface{}, error)) (string, error) {
+	if !hasSecret(doc) {
+		return doc, nil
+	}
+	v, e := decodeJSON(doc)
+	if e != nil {
+		return "", e
+	}
+	if v, e = walkSecrets(v, f); e != nil {
+		return "", e
+	}
+	return encodeJSON(v), nil
+}
+
+//maskSecrets replace the encrypted values with the mask
+func maskSecrets(doc string) string {
+	result, e := transformSecrets(doc, func(obj map[string]interface{}) (interface{}, error) {
+		if envelope, ok := obj["$encrypted"].(string); ok {
+			return map[string]interface{}{"$secret": secretMask, "$id": secretID(envelope)}, nil
+		}
+		return obj, nil
+	})
+	if e != nil {
+		//the stored config is always a valid json,this should not happen
+		return doc
+	}
+	return result
+}
+
+//openSecrets replace the encrypted values with the decrypted values
+func (s *Service) openSecrets(groupname, appname, doc string) (string, error) {
+	return transformSecrets(doc, func(obj map[string]interface{}) (interface{}, error) {
+		envelope, ok := obj["$encrypted"].(string)
+		if !ok {
+			return obj, nil
+		}
+		if s.secret == nil {
+			return nil, errors.New("missing key file")
+		}
+		value, e := s.secret.decrypt(groupname, appname, envelope)
+		if e != nil && appname != "" {
+			//inherited from the group's base
+			value, e = s.secret.decrypt(groupname, "", envelope)
+		}
+		if e != nil {
+			return nil, e
+		}
+		return decodeJSON(string(value))
+	})
+}
+
+//sealSecrets encrypt the new secrets and set back the masked secrets from the current version
+//current can be nil,then the masked secrets can't be set back
+func (s *Service) sealSecrets(groupname, appname, doc string, current *sconfigdao.Config) (string, error) {
+	var envelopes map[string]string
+	result, e := transformSecrets(doc, func(obj map[string]interface{}) (interface{}, error) {
+		if s.secret == nil {
+			return nil, ecode.ErrSecret
+		}
+		value, plain := obj["$secret"]
+		if !plain {
+			//copied from the stored config,make sure it belongs to this app
+			envelope, ok := obj["$encrypted"].(string)
+			if !ok || len(obj) != 1 {
+				return nil, ecode.ErrSecret
+			}
+			if _, e := s.secret.decrypt(groupname, appname, envelope); e != nil {
+				return nil, ecode.ErrSecret
+			}
+			return obj, nil
+		}
+		//the merge patch puts the new secret into the stored one,the old encrypted value is replaced
+		delete(obj, "$encrypted")
+		if id, ok := obj["$id"].(string); ok && value == secretMask && len(obj) == 2 {
+			if envelopes == nil {
+				envelopes = make(map[string]string)
+				if current != nil {
+					collectEnvelopes(current.AppConfig, envelopes)
+					collectEnvelopes(current.SourceConfig, envelopes)
+				}
+			}
+			envelope, ok := envelopes[id]
+			if !ok {
+				return nil, ecode.ErrSecret
+			}
+			return map[string]interface{}{"$encrypted": envelope}, nil
+		}
+		if len(obj) != 1 {
+			return nil, ecode.ErrSecret
+		}
+		envelope, e := s.secret.encrypt(groupname, appname, []byte(encodeJSON(value)))
+		if e != nil {
+			log.Error("[sconfig.sealSecrets] group:", groupname, "app:", appname, "encrypt error:", e)
+			return nil, ecode.ErrSystem
+		}
+		return map[string]interface{}{"$encrypted": envelope}, nil
+	})
+	if e != nil {
+		if e == ecode.ErrSecret || e == ecode.ErrSystem {
+			return "", e
+		}
+		return "", ecode.ErrCoinfigFormat
+	}
+	return result, nil
+}
+
+//collectEnvelopes collect the encrypted values in the doc,key is the $id in the masked secret
+func collectEnvelopes(doc string, envelopes map[string]string) {
+	transformSecrets(doc, func(obj map[string]interface{}) (interface{}, error) {
+		if envelope, ok := obj["$encrypted"].(string); ok {
+			envelopes[secretID(envelope)] = envelope
+		}
+		return obj, nil
+	})
+}
+
+//checkConfig merge the configs into the group's base,decrypt the secrets and check them with the built-in layout and the schema
+//base can be nil
+func (s *Service) checkConfig(schema *compiledSchema, base *sconfigdao.Base, groupname, appname, appconfig, sourceconfig string) error {
+	appconfig, sourceconfig, e := render(base, appconfig, sourceconfig)
+	if e != nil {
+		log.Error("[sconfig.checkConfig] group:", groupname, "app:", appname, "merge with base error:", e)
+		return ecode.ErrSystem
+	}
+	if appconfig, e = s.openSecrets(groupname, appname, appconfig); e == nil {
+		sourceconfig, e = s.openSecrets(groupname, appname, sourceconfig)
+	}
+	if e != nil {
+		log.Error("[sconfig.checkConfig] group:", groupname, "app:", appname, "decrypt error:", e)
+		return ecode.ErrSecret
+	}
+	return schema.check(appconfig, sourceconfig)
+}
@@ -0,0 +1,138 @@
+package sconfig
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	sconfigdao "github.com/chenjie199234/Config/dao/sconfig"
+)
+
+//newTestKeyring write the keys into a key file and load it
+func newTestKeyring(t *testing.T, n int) *keyring {
+	lines := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		key := make([]byte, 32)
+		rand.Read(key)
+		lines = append(lines, base64.StdEncoding.EncodeToString(key))
+	}
+	path := filepath.Join(t.TempDir(), "key")
+	if e := os.WriteFile(path, []byte(strings.Join(lines, "\n")+"\n"), 0600); e != nil {
+		t.Fatal(e)
+	}
+	k, e := loadKeyring(path)
+	if e != nil {
+		t.Fatal(e)
+	}
+	return k
+}
+
+func TestSealOpen(t *testing.T) {
+	key := make([]byte, 32)
+	rand.Read(key)
+	aead, e := newAEAD(key)
+	if e != nil {
+		t.Fatal(e)
+	}
+	tests := []struct {
+		plaintext string
+		sealad    string
+		openad    string
+		ok        bool
+	}{
+		{"hello", "g/a", "g/a", true},
+		{"", "g/a", "g/a", true},
+		{"hello", "g/a", "g/b", false},
+		{"hello", "", "", true},
+	}
+	for _, test := range tests {
+		ciphertext, e := seal(aead, []byte(test.plaintext), []byte(test.sealad))
+		if e != nil {
+			t.Fatal(e)
+		}
+		plaintext, e := open(aead, ciphertext, []byte(test.openad))
+		if (e == nil) != test.ok {
+			t.Errorf("open %q sealed with %q by %q error: %v", test.plaintext, test.sealad, test.openad, e)
+			continue
+		}
+		if test.ok && string(plaintext) != test.plaintext {
+			t.Errorf("open %q = %q", test.plaintext, plaintext)
+		}
+	}
+	//the nonce is random
+	c1, _ := seal(aead, []byte("hello"), nil)
+	c2, _ := seal(aead, []byte("hello"), nil)
+	if bytes.Equal(c1, c2) {
+		t.Error("same ciphertext for the same plaintext")
+	}
+	if _, e := open(aead, []byte("short"), nil); e == nil {
+		t.Error("open short ciphertext should fail")
+	}
+}
+
+func TestKeyring(t *testing.T) {
+	k := newTestKeyring(t, 2)
+	envelope, e := k.encrypt("g", "a", []byte(`"pwd"`))
+	if e != nil {
+		t.Fatal(e)
+	}
+	tests := []struct {
+		groupname string
+		appname   string
+		envelope  string
+		ok        bool
+	}{
+		{"g", "a", envelope, true},
+		{"g", "b", envelope, false},
+		{"h", "a", envelope, false},
+		{"g", "a", strings.Replace(envelope, "v1:", "v2:", 1), false},
+		{"g", "a", "v1:unknown:a:b", false},
+	}
+	for _, test := range tests {
+		value, e := k.decrypt(test.groupname, test.appname, test.envelope)
+		if (e == nil) != test.ok {
+			t.Errorf("decrypt %s/%s error: %v", test.groupname, test.appname, e)
+			continue
+		}
+		if test.ok && string(value) != `"pwd"` {
+			t.Errorf("decrypt %s/%s = %s", test.groupname, test.appname, value)
+		}
+	}
+}
+
+func TestSealSecrets(t *testing.T) {
+	s := &Service{secret: newTestKeyring(t, 1)}
+	stored, e := s.sealSecrets("g", "a", `{"a":1,"b":{"$secret":"pwd"}}`, nil)
+	if e != nil {
+		t.Fatal(e)
+	}
+	if strings.Contains(stored, "pwd") || !strings.Contains(stored, `"$encrypted"`) {
+		t.Fatalf("secret is not encrypted: %s", stored)
+	}
+	opened, e := s.openSecrets("g", "a", stored)
+	if e != nil || opened != `{"a":1,"b":"pwd"}` {
+		t.Fatalf("open secrets = %s,%v", opened, e)
+	}
+	//the masked secret is set back from the current version
+	masked := maskSecrets(stored)
+	if strings.Contains(masked, `"$encrypted"`) {
+		t.Fatalf("secret is not masked: %s", masked)
+	}
+	current := &sconfigdao.Config{AppConfig: stored, SourceConfig: "{}"}
+	resealed, e := s.sealSecrets("g", "a", masked, current)
+	if e != nil || resealed != stored {
+		t.Fatalf("reseal = %s,%v,want %s", resealed, e, stored)
+	}
+	//the encrypted value of other apps can't be copied
+	if _, e := s.sealSecrets("g", "b", stored, nil); e == nil {
+		t.Fatal("copy other app's secret should fail")
+	}
+	//the unknown mask can't be set
+	if _, e := s.sealSecrets("g", "a", `{"b":{"$secret":"******","$id":"unknown"}}`, current); e == nil {
+		t.Fatal("unknown mask should fail")
+	}
+}
@@ -0,0 +1,132 @@
+package sconfig
+
+import (
+	"context"
+	"time"
+
+	"github.com/chenjie199234/Config/api"
+	sconfigdao "github.com/chenjie199234/Config/dao/sconfig"
+	"github.com/chenjie199234/Config/ecode"
+
+	"github.com/chenjie199234/Corelib/log"
+)
+
+//issueReadToken create a new read token and return the token passed by the sdk
+func (s *Service) issueReadToken(ctx context.Context, groupname, appname, comment string, expire uint64) (*sconfigdao.ReadToken, string, error) {
+	secret, e := newSecret()
+	if e != nil {
+		return nil, "", e
+	}
+	author, _ := s.getCaller(ctx)
+	token := &sconfigdao.ReadToken{
+		Groupname: groupname,
+		Appname:   appname,
+		Token:     hashSecret(secret),
+		Expire:    expire,
+		Comment:   comment,
+		Ctime:     uint64(time.Now().Unix()),
+		Author:    author,
+	}
+	if e := s.sconfigDao.AddReadToken(ctx, token); e != nil {
+		return nil, "", e
+	}
+	return token, "app." + token.ID + "." + secret, nil
+}
+
+//issue a new read token for one specific app
+func (s *Service) StokenIssue(ctx context.Context, in *api.StokenIssueReq) (*api.StokenIssueResp, error) {
+	if e := s.check(ctx, in.Groupname, in.Appname, roleAdmin); e != nil {
+		return nil, e
+	}
+	if in.Expire != 0 && in.Expire <= uint64(time.Now().Unix()) {
+		return nil, ecode.ErrReq
+	}
+	token, str, e := s.issueReadToken(ctx, in.Groupname, in.Appname, in.Comment, in.Expire)
+	if e != nil {
+		log.Error("[sconfig.StokenIssue] error:", e)
+		return nil, ecode.ErrSystem
+	}
+	caller, ip := s.getCaller(ctx)
+	s.auditAs(ctx, caller, ip, in.Groupname, in.Appname, "token_issue", 0, 0, 0, token.ID)
+	return &api.StokenIssueResp{Id: token.ID, Token: str}, nil
+}
+
+//issue a new read token to replace the old one
+func (s *Service) StokenRotate(ctx context.Context, in *api.StokenRotateReq) (*api.StokenRotateResp, error) {
+	if e := s.check(ctx, in.Groupname, in.Appname, roleAdmin); e != nil {
+		return nil, e
+	}
+	old, e := s.sconfigDao.GetReadToken(ctx, in.Id)
+	if e == nil && (old.Groupname != in.Groupname || old.Appname != in.Appname) {
+		e = sconfigdao.ErrNotExist
+	}
+	if e != nil {
+		log.Error("[sconfig.StokenRotate] error:", e)
+		if e == sconfigdao.ErrNotExist {
+			return nil, ecode.ErrNotExist
+		}
+		return nil, ecode.ErrSystem
+	}
+	comment := in.Comment
+	if comment == "" {
+		comment = old.Comment
+	}
+	//the new token keeps the old token's expire time
+	token, str, e := s.issueReadToken(ctx, in.Groupname, in.Appname, comment, old.Expire)
+	if e != nil {
+		log.Error("[sconfig.StokenRotate] error:", e)
+		return nil, ecode.ErrSystem
+	}
+	if in.Grace == 0 {
+		e = s.sconfigDao.DelReadToken(ctx, in.Groupname, in.Appname, in.Id)
+	} else if expire := uint64(time.Now().Unix()) + in.Grace; old.Expire == 0 || old.Expire > expire {
+		e = s.sconfigDao.ExpireReadToken(ctx, in.Groupname, in.Appname, in.Id, expire)
+	}
+	if e != nil && e != sconfigdao.ErrNotExist {
+		//the new token is already issued,return it and let the caller revoke the old one again
+		log.Error("[sconfig.StokenRotate] expire the old token:", in.Id, "error:", e)
+	}
+	caller, ip := s.getCaller(ctx)
+	s.auditAs(ctx, caller, ip, in.Groupname, in.Appname, "token_rotate", 0, 0, 0, in.Id+"->"+token.ID)
+	return &api.StokenRotateResp{Id: token.ID, Token: str}, nil
+}
+
+//revoke one read token at once
+func (s *Service) StokenRevoke(ctx context.Context, in *api.StokenRevokeReq) (*api.StokenRevokeResp, error) {
+	if e := s.check(ctx, in.Groupname, in.Appname, roleAdmin); e != nil {
+		return nil, e
+	}
+	if e := s.sconfigDao.DelReadToken(ctx, in.Groupname, in.Appname, in.Id); e != nil {
+		log.Error("[sconfig.StokenRevoke] error:", e)
+		if e == sconfigdao.ErrNotExist {
+			return nil, ecode.ErrNotExist
+		}
+		return nil, ecode.ErrSystem
+	}
+	caller, ip := s.getCaller(ctx)
+	s.auditAs(ctx, caller, ip, in.Groupname, in.Appname, "token_revoke", 0, 0, 0, in.Id)
+	return &api.StokenRevokeResp{}, nil
+}
+
+//get one specific app's read tokens
+func (s *Service) Stokens(ctx context.Context, in *api.StokensReq) (*api.StokensResp, error) {
+	if e := s.check(ctx, in.Groupname, in.Appname, roleAdmin); e != nil {
+		return nil, e
+	}
+	tokens, e := s.sconfigDao.GetReadTokens(ctx, in.Groupname, in.Appname)
+	if e != nil {
+		log.Error("[sconfig.Stokens] error:", e)
+		return nil, ecode.ErrSystem
+	}
+	resp := &api.StokensResp{Tokens: make([]*api.ReadTokenInfo, 0, len(tokens))}
+	for _, token := range tokens {
+		resp.Tokens = append(resp.Tokens, &api.ReadTokenInfo{
+			Id:      token.ID,
+			Expire:  token.Expire,
+			Comment: token.Comment,
+			Ctime:   token.Ctime,
+			Author:  token.Author,
+		})
+	}
+	return resp, nil
+}
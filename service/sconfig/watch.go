@@ -0,0 +1,256 @@
+package sconfig
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	sconfigdao "github.com/chenjie199234/Config/dao/sconfig"
+
+	"github.com/chenjie199234/Corelib/log"
+)
+
+//the watcher is stopped when no caller uses it for this long
+const watcherIdle = time.Minute
+
+//hub shares one storage watch for each app between all the callers
+//so the storage will not be fanned out to every sdk
+//the watchers are reference counted,the idle ones are stopped
+type hub struct {
+	sync.Mutex
+	dao      sconfigdao.Storage
+	ctx      context.Context
+	cancel   context.CancelFunc
+	watchers map[appKey]*watcher
+	bases    map[string]*groupBase //key groupname
+}
+
+//appKey is the watcher's key,the names may contain any char,so they are not joined into one string
+type appKey struct {
+	groupname string
+	appname   string
+}
+
+//groupBase shares one storage watch for each group's base config between the group's watchers
+type groupBase struct {
+	base     *sconfigdao.Base //nil means the first data is not received
+	watchers []*watcher
+	cancel   context.CancelFunc
+}
+
+type watcher struct {
+	sync.RWMutex
+	//refs,idle and cancel are protected by the hub's lock
+	refs    int
+	idle    *time.Timer
+	cancel  context.CancelFunc
+	summary *sconfigdao.Summary
+	config  *sconfigdao.Config
+	canary  *sconfigdao.Config //nil means no canary
+	base    *sconfigdao.Base   //the group's base config
+	//closed and replaced every time the summary or the base changed
+	notice chan struct{}
+	//closed when the first data is received from the storage
+	inited chan struct{}
+	//closed when the first base is received from the storage
+	baseinited chan struct{}
+}
+
+func newHub(dao sconfigdao.Storage) *hub {
+	h := &hub{
+		dao:      dao,
+		watchers: make(map[appKey]*watcher),
+		bases:    make(map[string]*groupBase),
+	}
+	h.ctx, h.cancel = context.WithCancel(context.Background())
+	return h
+}
+
+//acquire return the app's watcher,the caller must release it after use
+//exist is called before a new watcher is created,so the app which doesn't exist will not get a watcher
+func (h *hub) acquire(groupname, appname string, exist func() error) (*watcher, error) {
+	key := appKey{groupname: groupname, appname: appname}
+	h.Lock()
+	if w, ok := h.watchers[key]; ok {
+		w.ref()
+		h.Unlock()
+		return w, nil
+	}
+	h.Unlock()
+	if e := exist(); e != nil {
+		return nil, e
+	}
+	h.Lock()
+	defer h.Unlock()
+	if w, ok := h.watchers[key]; ok {
+		//created by others when exist was called
+		w.ref()
+		return w, nil
+	}
+	w := &watcher{
+		refs:       1,
+		notice:     make(chan struct{}),
+		inited:     make(chan struct{}),
+		baseinited: make(chan struct{}),
+	}
+	var ctx context.Context
+	ctx, w.cancel = context.WithCancel(h.ctx)
+	h.watchers[key] = w
+	b, ok := h.bases[groupname]
+	if !ok {
+		b = &groupBase{}
+		var basectx context.Context
+		basectx, b.cancel = context.WithCancel(h.ctx)
+		h.bases[groupname] = b
+		go h.watchBase(basectx, groupname, b)
+	}
+	b.watchers = append(b.watchers, w)
+	if b.base != nil {
+		w.base = b.base
+		close(w.baseinited)
+	}
+	go func() {
+		for {
+			e := h.dao.Watch(ctx, groupname, appname, func(summary *sconfigdao.Summary, config *sconfigdao.Config, canary *sconfigdao.Config) {
+				w.Lock()
+				first := w.summary == nil
+				if !first && w.summary.OpNum == summary.OpNum {
+					w.Unlock()
+					return
+				}
+				w.summary = summary
+				w.config = config
+				w.canary = canary
+				close(w.notice)
+				w.notice = make(chan struct{})
+				w.Unlock()
+				if first {
+					close(w.inited)
+				}
+			})
+			if ctx.Err() != nil {
+				return
+			}
+			//the mongo change stream ends without error when the app is purged
+			if e != nil {
+				log.Error("[sconfig.hub] watch group:", groupname, "app:", appname, "error:", e)
+			}
+			time.Sleep(time.Millisecond * 500)
+		}
+	}()
+	return w, nil
+}
+
+//ref must be called with the hub's lock
+func (w *watcher) ref() {
+	w.refs++
+	if w.idle != nil {
+		w.idle.Stop()
+		w.idle = nil
+	}
+}
+
+//release decrease the watcher's reference,the watcher will be stopped after it is idle for watcherIdle
+//the sdk watches again at once after it gets the result,so the watcher is not stopped between two calls
+func (h *hub) release(groupname, appname string, w *watcher) {
+	h.Lock()
+	defer h.Unlock()
+	w.refs--
+	if w.refs > 0 {
+		return
+	}
+	w.idle = time.AfterFunc(watcherIdle, func() {
+		h.Lock()
+		defer h.Unlock()
+		key := appKey{groupname: groupname, appname: appname}
+		if w.refs > 0 || h.watchers[key] != w {
+			return
+		}
+		delete(h.watchers, key)
+		w.cancel()
+		b := h.bases[groupname]
+		for i, bw := range b.watchers {
+			if bw == w {
+				b.watchers = append(b.watchers[:i], b.watchers[i+1:]...)
+				break
+			}
+		}
+		if len(b.watchers) == 0 {
+			delete(h.bases, groupname)
+			b.cancel()
+		}
+	})
+}
+
+func (h *hub) watchBase(ctx context.Context, groupname string, b *groupBase) {
+	for {
+		e := h.dao.WatchBase(ctx, groupname, func(newbase *sconfigdao.Base) {
+			h.Lock()
+			if b.base != nil && b.base.OpNum == newbase.OpNum {
+				h.Unlock()
+				return
+			}
+			b.base = newbase
+			watchers := make([]*watcher, len(b.watchers))
+			copy(watchers, b.watchers)
+			h.Unlock()
+			for _, w := range watchers {
+				w.setBase(newbase)
+			}
+		})
+		if ctx.Err() != nil {
+			return
+		}
+		if e != nil {
+			log.Error("[sconfig.hub] watch group:", groupname, "base error:", e)
+		}
+		time.Sleep(time.Millisecond * 500)
+	}
+}
+
+func (w *watcher) setBase(newbase *sconfigdao.Base) {
+	w.Lock()
+	first := w.base == nil
+	if !first && w.base.OpNum == newbase.OpNum {
+		w.Unlock()
+		return
+	}
+	w.base = newbase
+	close(w.notice)
+	w.notice = make(chan struct{})
+	w.Unlock()
+	if first {
+		close(w.baseinited)
+	}
+}
+
+//get return the current summary,config,canary config and the group's base config
+//if opnum or baseopnum is different from the current one,return at once
+//otherwise block until the config or the base changed or ctx is done
+func (w *watcher) get(ctx context.Context, opnum, baseopnum uint64) (*sconfigdao.Summary, *sconfigdao.Config, *sconfigdao.Config, *sconfigdao.Base, error) {
+	for _, inited := range []chan struct{}{w.inited, w.baseinited} {
+		select {
+		case <-inited:
+		case <-ctx.Done():
+			return nil, nil, nil, nil, ctx.Err()
+		}
+	}
+	w.RLock()
+	summary, config, canary, b, notice := w.summary, w.config, w.canary, w.base, w.notice
+	w.RUnlock()
+	if summary.OpNum != opnum || b.OpNum != baseopnum {
+		return summary, config, canary, b, nil
+	}
+	select {
+	case <-notice:
+		w.RLock()
+		summary, config, canary, b = w.summary, w.config, w.canary, w.base
+		w.RUnlock()
+	case <-ctx.Done():
+	}
+	return summary, config, canary, b, nil
+}
+
+func (h *hub) stop() {
+	h.cancel()
+}
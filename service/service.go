@@ -19,7 +19,10 @@ func StartService() error {
 	}
 	//start sub service
 	SvcStatus = status.Start()
-	SvcSconfig = sconfig.Start()
+	var e error
+	if SvcSconfig, e = sconfig.Start(); e != nil {
+		return e
+	}
 	return nil
 }
 